@@ -1,14 +1,73 @@
 package internal
 
 import (
+	"fmt"
 	"github.com/ilyakaznacheev/cleanenv"
 	"go.uber.org/zap"
+	"time"
+)
+
+// Repository backends a service can store its data in
+const (
+	RepositoryInMemory = "inmemory"
+	RepositoryPostgres = "postgres"
+	RepositoryDapr     = "dapr"
 )
 
 type Config struct {
 	Port     string     `yaml:"port" env:"PORT" env-required:"true"`
 	Dapr     DaprConfig `yaml:"dapr"`
 	Database string     `yaml:"database" env:"DATABASE"`
+	// Repository selects the backend, one of inmemory, postgres or dapr
+	Repository  string            `yaml:"repository" env:"REPOSITORY" env-default:"dapr"`
+	Status      StatusConfig      `yaml:"status"`
+	Idempotency IdempotencyConfig `yaml:"idempotency"`
+	Timeline    TimelineConfig    `yaml:"timeline"`
+	// RateLimits limit the requests of every user, keyed by method and route pattern like "POST /statuses"
+	RateLimits map[string]RateLimit `yaml:"rate-limits"`
+}
+
+// StatusConfig limits what a status may contain, how long a deleted one can be restored and how many a user can pin
+type StatusConfig struct {
+	MaxContentLength int `yaml:"max-content-length" env:"STATUS_MAX_CONTENT_LENGTH" env-default:"500"`
+	MaxMediaCount    int `yaml:"max-media-count" env:"STATUS_MAX_MEDIA_COUNT" env-default:"4"`
+	// DuplicateWindow is how long a user can't post the same content again, 0 allows duplicates
+	DuplicateWindow time.Duration `yaml:"duplicate-window" env:"STATUS_DUPLICATE_WINDOW" env-default:"1h"`
+	// RestoreWindow is how long a deleted status can be restored before it is purged
+	RestoreWindow     time.Duration `yaml:"restore-window" env:"STATUS_RESTORE_WINDOW" env-default:"24h"`
+	MaxPinnedStatuses int           `yaml:"max-pinned-statuses" env:"STATUS_MAX_PINNED_STATUSES" env-default:"5"`
+}
+
+// TimelineConfig bounds the timelines kept for every user and how statuses get into them
+type TimelineConfig struct {
+	// MaxLength is how many statuses a timeline keeps, the oldest are dropped once it grows longer
+	MaxLength int `yaml:"max-length" env:"TIMELINE_MAX_LENGTH" env-default:"800"`
+	// BackfillLength is how many of the latest statuses of a followed user are added to the timeline of the follower
+	BackfillLength int `yaml:"backfill-length" env:"TIMELINE_BACKFILL_LENGTH" env-default:"20"`
+	// FanOutThreshold is how many followers an author can have before their statuses are no longer pushed to
+	// timelines but merged into them when they are read
+	FanOutThreshold int `yaml:"fan-out-threshold" env:"TIMELINE_FAN_OUT_THRESHOLD" env-default:"10000"`
+	// FanOutConcurrency is how many timelines are updated at once when a status is pushed
+	FanOutConcurrency int `yaml:"fan-out-concurrency" env:"TIMELINE_FAN_OUT_CONCURRENCY" env-default:"16"`
+}
+
+// validate rejects fan-out settings under which statuses would never be pushed, errgroup blocks with a limit of 0
+func (config TimelineConfig) validate() error {
+	if config.MaxLength < 1 {
+		return fmt.Errorf("max-length has to be at least 1, is %d", config.MaxLength)
+	}
+	if config.FanOutConcurrency < 1 {
+		return fmt.Errorf("fan-out-concurrency has to be at least 1, is %d", config.FanOutConcurrency)
+	}
+	if config.FanOutThreshold < 0 {
+		return fmt.Errorf("fan-out-threshold must not be negative, is %d", config.FanOutThreshold)
+	}
+	return nil
+}
+
+// IdempotencyConfig controls how long the response to a request with an Idempotency-Key is replayed
+type IdempotencyConfig struct {
+	TTL time.Duration `yaml:"ttl" env:"IDEMPOTENCY_TTL" env-default:"24h"`
 }
 
 type DaprConfig struct {
@@ -48,5 +107,10 @@ func NewConfig(path string, logger *zap.Logger) *Config {
 		}
 	}
 
+	err = config.Timeline.validate()
+	if err != nil {
+		logger.Fatal("invalid timeline config", zap.Error(err))
+	}
+
 	return &config
 }
@@ -1,8 +1,10 @@
 package internal
 
 import (
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"strings"
 )
 
 type NotFoundError uuid.UUID
@@ -11,6 +13,26 @@ func (err NotFoundError) Error() string {
 	return fmt.Sprintf("entity with uuid {%s} not found", uuid.UUID(err).String())
 }
 
+// FieldError names a field of a request and why its value was rejected
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// ValidationError collects every field of a request that was rejected
+type ValidationError []FieldError
+
+func (err ValidationError) Error() string {
+	messages := make([]string, len(err))
+	for i, fieldError := range err {
+		messages[i] = fmt.Sprintf("%s: %s", fieldError.Field, fieldError.Message)
+	}
+	return fmt.Sprintf("validation failed: %s", strings.Join(messages, ", "))
+}
+
+// NotImplementedError is returned by the client methods no service calls yet
+var NotImplementedError = errors.New("not implemented")
+
 type ClientError struct {
 	cause         error
 	errorResponse *ErrorResponse
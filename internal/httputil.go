@@ -17,6 +17,8 @@ type ErrorResponse struct {
 	Timestamp time.Time
 	Message   string
 	Status    int
+	// Fields lists the rejected fields of a ValidationError
+	Fields []FieldError `json:",omitempty"`
 }
 
 func ReplyWithError(w http.ResponseWriter, r *http.Request, err error, status int) {
@@ -28,9 +30,12 @@ func ReplyWithError(w http.ResponseWriter, r *http.Request, err error, status in
 	zap.L().Log(lvl, "Error while serving http route", zap.String("error", err.Error()), zap.Int("status", status))
 
 	var notFound NotFoundError
+	var validation ValidationError
 	msg := "Error"
 	if errors.As(err, &notFound) {
 		msg = uuid.UUID(notFound).String()
+	} else if errors.As(err, &validation) {
+		msg = "Validation failed"
 	}
 
 	errorRes := ErrorResponse{
@@ -38,6 +43,7 @@ func ReplyWithError(w http.ResponseWriter, r *http.Request, err error, status in
 		Path:      r.RequestURI,
 		Timestamp: time.Now().UTC(),
 		Message:   msg,
+		Fields:    validation,
 	}
 	ReplyWithStatusWithJSON(w, r, status, errorRes)
 }
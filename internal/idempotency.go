@@ -0,0 +1,245 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	dapr "github.com/dapr/go-sdk/client"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentReplayedHeader is set on responses that were replayed for a repeated request
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+var IdempotencyKeyReusedError = ValidationError{{Field: IdempotencyKeyHeader, Message: "was already used for a different request"}}
+var IdempotencyKeyInProgressError = errors.New("a request with this idempotency key is still in progress")
+
+// IdempotentResponse is the first response to a request with an idempotency key, Status is 0 while it is served
+type IdempotentResponse struct {
+	RequestHash string
+	Status      int
+	ContentType string
+	Body        []byte
+}
+
+type IdempotencyStore interface {
+	// Claim reserves key for a request. If the key was claimed before, the response stored for it is returned.
+	Claim(key string, requestHash string, ttl time.Duration) (*IdempotentResponse, error)
+	Complete(key string, response IdempotentResponse, ttl time.Duration) error
+	// Release gives up a claim, so the request can be retried with the same key
+	Release(key string) error
+}
+
+type Idempotency struct {
+	store IdempotencyStore
+	ttl   time.Duration
+}
+
+func NewIdempotency(store IdempotencyStore, ttl time.Duration) *Idempotency {
+	return &Idempotency{store, ttl}
+}
+
+// Middleware replays the stored response for POST requests to paths that repeat the Idempotency-Key of an earlier
+// request of the same X-user. Requests without the header are served as usual. Server errors are not stored,
+// so those requests can be retried.
+func (idempotency *Idempotency) Middleware(paths ...string) func(next http.Handler) http.Handler {
+	covered := NewSet[string]()
+	for _, path := range paths {
+		covered.Add(path)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			key := request.Header.Get(IdempotencyKeyHeader)
+			userId, err := uuid.Parse(request.Header.Get("X-user"))
+			if key == "" || err != nil || request.Method != http.MethodPost || !covered.Has(request.URL.Path) {
+				next.ServeHTTP(writer, request)
+				return
+			}
+
+			body, err := io.ReadAll(request.Body)
+			if err != nil {
+				ReplyWithError(writer, request, err, http.StatusBadRequest)
+				return
+			}
+			request.Body = io.NopCloser(bytes.NewReader(body))
+
+			hash := sha256.Sum256(body)
+			requestHash := hex.EncodeToString(hash[:])
+			stateKey := idempotencyKey(userId, request.URL.Path, key)
+
+			stored, err := idempotency.store.Claim(stateKey, requestHash, idempotency.ttl)
+			if err != nil {
+				ReplyWithError(writer, request, err, http.StatusInternalServerError)
+				return
+			}
+			if stored != nil {
+				replay(writer, request, *stored, requestHash)
+				return
+			}
+
+			var captured bytes.Buffer
+			ww := middleware.NewWrapResponseWriter(writer, request.ProtoMajor)
+			ww.Tee(&captured)
+			next.ServeHTTP(ww, request)
+
+			if ww.Status() >= http.StatusInternalServerError {
+				err = idempotency.store.Release(stateKey)
+			} else {
+				err = idempotency.store.Complete(stateKey, IdempotentResponse{
+					RequestHash: requestHash,
+					Status:      ww.Status(),
+					ContentType: ww.Header().Get("Content-Type"),
+					Body:        captured.Bytes(),
+				}, idempotency.ttl)
+			}
+			if err != nil {
+				// Without a stored response a retry is served again, instead of conflicting until the claim expires
+				_ = idempotency.store.Release(stateKey)
+			}
+		})
+	}
+}
+
+func replay(writer http.ResponseWriter, request *http.Request, stored IdempotentResponse, requestHash string) {
+	if stored.RequestHash != requestHash {
+		ReplyWithError(writer, request, IdempotencyKeyReusedError, http.StatusUnprocessableEntity)
+		return
+	}
+	if stored.Status == 0 {
+		ReplyWithError(writer, request, IdempotencyKeyInProgressError, http.StatusConflict)
+		return
+	}
+
+	if stored.ContentType != "" {
+		writer.Header().Set("Content-Type", stored.ContentType)
+	}
+	writer.Header().Set(IdempotentReplayedHeader, "true")
+	writer.WriteHeader(stored.Status)
+	_, _ = writer.Write(stored.Body)
+}
+
+// idempotencyKey keeps the keys of every user and path apart
+func idempotencyKey(userId uuid.UUID, path string, key string) string {
+	return fmt.Sprintf("idempotency-%s-%s-%s", userId.String(), path, key)
+}
+
+type DaprIdempotencyStore struct {
+	dapr   dapr.Client
+	config StateStoreConfig
+}
+
+func NewDaprIdempotencyStore(client dapr.Client, config StateStoreConfig) *DaprIdempotencyStore {
+	return &DaprIdempotencyStore{client, config}
+}
+
+// Claim writes the claim with first write concurrency, of two replicas claiming the same key only one succeeds
+func (store *DaprIdempotencyStore) Claim(key string, requestHash string, ttl time.Duration) (*IdempotentResponse, error) {
+	ctx := context.Background()
+	stored, err := store.get(ctx, key)
+	if err != nil || stored != nil {
+		return stored, err
+	}
+
+	err = store.save(ctx, key, IdempotentResponse{RequestHash: requestHash}, ttl, dapr.WithConcurrency(dapr.StateConcurrencyFirstWrite))
+	if err != nil {
+		stored, getErr := store.get(ctx, key)
+		if getErr != nil || stored == nil {
+			return nil, err
+		}
+		return stored, nil
+	}
+	return nil, nil
+}
+
+func (store *DaprIdempotencyStore) Complete(key string, response IdempotentResponse, ttl time.Duration) error {
+	return store.save(context.Background(), key, response, ttl)
+}
+
+func (store *DaprIdempotencyStore) Release(key string) error {
+	return store.dapr.DeleteState(context.Background(), store.config.Name, key, nil)
+}
+
+func (store *DaprIdempotencyStore) get(ctx context.Context, key string) (*IdempotentResponse, error) {
+	item, err := store.dapr.GetState(ctx, store.config.Name, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	if item.Value == nil {
+		return nil, nil
+	}
+
+	var response IdempotentResponse
+	err = json.Unmarshal(item.Value, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// save lets the state store expire the key after ttl
+func (store *DaprIdempotencyStore) save(ctx context.Context, key string, response IdempotentResponse, ttl time.Duration, options ...dapr.StateOption) error {
+	responseJson, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	metadata := map[string]string{"ttlInSeconds": strconv.Itoa(int(ttl.Seconds()))}
+	return store.dapr.SaveState(ctx, store.config.Name, key, responseJson, metadata, options...)
+}
+
+type inMemoryIdempotentResponse struct {
+	response  IdempotentResponse
+	expiresAt time.Time
+}
+
+type InMemoryIdempotencyStore struct {
+	mutex     sync.Mutex
+	responses map[string]inMemoryIdempotentResponse
+}
+
+func NewInMemoryIdempotencyStore() *InMemoryIdempotencyStore {
+	return &InMemoryIdempotencyStore{responses: map[string]inMemoryIdempotentResponse{}}
+}
+
+func (store *InMemoryIdempotencyStore) Claim(key string, requestHash string, ttl time.Duration) (*IdempotentResponse, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	now := time.Now()
+	stored, exists := store.responses[key]
+	if exists && now.Before(stored.expiresAt) {
+		return &stored.response, nil
+	}
+
+	store.responses[key] = inMemoryIdempotentResponse{IdempotentResponse{RequestHash: requestHash}, now.Add(ttl)}
+	return nil, nil
+}
+
+func (store *InMemoryIdempotencyStore) Complete(key string, response IdempotentResponse, ttl time.Duration) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.responses[key] = inMemoryIdempotentResponse{response, time.Now().Add(ttl)}
+	return nil
+}
+
+func (store *InMemoryIdempotencyStore) Release(key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	delete(store.responses, key)
+	return nil
+}
@@ -0,0 +1,251 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	dapr "github.com/dapr/go-sdk/client"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit allows Requests per Period and bursts of up to Burst requests, Burst defaults to Requests
+type RateLimit struct {
+	Requests int           `yaml:"requests"`
+	Period   time.Duration `yaml:"period"`
+	Burst    int           `yaml:"burst"`
+}
+
+func (limit RateLimit) capacity() float64 {
+	if limit.Burst > 0 {
+		return float64(limit.Burst)
+	}
+	return float64(limit.Requests)
+}
+
+// refillRate is the number of tokens added per second
+func (limit RateLimit) refillRate() float64 {
+	return float64(limit.Requests) / limit.Period.Seconds()
+}
+
+// TokenBucket holds the tokens a user has left for a route, one is taken by every request
+type TokenBucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// take refills the bucket for the time passed since it was updated and takes a token if there is one
+func (bucket *TokenBucket) take(limit RateLimit, now time.Time) bool {
+	if bucket.UpdatedAt.IsZero() {
+		bucket.Tokens = limit.capacity()
+	} else if now.After(bucket.UpdatedAt) {
+		refilled := bucket.Tokens + now.Sub(bucket.UpdatedAt).Seconds()*limit.refillRate()
+		bucket.Tokens = math.Min(refilled, limit.capacity())
+	}
+	bucket.UpdatedAt = now
+
+	if bucket.Tokens < 1 {
+		return false
+	}
+	bucket.Tokens--
+	return true
+}
+
+// untilToken is how long it takes until the bucket has a token again
+func (bucket TokenBucket) untilToken(limit RateLimit) time.Duration {
+	return secondsOf((1 - bucket.Tokens) / limit.refillRate())
+}
+
+// untilFull is how long it takes until the bucket is completely refilled
+func (bucket TokenBucket) untilFull(limit RateLimit) time.Duration {
+	return secondsOf((limit.capacity() - bucket.Tokens) / limit.refillRate())
+}
+
+func secondsOf(seconds float64) time.Duration {
+	if seconds <= 0 {
+		return 0
+	}
+	return time.Duration(math.Ceil(seconds)) * time.Second
+}
+
+type RateLimitStore interface {
+	// Take takes a token from the bucket stored under key, a bucket that does not exist yet starts full
+	Take(key string, limit RateLimit, now time.Time) (TokenBucket, bool, error)
+}
+
+type RateLimiter struct {
+	store  RateLimitStore
+	limits map[string]RateLimit
+}
+
+// NewRateLimiter limits the routes in limits, which are keyed by method and route pattern like "POST /statuses/{statusId}/likes".
+// Limits without requests or period are left out.
+func NewRateLimiter(store RateLimitStore, limits map[string]RateLimit) *RateLimiter {
+	valid := make(map[string]RateLimit, len(limits))
+	for route, limit := range limits {
+		if limit.Requests > 0 && limit.Period > 0 {
+			valid[route] = limit
+		}
+	}
+	return &RateLimiter{store, valid}
+}
+
+// Middleware limits the requests of every X-user to the limited routes with a token bucket per user and route.
+// Requests without X-user are not limited. If the store fails, requests are let through rather than rejected.
+func (limiter *RateLimiter) Middleware() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			userId, err := uuid.Parse(request.Header.Get("X-user"))
+			route, limit, limited := limiter.limitOf(request)
+			if err != nil || !limited {
+				next.ServeHTTP(writer, request)
+				return
+			}
+
+			bucket, allowed, err := limiter.store.Take(rateLimitKey(userId, route), limit, time.Now().UTC())
+			if err != nil {
+				zap.L().Error("rate limiting, request is let through", zap.Error(err), zap.String("route", route))
+				next.ServeHTTP(writer, request)
+				return
+			}
+
+			header := writer.Header()
+			header.Set("RateLimit-Limit", strconv.Itoa(int(limit.capacity())))
+			header.Set("RateLimit-Remaining", strconv.Itoa(int(bucket.Tokens)))
+			header.Set("RateLimit-Reset", strconv.Itoa(int(bucket.untilFull(limit).Seconds())))
+			if !allowed {
+				header.Set("Retry-After", strconv.Itoa(int(bucket.untilToken(limit).Seconds())))
+				ReplyWithError(writer, request, fmt.Errorf("rate limit of %s exceeded", route), http.StatusTooManyRequests)
+				return
+			}
+
+			next.ServeHTTP(writer, request)
+		})
+	}
+}
+
+// limitOf finds the limit of the route the request is for. The middleware runs before the router,
+// so the route pattern is matched here.
+func (limiter *RateLimiter) limitOf(request *http.Request) (string, RateLimit, bool) {
+	for route, limit := range limiter.limits {
+		method, pattern, found := strings.Cut(route, " ")
+		if found && method == request.Method && matchesPattern(pattern, request.URL.Path) {
+			return route, limit, true
+		}
+	}
+	return "", RateLimit{}, false
+}
+
+// matchesPattern matches a path against a route pattern, where every {param} matches one segment
+func matchesPattern(pattern string, path string) bool {
+	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(patternSegments) != len(pathSegments) {
+		return false
+	}
+
+	for i, segment := range patternSegments {
+		isParam := strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+		if !isParam && segment != pathSegments[i] {
+			return false
+		}
+		if isParam && pathSegments[i] == "" {
+			return false
+		}
+	}
+	return true
+}
+
+func rateLimitKey(userId uuid.UUID, route string) string {
+	return fmt.Sprintf("ratelimit-%s-%s", userId.String(), route)
+}
+
+const maxRateLimitWriteAttempts = 5
+
+type DaprRateLimitStore struct {
+	dapr   dapr.Client
+	config StateStoreConfig
+}
+
+func NewDaprRateLimitStore(client dapr.Client, config StateStoreConfig) *DaprRateLimitStore {
+	return &DaprRateLimitStore{client, config}
+}
+
+// Take writes the bucket with its etag, so replicas taking from the same bucket at once don't both get the last token.
+// Conflicting writes are retried.
+func (store *DaprRateLimitStore) Take(key string, limit RateLimit, now time.Time) (TokenBucket, bool, error) {
+	var err error
+	for attempt := 0; attempt < maxRateLimitWriteAttempts; attempt++ {
+		var bucket TokenBucket
+		var allowed bool
+		bucket, allowed, err = store.tryTake(context.Background(), key, limit, now)
+		if err == nil {
+			return bucket, allowed, nil
+		}
+	}
+	return TokenBucket{}, false, err
+}
+
+func (store *DaprRateLimitStore) tryTake(ctx context.Context, key string, limit RateLimit, now time.Time) (TokenBucket, bool, error) {
+	item, err := store.dapr.GetState(ctx, store.config.Name, key, nil)
+	if err != nil {
+		return TokenBucket{}, false, err
+	}
+
+	var bucket TokenBucket
+	if item.Value != nil {
+		err = json.Unmarshal(item.Value, &bucket)
+		if err != nil {
+			return TokenBucket{}, false, err
+		}
+	}
+
+	allowed := bucket.take(limit, now)
+	bucketJson, err := json.Marshal(bucket)
+	if err != nil {
+		return TokenBucket{}, false, err
+	}
+
+	// A bucket that is full again is the same as none, the state store can drop it
+	ttl := bucket.untilFull(limit) + time.Second
+	setItem := &dapr.SetStateItem{
+		Key:      key,
+		Value:    bucketJson,
+		Metadata: map[string]string{"ttlInSeconds": strconv.Itoa(int(ttl.Seconds()))},
+		Options:  &dapr.StateOptions{Concurrency: dapr.StateConcurrencyFirstWrite},
+	}
+	if item.Etag != "" {
+		setItem.Etag = &dapr.ETag{Value: item.Etag}
+	}
+
+	err = store.dapr.SaveBulkState(ctx, store.config.Name, setItem)
+	if err != nil {
+		return TokenBucket{}, false, err
+	}
+	return bucket, allowed, nil
+}
+
+type InMemoryRateLimitStore struct {
+	mutex   sync.Mutex
+	buckets map[string]TokenBucket
+}
+
+func NewInMemoryRateLimitStore() *InMemoryRateLimitStore {
+	return &InMemoryRateLimitStore{buckets: map[string]TokenBucket{}}
+}
+
+func (store *InMemoryRateLimitStore) Take(key string, limit RateLimit, now time.Time) (TokenBucket, bool, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	bucket := store.buckets[key]
+	allowed := bucket.take(limit, now)
+	store.buckets[key] = bucket
+	return bucket, allowed, nil
+}
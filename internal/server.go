@@ -57,6 +57,11 @@ func createHistogram(logger *zap.Logger) instrument.Float64Histogram {
 	return histogram
 }
 
+// OnShutdown registers f to be called once the server shuts down, so long-lived requests like streams can end
+func (server *Server) OnShutdown(f func()) {
+	server.httpServer.RegisterOnShutdown(f)
+}
+
 // StartAndWait starts the http server and waits for sigint || sigterm. If it receives a signal it gracefully shutdowns the server
 func (server *Server) StartAndWait() {
 	logger := server.logger
@@ -25,8 +25,9 @@ func main() {
 	_ = mime.AddExtensionType(".mp4", "video/mp4")
 
 	s3 := media.NewDaprS3(client, config.Dapr.S3)
+	repo := media.NewDaprRepo(client, config.Dapr.StateStore)
 	publisher := media.NewDaprMediaPublisher(client, config.Dapr.PubSub)
-	service := media.NewMediaService(s3, publisher)
+	service := media.NewMediaService(s3, repo, publisher)
 	api := media.NewMediaApi(service)
 
 	port, err := strconv.Atoi(config.Port)
@@ -34,7 +35,10 @@ func main() {
 		logger.Fatal("port not a int", zap.String("port", config.Port))
 	}
 
-	server := internal.NewServer(logger, port)
+	idempotencyStore := internal.NewDaprIdempotencyStore(client, config.Dapr.StateStore)
+	idempotency := internal.NewIdempotency(idempotencyStore, config.Idempotency.TTL)
+	rateLimiter := internal.NewRateLimiter(internal.NewDaprRateLimitStore(client, config.Dapr.StateStore), config.RateLimits)
+	server := internal.NewServer(logger, port, rateLimiter.Middleware(), idempotency.Middleware("/media"))
 	server.Router.Route("/", api.ConfigureRouter)
 
 	server.StartAndWait()
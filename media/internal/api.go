@@ -47,7 +47,7 @@ func NewMediaUpload(r *http.Request) (*MediaUpload, error) {
 	return &MediaUpload{media}, nil
 }
 
-func (api *Api) UploadMedia(w http.ResponseWriter, r *http.Request) {
+func (api *Api) UploadMedia(w http.ResponseWriter, r *http.Request, params UploadMediaParams) {
 	err := r.ParseMultipartForm(1_000_000) // 1Mb
 	if err != nil {
 		internal.ReplyWithError(w, r, err, http.StatusBadRequest)
@@ -84,6 +84,7 @@ func (api *Api) UploadMedia(w http.ResponseWriter, r *http.Request) {
 			Extension: extension,
 		},
 		fileName: mediaUpload.Media.Filename(),
+		userId:   params.XUser,
 		reader:   io.Reader(reader),
 	}
 
@@ -134,3 +135,23 @@ func (api *Api) DownloadMedia(w http.ResponseWriter, r *http.Request, mediaId st
 	w.Header().Add("Location", url)
 	w.WriteHeader(http.StatusSeeOther)
 }
+
+func (api *Api) GetMediaMetadata(w http.ResponseWriter, r *http.Request, mediaId openapi_types.UUID) {
+	storedMedia, err := api.service.GetMedia(mediaId)
+	if err != nil {
+		if errors.Is(err, internal.NotFoundError(mediaId)) {
+			internal.ReplyWithError(w, r, err, http.StatusNotFound)
+		} else {
+			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, MediaMetadataResponse{
+		MediaId:   storedMedia.Id,
+		UserId:    storedMedia.UserId,
+		Extension: storedMedia.Extension,
+		Converted: storedMedia.Converted,
+		CreatedAt: storedMedia.CreatedAt,
+	})
+}
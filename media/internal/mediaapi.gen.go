@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
@@ -19,6 +20,16 @@ import (
 	"github.com/go-chi/chi/v5"
 )
 
+// MediaMetadataResponse defines model for MediaMetadataResponse.
+type MediaMetadataResponse struct {
+	// Converted whether the compressed version is available
+	Converted bool               `json:"converted"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Extension string             `json:"extension"`
+	MediaId   openapi_types.UUID `json:"mediaId"`
+	UserId    openapi_types.UUID `json:"userId"`
+}
+
 // MediaUpload defines model for MediaUpload.
 type MediaUpload struct {
 	Media openapi_types.File `json:"media"`
@@ -29,6 +40,15 @@ type MediaUploadResponse struct {
 	MediaId string `json:"mediaId"`
 }
 
+// UploadMediaParams defines parameters for UploadMedia.
+type UploadMediaParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally.
+	XUser openapi_types.UUID `json:"X-user"`
+
+	// IdempotencyKey repeats of a request with the same key by the same user get the first response replayed, for 24 hours by default
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
 // DownloadMediaParams defines parameters for DownloadMedia.
 type DownloadMediaParams struct {
 	// Compressed boolean which indicates if the compressed version is requested
@@ -42,10 +62,13 @@ type UploadMediaMultipartRequestBody = MediaUpload
 type ServerInterface interface {
 	// upload a media by mediaId
 	// (POST /media)
-	UploadMedia(w http.ResponseWriter, r *http.Request)
+	UploadMedia(w http.ResponseWriter, r *http.Request, params UploadMediaParams)
 	// download a media by mediaId
 	// (GET /media/{mediaId})
 	DownloadMedia(w http.ResponseWriter, r *http.Request, mediaId string, params DownloadMediaParams)
+	// get the owner and conversion state of a media
+	// (GET /media/{mediaId}/metadata)
+	GetMediaMetadata(w http.ResponseWriter, r *http.Request, mediaId openapi_types.UUID)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -61,8 +84,57 @@ type MiddlewareFunc func(http.Handler) http.Handler
 func (siw *ServerInterfaceWrapper) UploadMedia(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadMediaParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UploadMedia(w, r)
+		siw.Handler.UploadMedia(w, r, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -109,6 +181,32 @@ func (siw *ServerInterfaceWrapper) DownloadMedia(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetMediaMetadata operation middleware
+func (siw *ServerInterfaceWrapper) GetMediaMetadata(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "mediaId" -------------
+	var mediaId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "mediaId", runtime.ParamLocationPath, chi.URLParam(r, "mediaId"), &mediaId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mediaId", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMediaMetadata(w, r, mediaId)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -228,6 +326,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/media/{mediaId}", wrapper.DownloadMedia)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/media/{mediaId}/metadata", wrapper.GetMediaMetadata)
+	})
 
 	return r
 }
@@ -235,15 +336,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/5xTwYrbMBD9FTHt0V2nzWXRraWXHAql0FPJQbHGsRZb0mrGu4Tgfy8j24njTWHpybI0",
-	"o/fmvaczVKGLwaNnAn0GqhrsTF7+QOvM79gGY+U3phAxscN82MmhLOqQOsOg4eC8SScogE8RQQNxcv4I",
-	"w1BAwufeJbSg/0yN+0tZODxhxTAUS7xfSDF4wn/g7jKhd+Ds7B0kqXS+DnKHRaqSi+yCBz0ykAkctzj/",
-	"q68/d1DACyYaqz4L2RDRm+hAw/Zh87CFAqLhJlMsL9rEQCxfGcAIhhCHccQZSzgj8bdgT1JaBc/oc1fX",
-	"t+yiSVyKxp+sYXN1SFYfE9ag4UN5tbCc/CuX5g232nDqMW+MGmfOXzabFbqJsXVVZl0+kQz+H9AXHzOF",
-	"W7GpryokqvtW9TPPAqjvOomRhnFTGZXlVIeTmi0tgM2RFmGSxlH18jwVDcLyiHfk/x5e/dKAaJLpkDHJ",
-	"jetEOKtCPQKDpAZ09hkK8KaTiFw53QpcLMR6E9Q1yiGEFo1Xr42rGuW8FeGRlKsVN6hE44REaNWUQuVI",
-	"TcFBOxN77jG/v4nZtQvukJkgYRj2qyhsN9u3L6MNYxREDaFkJxFVn9qVb5ej9zknvZheZvnlPg0Nc9Rl",
-	"KahtE4j14+ZxC0J16j/f6A/DfvgbAAD///2OGBrJBAAA",
+	"H4sIAAAAAAAC/7RWUW/jNgz+KwS3R7fJ2j7c/HbDgKEYDhgGDBhw6ANj0bFutqST6GZGkf8+UHYSN27W",
+	"7IA9xY4l8iO/j5/0gpXvgnfsJGH5gqlquKP8+ImNpU8sZEjod07Bu8T6IUQfOIrlvKzy7pmjsNEXw6mK",
+	"Noj1DkvcNSwNR5CGQdNETokNPHNM1juwCeiZbEublrFAGQJjiRvvWyaH+wKryCRsPoqGrn3sSLBEQ8I3",
+	"YrvZniTRuq1u4b+FXcrpX5ZfOy3p0bwK1/fWvBWpTxyvWrovMPLX3kZtwedjjmOEOahi1q55fU/HqH7z",
+	"hStRALn/f4TWk1l2PWd5BW5jHcXhOnjv5bvM9qyD17VhmUlXWlf7pV4yAq3ASsuHd/j42yMWOIkGS/xB",
+	"wfrAjoLFEu9v17f3WGAgaTLE1bE3wacsHS2ANIcCx7HEQ65AkToWjgnLz+eAUh9Ca9lAHX0HFCxsSXhH",
+	"A9gaqJeGndhKOSygIgcbhsQCHbme2naA1lf6e4taMZbYMBmOWKCjjrHEP29UIzjvnMSei2kMrxLfOebI",
+	"gUkS+BoINC4ngZ2VJo9hoo7hLx5gM5zeFQRsWfI/tY1JIE4KgMihpUELrH2EuwdofB+TbjdcU9/Kpdoe",
+	"DXfBC7tquPmVB5wXdV7E09gBTvKTN8PkKsIus9f1rdhAUVbaixt1o5NT6dP3kWss8bvVycpW49e0mg/R",
+	"fr8/73T+Y6w0a+duvT7LTqqAKqtn9SWNvvKfUx/nKUM411hVcUp130I/4SzwYf3jcjwu0XnWafXVJLZt",
+	"wToI0W8jp5SD3t0tg0qzDLCjpJowmXICY+uaIzs55M/DnvquU8MpcYQNBHnwVBonDxTappnt6MZxPlcv",
+	"06K9YtryG4P6s9+5q0fVGlV8Ny3NilRHOOnxhOnysL07XNPhBLvGVg1YZ/L0J7WDy4fc1DU2B2Bfe47D",
+	"Cdlp11tDcjwPxymZifV+fb/kUy1HH8GPkMzUROhje8bb8dO3MrfqptvBRQp/YXl1jXiPRTW5I49F1rnv",
+	"BeYH6DdS+56PPv3fTrC4Sf27Fxz7ONrBw5LpkTPnBWrfO3NG7sHO/c5xBHIGxptHFmUSEh5PiMPELOjW",
+	"aByfDzypfEpsREK5WuVzrfFJyg/rD/e4fzruf3nFCe6f9v8MAFfDwmliCgAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
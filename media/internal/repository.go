@@ -0,0 +1,56 @@
+package media
+
+import (
+	"context"
+	"encoding/json"
+	dapr "github.com/dapr/go-sdk/client"
+	"github.com/google/uuid"
+	"yatc/internal"
+	media "yatc/media/pkg/media"
+)
+
+type Repository interface {
+	Get(mediaId uuid.UUID) (media.Media, error)
+	Save(media media.Media) (media.Media, error)
+}
+
+type DaprStateStoreRepo struct {
+	dapr   dapr.Client
+	config internal.StateStoreConfig
+}
+
+func NewDaprRepo(client dapr.Client, config internal.StateStoreConfig) Repository {
+	return &DaprStateStoreRepo{client, config}
+}
+
+func (repo *DaprStateStoreRepo) Get(mediaId uuid.UUID) (media.Media, error) {
+	item, err := repo.dapr.GetState(context.Background(), repo.config.Name, mediaId.String(), nil)
+	if err != nil {
+		return media.Media{}, err
+	}
+	if item.Value == nil {
+		return media.Media{}, internal.NotFoundError(mediaId)
+	}
+
+	var storedMedia media.Media
+	err = json.Unmarshal(item.Value, &storedMedia)
+	if err != nil {
+		return media.Media{}, err
+	}
+
+	return storedMedia, nil
+}
+
+func (repo *DaprStateStoreRepo) Save(storedMedia media.Media) (media.Media, error) {
+	data, err := json.Marshal(storedMedia)
+	if err != nil {
+		return media.Media{}, err
+	}
+
+	err = repo.dapr.SaveState(context.Background(), repo.config.Name, storedMedia.Id.String(), data, nil)
+	if err != nil {
+		return media.Media{}, err
+	}
+
+	return storedMedia, nil
+}
@@ -11,6 +11,7 @@ type S3 interface {
 	Create(data []byte, key string) error
 	Upload(path string, key string) error
 	Presign(ttl string, key string) (string, error)
+	Exists(key string) (bool, error)
 }
 
 type DaprS3 struct {
@@ -66,3 +67,38 @@ func (s3 *DaprS3) Presign(ttl string, key string) (string, error) {
 
 	return data.PresignURL, nil
 }
+
+// Exists lists the objects starting with key, because the binding has no operation to look up a single object
+func (s3 *DaprS3) Exists(key string) (bool, error) {
+	data, err := json.Marshal(struct {
+		MaxResults int    `json:"maxResults"`
+		Prefix     string `json:"prefix"`
+	}{1, key})
+	if err != nil {
+		return false, err
+	}
+
+	invokeBindingRequest := client.InvokeBindingRequest{
+		Name:      s3.config.Name,
+		Operation: "list",
+		Data:      data,
+	}
+
+	response, err := s3.client.InvokeBinding(context.Background(), &invokeBindingRequest)
+	if err != nil {
+		return false, err
+	}
+
+	listing := struct {
+		Contents []struct {
+			Key string
+		}
+	}{}
+
+	err = json.Unmarshal(response.Data, &listing)
+	if err != nil {
+		return false, err
+	}
+
+	return len(listing.Contents) > 0 && listing.Contents[0].Key == key, nil
+}
@@ -10,6 +10,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
+	media "yatc/media/pkg/media"
 )
 
 type Metadata struct {
@@ -19,26 +21,29 @@ type Metadata struct {
 type Media struct {
 	metadata Metadata
 	fileName string
+	userId   uuid.UUID
 	reader   io.Reader
 }
 
 type Service interface {
-	UploadFile(media *Media) (string, error)
+	UploadFile(upload *Media) (string, error)
 	DownloadFile(mediaId string, compressed bool) (string, error)
+	GetMedia(mediaId uuid.UUID) (media.Media, error)
 }
 
 type MediaService struct {
 	s3        S3
+	repo      Repository
 	publisher Publisher
 }
 
-func NewMediaService(s3 S3, publisher Publisher) *MediaService {
-	return &MediaService{s3, publisher}
+func NewMediaService(s3 S3, repo Repository, publisher Publisher) *MediaService {
+	return &MediaService{s3, repo, publisher}
 }
 
-func (service *MediaService) UploadFile(media *Media) (string, error) {
+func (service *MediaService) UploadFile(upload *Media) (string, error) {
 	mediaId := uuid.New()
-	key := fmt.Sprintf("%s.%s", mediaId, media.metadata.Extension)
+	key := fmt.Sprintf("%s.%s", mediaId, upload.metadata.Extension)
 
 	file, err := os.Create(fmt.Sprintf("/tmp/tmp-%s", mediaId.String()))
 	if err != nil {
@@ -46,7 +51,7 @@ func (service *MediaService) UploadFile(media *Media) (string, error) {
 	}
 	encoder := base64.NewEncoder(base64.StdEncoding, file)
 
-	_, err = io.Copy(encoder, media.reader)
+	_, err = io.Copy(encoder, upload.reader)
 	if err != nil {
 		return "", err
 	}
@@ -66,6 +71,17 @@ func (service *MediaService) UploadFile(media *Media) (string, error) {
 		return "", err
 	}
 
+	// Stored before the conversion is triggered, so the owner is known as soon as the media is
+	_, err = service.repo.Save(media.Media{
+		Id:        mediaId,
+		UserId:    upload.userId,
+		Extension: upload.metadata.Extension,
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return "", err
+	}
+
 	err = service.publisher.Publish(key)
 	if err != nil {
 		return "", err
@@ -104,3 +120,31 @@ func (service *MediaService) DownloadFile(mediaId string, compressed bool) (stri
 		return service.s3.Presign("5m", mediaId)
 	}
 }
+
+// GetMedia returns the stored media. Whether it was converted is looked up in S3 until the compressed version exists.
+func (service *MediaService) GetMedia(mediaId uuid.UUID) (media.Media, error) {
+	storedMedia, err := service.repo.Get(mediaId)
+	if err != nil {
+		return media.Media{}, err
+	}
+
+	if storedMedia.Converted {
+		return storedMedia, nil
+	}
+
+	compressionExtension, err := getCompressionExtension(fmt.Sprintf(".%s", storedMedia.Extension))
+	if err != nil {
+		return media.Media{}, err
+	}
+
+	converted, err := service.s3.Exists(fmt.Sprintf("%s.%s", mediaId, compressionExtension))
+	if err != nil {
+		return media.Media{}, err
+	}
+	if !converted {
+		return storedMedia, nil
+	}
+
+	storedMedia.Converted = true
+	return service.repo.Save(storedMedia)
+}
@@ -0,0 +1,56 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"net/http"
+	"yatc/internal"
+	api "yatc/media/pkg"
+)
+
+type MediaClient struct {
+	httpClient api.ClientInterface
+}
+
+func NewMediaClient(config internal.DaprConfig) *MediaClient {
+	server := fmt.Sprintf("%s:%s", config.Host, config.HttpPort)
+
+	traceRequestFn := api.WithRequestEditorFn(internal.OapiClientTraceRequestFn())
+	authRequestFn := api.WithRequestEditorFn(internal.OapiClientAuthRequestFn())
+
+	daprHeaderFn := api.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		if config.AppId == "" {
+			config.AppId = "media-service"
+		}
+		req.Header.Add("dapr-app-id", config.AppId)
+		return nil
+	})
+
+	httpClient, _ := api.NewClient(server, traceRequestFn, daprHeaderFn, authRequestFn)
+
+	return &MediaClient{httpClient}
+}
+
+func (client *MediaClient) GetMedia(mediaId uuid.UUID) (Media, error) {
+	response, err := client.httpClient.GetMediaMetadata(context.Background(), mediaId)
+	clientError := internal.ToClientError(response, err)
+	if clientError != nil {
+		return Media{}, clientError
+	}
+
+	var metadataResponse api.MediaMetadataResponse
+	err = render.DecodeJSON(response.Body, &metadataResponse)
+	if err != nil {
+		return Media{}, err
+	}
+
+	return Media{
+		Id:        metadataResponse.MediaId,
+		UserId:    metadataResponse.UserId,
+		Extension: metadataResponse.Extension,
+		Converted: metadataResponse.Converted,
+		CreatedAt: metadataResponse.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,19 @@
+package media
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Media struct {
+	Id        uuid.UUID
+	UserId    uuid.UUID
+	Extension string
+	// Converted is true once the compressed version was uploaded
+	Converted bool
+	CreatedAt time.Time
+}
+
+type Service interface {
+	GetMedia(mediaId uuid.UUID) (Media, error)
+}
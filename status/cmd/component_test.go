@@ -32,7 +32,7 @@ func Test_GetStatus(t *testing.T) {
 		panic(err)
 	}
 
-	status, err := client.GetStatusWithResponse(context.Background(), (*response.JSON201).Id)
+	status, err := client.GetStatusWithResponse(context.Background(), (*response.JSON201).Id, &statuses.GetStatusParams{XUser: &userId})
 	if err != nil {
 		return
 	}
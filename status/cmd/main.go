@@ -1,12 +1,18 @@
 package main
 
 import (
+	"context"
 	dapr "github.com/dapr/go-sdk/client"
+	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	"go.uber.org/zap"
 	"strconv"
+	"time"
 	"yatc/internal"
+	"yatc/media/pkg/media"
 	statuses "yatc/status/internal"
+	"yatc/user/pkg/followers"
+	"yatc/user/pkg/users"
 )
 
 func main() {
@@ -21,28 +27,35 @@ func main() {
 	}
 	defer client.Close()
 
-	/*	db, err := sqlx.Connect("postgres", config.Database)
+	publisher := statuses.NewDaprStatusPublisher(client, config.Dapr.PubSub)
+
+	var repo statuses.Repository
+	switch config.Repository {
+	case internal.RepositoryInMemory:
+		repo = statuses.NewInMemoryRepo()
+	case internal.RepositoryPostgres:
+		db, err := sqlx.Connect("postgres", config.Database)
 		if err != nil {
 			logger.Fatal("cant connect to database", zap.Error(err))
 		}
 		defer db.Close()
 
-		//TODO: Temporary use migration?
-		schema := `CREATE TABLE IF NOT EXISTS statuses (
-				id UUID PRIMARY KEY,
-				content TEXT,
-				user_id UUID
-			);`
-
-		_, err = db.Exec(schema)
+		version, err := statuses.MigrateUp(db)
 		if err != nil {
-			logger.Fatal("cant apply default scheme to database", zap.Error(err))
-		}*/
+			logger.Fatal("cant migrate database", zap.Error(err))
+		}
+		logger.Info("database migrated", zap.Int("version", version))
+		repo = statuses.NewPostgresRepo(db)
+	case internal.RepositoryDapr:
+		repo = statuses.NewDaprStateStore(client, config.Dapr.StateStore)
+	default:
+		logger.Fatal("unknown repository", zap.String("repository", config.Repository))
+	}
 
-	publisher := statuses.NewDaprStatusPublisher(client, config.Dapr.PubSub)
-	//repo := statuses.NewInMemoryRepo()
-	repo := statuses.NewDaprStateStore(client, config.Dapr.StateStore) //statuses.NewPostgresRepo(db)
-	service := statuses.NewStatusService(repo, publisher)
+	userClient := users.NewUserClient(config.Dapr)
+	followerClient := followers.NewFollowerClient(config.Dapr)
+	mediaClient := media.NewMediaClient(config.Dapr)
+	service := statuses.NewStatusService(repo, publisher, userClient, followerClient, mediaClient, config.Status)
 	api := statuses.NewStatusApi(service)
 
 	port, err := strconv.Atoi(config.Port)
@@ -50,7 +63,19 @@ func main() {
 		logger.Fatal("port not a int", zap.String("port", config.Port))
 	}
 
-	server := internal.NewServer(logger, port)
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	relay := statuses.NewRelay(repo, publisher, logger, time.Second)
+	go relay.Run(workerCtx)
+	scheduler := statuses.NewScheduler(repo, logger, time.Second)
+	go scheduler.Run(workerCtx)
+	purger := statuses.NewPurger(repo, logger, time.Minute, config.Status.RestoreWindow)
+	go purger.Run(workerCtx)
+
+	idempotencyStore := internal.NewDaprIdempotencyStore(client, config.Dapr.StateStore)
+	idempotency := internal.NewIdempotency(idempotencyStore, config.Idempotency.TTL)
+	rateLimiter := internal.NewRateLimiter(internal.NewDaprRateLimitStore(client, config.Dapr.StateStore), config.RateLimits)
+	server := internal.NewServer(logger, port, rateLimiter.Middleware(), idempotency.Middleware("/statuses"))
 	server.Router.Route("/", api.ConfigureRouter)
 
 	server.StartAndWait()
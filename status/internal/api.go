@@ -3,6 +3,7 @@ package statuses
 import (
 	"context"
 	"errors"
+	"fmt"
 	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
@@ -22,12 +23,94 @@ func StatusFromCreateStatusRequest(request statuses.CreateStatusRequest, userId
 		mediaIds = *request.MediaIds
 	}
 
+	var visibility statuses.Visibility
+	if request.Visibility != nil {
+		visibility = *request.Visibility
+	}
+
+	var spoilerText string
+	if request.SpoilerText != nil {
+		spoilerText = *request.SpoilerText
+	}
+
+	var sensitive bool
+	if request.Sensitive != nil {
+		sensitive = *request.Sensitive
+	}
+
+	var poll *statuses.Poll
+	if request.Poll != nil {
+		poll = &statuses.Poll{Options: request.Poll.Options, ExpiresAt: request.Poll.ExpiresAt}
+		if request.Poll.Multiple != nil {
+			poll.Multiple = *request.Poll.Multiple
+		}
+	}
+
 	return statuses.Status{
-		Id:       uuid.New(),
-		Content:  request.Content,
-		UserId:   userId,
-		MediaIds: mediaIds,
+		Id:          uuid.New(),
+		Content:     request.Content,
+		UserId:      userId,
+		MediaIds:    mediaIds,
+		InReplyToId: request.InReplyToId,
+		PublishAt:   request.PublishAt,
+		Visibility:  visibility,
+		Poll:        poll,
+		SpoilerText: spoilerText,
+		Sensitive:   sensitive,
+	}
+}
+
+// callerOf returns the optional X-user of a request, uuid.Nil for anonymous callers
+func callerOf(xUser *openapi_types.UUID) uuid.UUID {
+	if xUser == nil {
+		return uuid.Nil
+	}
+	return *xUser
+}
+
+func PageQueryFromParams(params statuses.GetStatusesParams) (statuses.PageQuery, error) {
+	query, err := pageQueryOf(params.Limit, params.Cursor)
+	if err != nil {
+		return statuses.PageQuery{}, err
+	}
+
+	query.Since = params.Since
+	query.Until = params.Until
+	return query, nil
+}
+
+func pageQueryOf(limit *int, cursor *string) (statuses.PageQuery, error) {
+	query := statuses.PageQuery{Limit: statuses.DefaultPageLimit}
+
+	if limit != nil {
+		if *limit < 1 || *limit > statuses.MaxPageLimit {
+			return statuses.PageQuery{}, fmt.Errorf("limit has to be between 1 and %d", statuses.MaxPageLimit)
+		}
+		query.Limit = *limit
+	}
+
+	if cursor != nil {
+		parsed, err := statuses.ParseCursor(*cursor)
+		if err != nil {
+			return statuses.PageQuery{}, err
+		}
+		query.Cursor = &parsed
+	}
+
+	return query, nil
+}
+
+func statusesResponseFromPage(page statuses.StatusPage) statuses.StatusesResponse {
+	statusResponses := make([]statuses.StatusResponse, len(page.Statuses))
+	for i, status := range page.Statuses {
+		statusResponses[i] = statuses.StatusResponseFromStatus(status)
+	}
+
+	response := statuses.StatusesResponse{Statuses: statusResponses}
+	if page.Next != nil {
+		response.Next = internal.Ptr(page.Next.String())
 	}
+	return response
 }
 
 func NewStatusApi(service statuses.Service) *Api {
@@ -57,34 +140,127 @@ func (api *Api) CreateStatus(w http.ResponseWriter, r *http.Request, params stat
 	status := StatusFromCreateStatusRequest(createStatusRequest, params.XUser)
 	status, err = service.CreateStatus(context.Background(), status)
 	if err != nil {
-		internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		var validation internal.ValidationError
+		if errors.Is(err, ParentNotFoundError) || errors.As(err, &validation) {
+			internal.ReplyWithError(w, r, err, http.StatusUnprocessableEntity)
+		} else {
+			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		}
 		return
 	}
 
 	internal.ReplyWithStatusWithJSON(w, r, http.StatusCreated, statuses.StatusResponseFromStatus(status))
 }
 
-func (api *Api) GetStatuses(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+func (api *Api) GetStatuses(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params statuses.GetStatusesParams) {
 	service := api.service
-	allStatuses, err := service.GetStatuses(userId)
+	query, err := PageQueryFromParams(params)
+	if err != nil {
+		internal.ReplyWithError(w, r, err, http.StatusBadRequest)
+		return
+	}
+
+	page, err := service.GetStatuses(userId, callerOf(params.XUser), query)
 	if err != nil {
 		internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
 		return
 	}
 
-	statusResponses := make([]statuses.StatusResponse, len(allStatuses))
-	for i, status := range allStatuses {
-		statusResponses[i] = statuses.StatusResponseFromStatus(status)
+	if params.Pinned != nil && *params.Pinned && query.Cursor == nil {
+		pinned, err := service.GetPinnedStatuses(userId, callerOf(params.XUser))
+		if err != nil {
+			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+			return
+		}
+		page = withPinnedFirst(pinned, page)
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, statusesResponseFromPage(page))
+}
+
+func (api *Api) GetTaggedStatuses(w http.ResponseWriter, r *http.Request, tag string, params statuses.GetTaggedStatusesParams) {
+	query, err := pageQueryOf(params.Limit, params.Cursor)
+	if err != nil {
+		internal.ReplyWithError(w, r, err, http.StatusBadRequest)
+		return
+	}
+
+	page, err := api.service.GetTaggedStatuses(tag, query)
+	if err != nil {
+		internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		return
 	}
 
-	internal.ReplyWithStatusOkWithJSON(w, r, statuses.StatusesResponse{Statuses: statusResponses})
+	internal.ReplyWithStatusOkWithJSON(w, r, statusesResponseFromPage(page))
 }
 
-func (api *Api) DeleteStatus(w http.ResponseWriter, r *http.Request, statusId uuid.UUID) {
-	status, err := api.service.DeleteStatus(statusId)
+func (api *Api) GetLikedStatuses(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params statuses.GetLikedStatusesParams) {
+	query, err := pageQueryOf(params.Limit, params.Cursor)
+	if err != nil {
+		internal.ReplyWithError(w, r, err, http.StatusBadRequest)
+		return
+	}
+
+	page, err := api.service.GetLikedStatuses(userId, query)
+	if err != nil {
+		internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		return
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, statusesResponseFromPage(page))
+}
+
+func (api *Api) GetLikes(w http.ResponseWriter, r *http.Request, statusId uuid.UUID, params statuses.GetLikesParams) {
+	query, err := pageQueryOf(params.Limit, params.Cursor)
+	if err != nil {
+		internal.ReplyWithError(w, r, err, http.StatusBadRequest)
+		return
+	}
+
+	page, err := api.service.GetLikes(statusId, callerOf(params.XUser), query)
+	if err != nil {
+		if errors.Is(err, internal.NotFoundError(statusId)) {
+			internal.ReplyWithError(w, r, err, http.StatusNotFound)
+		} else {
+			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	likeResponses := make([]statuses.LikeResponse, len(page.Likes))
+	for i, like := range page.Likes {
+		likeResponses[i] = statuses.LikeResponseFromLike(like)
+	}
+
+	response := statuses.LikesResponse{Likes: likeResponses}
+	if page.Next != nil {
+		response.Next = internal.Ptr(page.Next.String())
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, response)
+}
+
+func (api *Api) CreateLike(w http.ResponseWriter, r *http.Request, statusId uuid.UUID, params statuses.CreateLikeParams) {
+	status, err := api.service.CreateLike(context.Background(), statusId, params.XUser)
 	if err != nil {
 		if errors.Is(err, internal.NotFoundError(statusId)) {
 			internal.ReplyWithError(w, r, err, http.StatusNotFound)
+		} else if errors.Is(err, AlreadyLikedError) {
+			internal.ReplyWithError(w, r, err, http.StatusConflict)
+		} else {
+			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	internal.ReplyWithStatusWithJSON(w, r, http.StatusCreated, statuses.StatusResponseFromStatus(status))
+}
+
+func (api *Api) DeleteLike(w http.ResponseWriter, r *http.Request, statusId uuid.UUID, params statuses.DeleteLikeParams) {
+	status, err := api.service.DeleteLike(context.Background(), statusId, params.XUser)
+	if err != nil {
+		if errors.Is(err, internal.NotFoundError(statusId)) || errors.Is(err, NotLikedError) {
+			internal.ReplyWithError(w, r, err, http.StatusNotFound)
 		} else {
 			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
 		}
@@ -94,8 +270,257 @@ func (api *Api) DeleteStatus(w http.ResponseWriter, r *http.Request, statusId uu
 	internal.ReplyWithStatusOkWithJSON(w, r, statuses.StatusResponseFromStatus(status))
 }
 
-func (api *Api) GetStatus(w http.ResponseWriter, r *http.Request, statusId uuid.UUID) {
-	status, err := api.service.GetStatus(statusId)
+func (api *Api) UpdateStatus(w http.ResponseWriter, r *http.Request, statusId uuid.UUID, params statuses.UpdateStatusParams) {
+	var updateStatusRequest statuses.UpdateStatusRequest
+	err := render.Decode(r, &updateStatusRequest)
+	if err != nil {
+		internal.ReplyWithError(w, r, err, http.StatusBadRequest)
+		return
+	}
+
+	edit := statuses.StatusEdit{
+		Content:     updateStatusRequest.Content,
+		MediaIds:    updateStatusRequest.MediaIds,
+		SpoilerText: updateStatusRequest.SpoilerText,
+		Sensitive:   updateStatusRequest.Sensitive,
+	}
+	status, err := api.service.UpdateStatus(context.Background(), statusId, params.XUser, edit)
+	if err != nil {
+		var validation internal.ValidationError
+		if errors.Is(err, internal.NotFoundError(statusId)) {
+			internal.ReplyWithError(w, r, err, http.StatusNotFound)
+		} else if errors.Is(err, NotAuthorError) {
+			internal.ReplyWithError(w, r, err, http.StatusForbidden)
+		} else if errors.As(err, &validation) {
+			internal.ReplyWithError(w, r, err, http.StatusUnprocessableEntity)
+		} else {
+			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, statuses.StatusResponseFromStatus(status))
+}
+
+func (api *Api) GetStatusHistory(w http.ResponseWriter, r *http.Request, statusId uuid.UUID, params statuses.GetStatusHistoryParams) {
+	revisions, err := api.service.GetStatusHistory(statusId, callerOf(params.XUser))
+	if err != nil {
+		if errors.Is(err, internal.NotFoundError(statusId)) {
+			internal.ReplyWithError(w, r, err, http.StatusNotFound)
+		} else {
+			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	revisionResponses := make([]statuses.StatusRevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		revisionResponses[i] = statuses.StatusRevisionResponseFromRevision(revision)
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, statuses.StatusHistoryResponse{Revisions: revisionResponses})
+}
+
+func (api *Api) CreateRepost(w http.ResponseWriter, r *http.Request, statusId uuid.UUID, params statuses.CreateRepostParams) {
+	status, err := api.service.CreateRepost(context.Background(), statusId, params.XUser)
+	if err != nil {
+		if errors.Is(err, internal.NotFoundError(statusId)) {
+			internal.ReplyWithError(w, r, err, http.StatusNotFound)
+		} else if errors.Is(err, NotRepostableError) {
+			internal.ReplyWithError(w, r, err, http.StatusForbidden)
+		} else if errors.Is(err, AlreadyRepostedError) {
+			internal.ReplyWithError(w, r, err, http.StatusConflict)
+		} else {
+			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	internal.ReplyWithStatusWithJSON(w, r, http.StatusCreated, statuses.StatusResponseFromStatus(status))
+}
+
+func (api *Api) DeleteRepost(w http.ResponseWriter, r *http.Request, statusId uuid.UUID, params statuses.DeleteRepostParams) {
+	status, err := api.service.DeleteRepost(context.Background(), statusId, params.XUser)
+	if err != nil {
+		if errors.Is(err, internal.NotFoundError(statusId)) || errors.Is(err, NotRepostedError) {
+			internal.ReplyWithError(w, r, err, http.StatusNotFound)
+		} else {
+			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, statuses.StatusResponseFromStatus(status))
+}
+
+func (api *Api) GetStatusContext(w http.ResponseWriter, r *http.Request, statusId uuid.UUID, params statuses.GetStatusContextParams) {
+	statusContext, err := api.service.GetStatusContext(statusId, callerOf(params.XUser))
+	if err != nil {
+		if errors.Is(err, internal.NotFoundError(statusId)) {
+			internal.ReplyWithError(w, r, err, http.StatusNotFound)
+		} else {
+			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, statuses.StatusContextResponseFromStatusContext(statusContext))
+}
+
+func (api *Api) DeleteStatus(w http.ResponseWriter, r *http.Request, statusId uuid.UUID, params statuses.DeleteStatusParams) {
+	status, err := api.service.DeleteStatus(context.Background(), statusId, params.XUser)
+	if err != nil {
+		if errors.Is(err, internal.NotFoundError(statusId)) {
+			internal.ReplyWithError(w, r, err, http.StatusNotFound)
+		} else if errors.Is(err, NotAuthorError) {
+			internal.ReplyWithError(w, r, err, http.StatusForbidden)
+		} else {
+			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, statuses.StatusResponseFromStatus(status))
+}
+
+func (api *Api) RestoreStatus(w http.ResponseWriter, r *http.Request, statusId uuid.UUID, params statuses.RestoreStatusParams) {
+	status, err := api.service.RestoreStatus(context.Background(), statusId, params.XUser)
+	if err != nil {
+		if errors.Is(err, internal.NotFoundError(statusId)) {
+			internal.ReplyWithError(w, r, err, http.StatusNotFound)
+		} else if errors.Is(err, NotAuthorError) {
+			internal.ReplyWithError(w, r, err, http.StatusForbidden)
+		} else if errors.Is(err, NotDeletedError) {
+			internal.ReplyWithError(w, r, err, http.StatusConflict)
+		} else if errors.Is(err, RestoreWindowExpiredError) {
+			internal.ReplyWithError(w, r, err, http.StatusGone)
+		} else {
+			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, statuses.StatusResponseFromStatus(status))
+}
+
+func (api *Api) PinStatus(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, statusId uuid.UUID, params statuses.PinStatusParams) {
+	status, err := api.service.PinStatus(context.Background(), statusId, userId, params.XUser)
+	if err != nil {
+		api.replyWithPinError(w, r, statusId, err)
+		return
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, statuses.StatusResponseFromStatus(status))
+}
+
+func (api *Api) UnpinStatus(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, statusId uuid.UUID, params statuses.UnpinStatusParams) {
+	status, err := api.service.UnpinStatus(context.Background(), statusId, userId, params.XUser)
+	if err != nil {
+		api.replyWithPinError(w, r, statusId, err)
+		return
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, statuses.StatusResponseFromStatus(status))
+}
+
+func (api *Api) replyWithPinError(w http.ResponseWriter, r *http.Request, statusId uuid.UUID, err error) {
+	if errors.Is(err, internal.NotFoundError(statusId)) {
+		internal.ReplyWithError(w, r, err, http.StatusNotFound)
+	} else if errors.Is(err, NotOwnPinsError) || errors.Is(err, NotAuthorError) {
+		internal.ReplyWithError(w, r, err, http.StatusForbidden)
+	} else if errors.Is(err, AlreadyPinnedError) || errors.Is(err, NotPinnedError) {
+		internal.ReplyWithError(w, r, err, http.StatusConflict)
+	} else if errors.Is(err, TooManyPinsError) {
+		internal.ReplyWithError(w, r, err, http.StatusUnprocessableEntity)
+	} else {
+		internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+	}
+}
+
+func (api *Api) GetScheduledStatuses(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params statuses.GetScheduledStatusesParams) {
+	scheduled, err := api.service.GetScheduledStatuses(userId, params.XUser)
+	if err != nil {
+		if errors.Is(err, NotOwnScheduleError) {
+			internal.ReplyWithError(w, r, err, http.StatusForbidden)
+		} else {
+			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, statusesResponseFromPage(statuses.StatusPage{Statuses: scheduled}))
+}
+
+func (api *Api) RescheduleStatus(w http.ResponseWriter, r *http.Request, statusId uuid.UUID, params statuses.RescheduleStatusParams) {
+	var rescheduleRequest statuses.RescheduleStatusRequest
+	err := render.Decode(r, &rescheduleRequest)
+	if err != nil {
+		internal.ReplyWithError(w, r, err, http.StatusBadRequest)
+		return
+	}
+
+	status, err := api.service.RescheduleStatus(context.Background(), statusId, params.XUser, rescheduleRequest.PublishAt)
+	if err != nil {
+		api.replyWithScheduleError(w, r, statusId, err)
+		return
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, statuses.StatusResponseFromStatus(status))
+}
+
+func (api *Api) CancelScheduledStatus(w http.ResponseWriter, r *http.Request, statusId uuid.UUID, params statuses.CancelScheduledStatusParams) {
+	status, err := api.service.CancelScheduledStatus(context.Background(), statusId, params.XUser)
+	if err != nil {
+		api.replyWithScheduleError(w, r, statusId, err)
+		return
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, statuses.StatusResponseFromStatus(status))
+}
+
+func (api *Api) replyWithScheduleError(w http.ResponseWriter, r *http.Request, statusId uuid.UUID, err error) {
+	var validation internal.ValidationError
+	if errors.Is(err, internal.NotFoundError(statusId)) {
+		internal.ReplyWithError(w, r, err, http.StatusNotFound)
+	} else if errors.Is(err, NotAuthorError) {
+		internal.ReplyWithError(w, r, err, http.StatusForbidden)
+	} else if errors.Is(err, NotScheduledError) {
+		internal.ReplyWithError(w, r, err, http.StatusConflict)
+	} else if errors.As(err, &validation) {
+		internal.ReplyWithError(w, r, err, http.StatusUnprocessableEntity)
+	} else {
+		internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+	}
+}
+
+func (api *Api) VotePoll(w http.ResponseWriter, r *http.Request, statusId uuid.UUID, params statuses.VotePollParams) {
+	var voteRequest statuses.PollVoteRequest
+	err := render.Decode(r, &voteRequest)
+	if err != nil {
+		internal.ReplyWithError(w, r, err, http.StatusBadRequest)
+		return
+	}
+
+	poll, err := api.service.VotePoll(context.Background(), statusId, params.XUser, voteRequest.Choices)
+	if err != nil {
+		var validation internal.ValidationError
+		if errors.Is(err, internal.NotFoundError(statusId)) || errors.Is(err, NoPollError) {
+			internal.ReplyWithError(w, r, err, http.StatusNotFound)
+		} else if errors.Is(err, AlreadyVotedError) || errors.Is(err, PollExpiredError) {
+			internal.ReplyWithError(w, r, err, http.StatusConflict)
+		} else if errors.As(err, &validation) {
+			internal.ReplyWithError(w, r, err, http.StatusUnprocessableEntity)
+		} else {
+			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, statuses.PollResponseFromPoll(poll))
+}
+
+func (api *Api) GetStatus(w http.ResponseWriter, r *http.Request, statusId uuid.UUID, params statuses.GetStatusParams) {
+	status, err := api.service.GetStatus(statusId, callerOf(params.XUser))
 	if err != nil {
 		if errors.Is(err, internal.NotFoundError(statusId)) {
 			internal.ReplyWithError(w, r, err, http.StatusNotFound)
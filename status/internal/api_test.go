@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -11,23 +12,60 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 	"yatc/internal"
 	statuses "yatc/status/pkg"
 )
 
 type MockService struct {
-	statuses []statuses.Status
+	statuses  []statuses.Status
+	lastQuery statuses.PageQuery
+	next      *statuses.Cursor
+	reposters map[uuid.UUID]bool
+	likes     []statuses.Like
+	lastTag   string
 }
 
 func NewMockService() *MockService {
-	return &MockService{}
+	return &MockService{reposters: map[uuid.UUID]bool{}}
 }
 
-func (service *MockService) GetStatuses(userId uuid.UUID) ([]statuses.Status, error) {
-	return service.statuses, nil
+func (service *MockService) GetStatuses(userId uuid.UUID, callerId uuid.UUID, query statuses.PageQuery) (statuses.StatusPage, error) {
+	service.lastQuery = query
+	visible := make([]statuses.Status, 0)
+	for _, status := range service.statuses {
+		if status.VisibleTo(callerId, false) {
+			visible = append(visible, status)
+		}
+	}
+	return statuses.StatusPage{Statuses: visible, Next: service.next}, nil
+}
+
+func (service *MockService) GetPinnedStatuses(userId uuid.UUID, callerId uuid.UUID) ([]statuses.Status, error) {
+	pinned := make([]statuses.Status, 0)
+	for _, status := range service.statuses {
+		if status.UserId == userId && status.PinnedAt != nil && status.VisibleTo(callerId, false) {
+			pinned = append(pinned, status)
+		}
+	}
+	return pinned, nil
 }
 
-func (service *MockService) GetStatus(statusId uuid.UUID) (statuses.Status, error) {
+func (service *MockService) GetTaggedStatuses(tag string, query statuses.PageQuery) (statuses.StatusPage, error) {
+	service.lastTag = tag
+	service.lastQuery = query
+	return statuses.StatusPage{Statuses: service.statuses, Next: service.next}, nil
+}
+
+func (service *MockService) GetStatus(statusId uuid.UUID, callerId uuid.UUID) (statuses.Status, error) {
+	status, err := service.find(statusId)
+	if err != nil || !status.VisibleTo(callerId, false) {
+		return statuses.Status{}, internal.NotFoundError(statusId)
+	}
+	return status, nil
+}
+
+func (service *MockService) find(statusId uuid.UUID) (statuses.Status, error) {
 	for _, status := range service.statuses {
 		if status.Id == statusId {
 			return status, nil
@@ -37,13 +75,147 @@ func (service *MockService) GetStatus(statusId uuid.UUID) (statuses.Status, erro
 }
 
 func (service *MockService) CreateStatus(ctx context.Context, status statuses.Status) (statuses.Status, error) {
+	if status.Content == "" {
+		return statuses.Status{}, internal.ValidationError{{Field: "content", Message: "must not be empty without media"}}
+	}
+	if status.InReplyToId != nil {
+		_, err := service.find(*status.InReplyToId)
+		if err != nil {
+			return statuses.Status{}, ParentNotFoundError
+		}
+	}
 	service.statuses = append(service.statuses, status)
 	return status, nil
 }
 
-func (service *MockService) DeleteStatus(statusId uuid.UUID) (statuses.Status, error) {
+func (service *MockService) GetStatusContext(statusId uuid.UUID, callerId uuid.UUID) (statuses.StatusContext, error) {
+	status, err := service.GetStatus(statusId, callerId)
+	if err != nil {
+		return statuses.StatusContext{}, err
+	}
+
+	ancestors := make([]statuses.Status, 0)
+	if status.InReplyToId != nil {
+		parent, err := service.find(*status.InReplyToId)
+		if err != nil {
+			return statuses.StatusContext{}, err
+		}
+		ancestors = append(ancestors, parent)
+	}
+
+	descendants := make([]statuses.Thread, 0)
+	for _, reply := range service.statuses {
+		if reply.InReplyToId != nil && *reply.InReplyToId == statusId {
+			descendants = append(descendants, statuses.Thread{Status: reply, Replies: []statuses.Thread{}})
+		}
+	}
+
+	return statuses.StatusContext{Ancestors: ancestors, Descendants: descendants}, nil
+}
+
+func (service *MockService) GetStatusHistory(statusId uuid.UUID, callerId uuid.UUID) ([]statuses.Revision, error) {
+	_, err := service.GetStatus(statusId, callerId)
+	if err != nil {
+		return nil, err
+	}
+	return []statuses.Revision{{StatusId: statusId, Content: "before edit"}}, nil
+}
+
+func (service *MockService) UpdateStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, edit statuses.StatusEdit) (statuses.Status, error) {
+	for i, status := range service.statuses {
+		if status.Id == statusId {
+			if status.UserId != userId {
+				return statuses.Status{}, NotAuthorError
+			}
+			service.statuses[i].Content = edit.Content
+			return service.statuses[i], nil
+		}
+	}
+	return statuses.Status{}, internal.NotFoundError(statusId)
+}
+
+func (service *MockService) CreateRepost(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	for i, status := range service.statuses {
+		if status.Id == statusId {
+			if service.reposters[userId] {
+				return statuses.Status{}, AlreadyRepostedError
+			}
+			service.reposters[userId] = true
+			service.statuses[i].RepostCount++
+			reposted := service.statuses[i]
+			reposted.Repost = &statuses.Repost{StatusId: statusId, UserId: userId, CreatedAt: time.Now()}
+			return reposted, nil
+		}
+	}
+	return statuses.Status{}, internal.NotFoundError(statusId)
+}
+
+func (service *MockService) DeleteRepost(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	for i, status := range service.statuses {
+		if status.Id == statusId {
+			if !service.reposters[userId] {
+				return statuses.Status{}, NotRepostedError
+			}
+			delete(service.reposters, userId)
+			service.statuses[i].RepostCount--
+			return service.statuses[i], nil
+		}
+	}
+	return statuses.Status{}, internal.NotFoundError(statusId)
+}
+
+func (service *MockService) GetLikes(statusId uuid.UUID, callerId uuid.UUID, query statuses.PageQuery) (statuses.LikePage, error) {
+	service.lastQuery = query
+	_, err := service.GetStatus(statusId, callerId)
+	if err != nil {
+		return statuses.LikePage{}, err
+	}
+	return statuses.LikePage{Likes: service.likes, Next: service.next}, nil
+}
+
+func (service *MockService) GetLikedStatuses(userId uuid.UUID, query statuses.PageQuery) (statuses.StatusPage, error) {
+	service.lastQuery = query
+	return statuses.StatusPage{Statuses: service.statuses, Next: service.next}, nil
+}
+
+func (service *MockService) CreateLike(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	for i, status := range service.statuses {
+		if status.Id == statusId {
+			for _, like := range service.likes {
+				if like.UserId == userId {
+					return statuses.Status{}, AlreadyLikedError
+				}
+			}
+			service.likes = append(service.likes, statuses.Like{StatusId: statusId, UserId: userId, CreatedAt: time.Now()})
+			service.statuses[i].LikeCount++
+			return service.statuses[i], nil
+		}
+	}
+	return statuses.Status{}, internal.NotFoundError(statusId)
+}
+
+func (service *MockService) DeleteLike(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	for i, status := range service.statuses {
+		if status.Id == statusId {
+			for j, like := range service.likes {
+				if like.UserId == userId {
+					service.likes = append(service.likes[:j], service.likes[j+1:]...)
+					service.statuses[i].LikeCount--
+					return service.statuses[i], nil
+				}
+			}
+			return statuses.Status{}, NotLikedError
+		}
+	}
+	return statuses.Status{}, internal.NotFoundError(statusId)
+}
+
+func (service *MockService) DeleteStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
 	for i, status := range service.statuses {
 		if status.Id == statusId {
+			if status.UserId != userId {
+				return statuses.Status{}, NotAuthorError
+			}
 			service.statuses = append(service.statuses[:i], service.statuses[i+1:]...)
 			return status, nil
 		}
@@ -51,6 +223,115 @@ func (service *MockService) DeleteStatus(statusId uuid.UUID) (statuses.Status, e
 	return statuses.Status{}, internal.NotFoundError(statusId)
 }
 
+func (service *MockService) GetScheduledStatuses(userId uuid.UUID, callerId uuid.UUID) ([]statuses.Status, error) {
+	if userId != callerId {
+		return nil, NotOwnScheduleError
+	}
+	scheduled := make([]statuses.Status, 0)
+	for _, status := range service.statuses {
+		if status.UserId == userId && status.PublishAt != nil {
+			scheduled = append(scheduled, status)
+		}
+	}
+	return scheduled, nil
+}
+
+func (service *MockService) RescheduleStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, publishAt time.Time) (statuses.Status, error) {
+	for i, status := range service.statuses {
+		if status.Id == statusId {
+			if status.UserId != userId {
+				return statuses.Status{}, NotAuthorError
+			}
+			if status.PublishAt == nil {
+				return statuses.Status{}, NotScheduledError
+			}
+			service.statuses[i].PublishAt = &publishAt
+			return service.statuses[i], nil
+		}
+	}
+	return statuses.Status{}, internal.NotFoundError(statusId)
+}
+
+func (service *MockService) CancelScheduledStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	return service.DeleteStatus(ctx, statusId, userId)
+}
+
+func (service *MockService) RestoreStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	for i, status := range service.statuses {
+		if status.Id == statusId {
+			if status.UserId != userId {
+				return statuses.Status{}, NotAuthorError
+			}
+			if status.DeletedAt == nil {
+				return statuses.Status{}, NotDeletedError
+			}
+			if time.Since(*status.DeletedAt) >= 24*time.Hour {
+				return statuses.Status{}, RestoreWindowExpiredError
+			}
+			service.statuses[i].DeletedAt = nil
+			return service.statuses[i], nil
+		}
+	}
+	return statuses.Status{}, internal.NotFoundError(statusId)
+}
+
+func (service *MockService) PinStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, callerId uuid.UUID) (statuses.Status, error) {
+	return service.changePin(statusId, userId, callerId, func(status *statuses.Status) error {
+		if status.PinnedAt != nil {
+			return AlreadyPinnedError
+		}
+		status.PinnedAt = internal.Ptr(time.Now().UTC())
+		return nil
+	})
+}
+
+func (service *MockService) UnpinStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, callerId uuid.UUID) (statuses.Status, error) {
+	return service.changePin(statusId, userId, callerId, func(status *statuses.Status) error {
+		if status.PinnedAt == nil {
+			return NotPinnedError
+		}
+		status.PinnedAt = nil
+		return nil
+	})
+}
+
+func (service *MockService) changePin(statusId uuid.UUID, userId uuid.UUID, callerId uuid.UUID, change func(status *statuses.Status) error) (statuses.Status, error) {
+	if userId != callerId {
+		return statuses.Status{}, NotOwnPinsError
+	}
+	for i, status := range service.statuses {
+		if status.Id == statusId {
+			if status.UserId != userId {
+				return statuses.Status{}, NotAuthorError
+			}
+			err := change(&service.statuses[i])
+			if err != nil {
+				return statuses.Status{}, err
+			}
+			return service.statuses[i], nil
+		}
+	}
+	return statuses.Status{}, internal.NotFoundError(statusId)
+}
+
+func (service *MockService) VotePoll(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, choices []int) (statuses.Poll, error) {
+	status, err := service.find(statusId)
+	if err != nil {
+		return statuses.Poll{}, err
+	}
+	if status.Poll == nil {
+		return statuses.Poll{}, NoPollError
+	}
+	if len(choices) != 1 {
+		return statuses.Poll{}, internal.ValidationError{{Field: "choices", Message: "must contain one choice, the poll is single choice"}}
+	}
+	if status.Poll.VoterCount > 0 {
+		return statuses.Poll{}, AlreadyVotedError
+	}
+	tally(status.Poll, choices)
+	return *status.Poll, nil
+}
+
 func TestApi_GetStatuses(t *testing.T) {
 	// GIVEN
 	service := NewMockService()
@@ -85,6 +366,64 @@ func TestApi_GetStatuses(t *testing.T) {
 	assert.Equal(t, status2.Id, statusesResponse.Statuses[1].Id)
 	assert.Equal(t, status2.Content, statusesResponse.Statuses[1].Content)
 	assert.Equal(t, status2.UserId, statusesResponse.Statuses[1].UserId)
+	assert.Nil(t, statusesResponse.Next)
+	assert.Equal(t, statuses.DefaultPageLimit, service.lastQuery.Limit)
+}
+
+func TestApi_GetStatuses_Pagination(t *testing.T) {
+	// GIVEN
+	service := NewMockService()
+	api := NewStatusApi(service)
+	userId := uuid.New()
+	cursor := statuses.Cursor{Time: time.Now().UTC(), Id: uuid.New()}
+	next := statuses.Cursor{Time: cursor.Time.Add(-time.Minute), Id: uuid.New()}
+	service.next = &next
+
+	router := chi.NewRouter()
+	api.ConfigureRouter(router)
+
+	url := fmt.Sprintf("/users/%s/statuses?limit=5&cursor=%s", userId.String(), cursor.String())
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+
+	// WHEN
+	router.ServeHTTP(rr, req)
+
+	// THEN
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var statusesResponse statuses.StatusesResponse
+	err = json.NewDecoder(rr.Body).Decode(&statusesResponse)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 5, service.lastQuery.Limit)
+	assert.Equal(t, cursor.Id, service.lastQuery.Cursor.Id)
+	assert.True(t, cursor.Time.Equal(service.lastQuery.Cursor.Time))
+	assert.Equal(t, next.String(), *statusesResponse.Next)
+}
+
+func TestApi_GetStatuses_InvalidParams(t *testing.T) {
+	for _, query := range []string{"limit=0", "limit=1000", "cursor=invalid"} {
+		// GIVEN
+		service := NewMockService()
+		api := NewStatusApi(service)
+
+		router := chi.NewRouter()
+		api.ConfigureRouter(router)
+
+		req, err := http.NewRequest(http.MethodGet, "/users/"+uuid.New().String()+"/statuses?"+query, nil)
+		assert.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+
+		// WHEN
+		router.ServeHTTP(rr, req)
+
+		// THEN
+		assert.Equal(t, http.StatusBadRequest, rr.Code, query)
+	}
 }
 
 func TestApi_GetStatus(t *testing.T) {
@@ -117,6 +456,48 @@ func TestApi_GetStatus(t *testing.T) {
 	assert.Equal(t, status.UserId, statusResponse.UserId)
 }
 
+func TestApi_GetStatus_Visibility(t *testing.T) {
+	// GIVEN
+	service := NewMockService()
+	api := NewStatusApi(service)
+	author := uuid.New()
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: author, Visibility: statuses.Followers}
+	service.statuses = []statuses.Status{status}
+
+	router := chi.NewRouter()
+	api.ConfigureRouter(router)
+
+	for _, test := range []struct {
+		name         string
+		caller       *uuid.UUID
+		expectedCode int
+	}{
+		{"author", &author, http.StatusOK},
+		{"someone else", internal.Ptr(uuid.New()), http.StatusNotFound},
+		{"anonymous", nil, http.StatusNotFound},
+	} {
+		req, err := http.NewRequest(http.MethodGet, "/statuses/"+status.Id.String(), nil)
+		assert.NoError(t, err)
+		if test.caller != nil {
+			req.Header.Set("X-user", test.caller.String())
+		}
+
+		rr := httptest.NewRecorder()
+
+		// WHEN
+		router.ServeHTTP(rr, req)
+
+		// THEN
+		assert.Equal(t, test.expectedCode, rr.Code, test.name)
+		if test.expectedCode == http.StatusOK {
+			var statusResponse statuses.StatusResponse
+			err = json.NewDecoder(rr.Body).Decode(&statusResponse)
+			assert.NoError(t, err)
+			assert.Equal(t, statuses.Followers, statusResponse.Visibility)
+		}
+	}
+}
+
 func TestApi_CreateStatus(t *testing.T) {
 	// GIVEN
 	service := NewMockService()
@@ -150,6 +531,351 @@ func TestApi_CreateStatus(t *testing.T) {
 	assert.Equal(t, status.UserId, statusResponse.UserId)
 }
 
+func TestApi_CreateStatus_Reply(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		parentExists bool
+		expectedCode int
+	}{
+		{"reply to existing status", true, http.StatusCreated},
+		{"reply to non existent status", false, http.StatusUnprocessableEntity},
+	} {
+		// GIVEN
+		service := NewMockService()
+		api := NewStatusApi(service)
+		parent := statuses.Status{Id: uuid.New(), Content: "parent status", UserId: uuid.New()}
+		if test.parentExists {
+			service.statuses = []statuses.Status{parent}
+		}
+		createStatusRequest := statuses.CreateStatusRequest{Content: "reply", InReplyToId: &parent.Id}
+		requestBody, err := json.Marshal(createStatusRequest)
+		assert.NoError(t, err)
+
+		router := chi.NewRouter()
+		api.ConfigureRouter(router)
+
+		req, err := http.NewRequest(http.MethodPost, "/statuses", bytes.NewReader(requestBody))
+		assert.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-user", uuid.New().String())
+
+		rr := httptest.NewRecorder()
+
+		// WHEN
+		router.ServeHTTP(rr, req)
+
+		// THEN
+		assert.Equal(t, test.expectedCode, rr.Code, test.name)
+		if test.expectedCode == http.StatusCreated {
+			var statusResponse statuses.StatusResponse
+			err = json.NewDecoder(rr.Body).Decode(&statusResponse)
+			assert.NoError(t, err)
+			assert.Equal(t, &parent.Id, statusResponse.InReplyToId)
+		}
+	}
+}
+
+func TestApi_CreateRepost(t *testing.T) {
+	for _, test := range []struct {
+		name          string
+		exists        bool
+		alreadyRepost bool
+		expectedCode  int
+	}{
+		{"repost", true, false, http.StatusCreated},
+		{"repost twice", true, true, http.StatusConflict},
+		{"status does not exist", false, false, http.StatusNotFound},
+	} {
+		// GIVEN
+		service := NewMockService()
+		api := NewStatusApi(service)
+		status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+		reposter := uuid.New()
+		if test.exists {
+			service.statuses = []statuses.Status{status}
+		}
+		if test.alreadyRepost {
+			service.reposters[reposter] = true
+		}
+
+		router := chi.NewRouter()
+		api.ConfigureRouter(router)
+
+		req, err := http.NewRequest(http.MethodPost, "/statuses/"+status.Id.String()+"/reposts", nil)
+		assert.NoError(t, err)
+		req.Header.Set("X-user", reposter.String())
+
+		rr := httptest.NewRecorder()
+
+		// WHEN
+		router.ServeHTTP(rr, req)
+
+		// THEN
+		assert.Equal(t, test.expectedCode, rr.Code, test.name)
+		if test.expectedCode == http.StatusCreated {
+			var statusResponse statuses.StatusResponse
+			err = json.NewDecoder(rr.Body).Decode(&statusResponse)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, statusResponse.RepostCount)
+			assert.Equal(t, reposter, statusResponse.Repost.UserId)
+			assert.Equal(t, status.UserId, statusResponse.UserId)
+		}
+	}
+}
+
+func TestApi_DeleteRepost(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		reposted     bool
+		expectedCode int
+	}{
+		{"undo repost", true, http.StatusOK},
+		{"undo missing repost", false, http.StatusNotFound},
+	} {
+		// GIVEN
+		service := NewMockService()
+		api := NewStatusApi(service)
+		status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+		reposter := uuid.New()
+		service.statuses = []statuses.Status{status}
+		if test.reposted {
+			_, err := service.CreateRepost(context.Background(), status.Id, reposter)
+			assert.NoError(t, err)
+		}
+
+		router := chi.NewRouter()
+		api.ConfigureRouter(router)
+
+		req, err := http.NewRequest(http.MethodDelete, "/statuses/"+status.Id.String()+"/reposts", nil)
+		assert.NoError(t, err)
+		req.Header.Set("X-user", reposter.String())
+
+		rr := httptest.NewRecorder()
+
+		// WHEN
+		router.ServeHTTP(rr, req)
+
+		// THEN
+		assert.Equal(t, test.expectedCode, rr.Code, test.name)
+		if test.expectedCode == http.StatusOK {
+			var statusResponse statuses.StatusResponse
+			err = json.NewDecoder(rr.Body).Decode(&statusResponse)
+			assert.NoError(t, err)
+			assert.Equal(t, 0, statusResponse.RepostCount)
+		}
+	}
+}
+
+func TestApi_CreateLike(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		exists       bool
+		alreadyLiked bool
+		expectedCode int
+	}{
+		{"like", true, false, http.StatusCreated},
+		{"like twice", true, true, http.StatusConflict},
+		{"status does not exist", false, false, http.StatusNotFound},
+	} {
+		// GIVEN
+		service := NewMockService()
+		api := NewStatusApi(service)
+		status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+		liker := uuid.New()
+		if test.exists {
+			service.statuses = []statuses.Status{status}
+		}
+		if test.alreadyLiked {
+			_, err := service.CreateLike(context.Background(), status.Id, liker)
+			assert.NoError(t, err)
+		}
+
+		router := chi.NewRouter()
+		api.ConfigureRouter(router)
+
+		req, err := http.NewRequest(http.MethodPost, "/statuses/"+status.Id.String()+"/likes", nil)
+		assert.NoError(t, err)
+		req.Header.Set("X-user", liker.String())
+
+		rr := httptest.NewRecorder()
+
+		// WHEN
+		router.ServeHTTP(rr, req)
+
+		// THEN
+		assert.Equal(t, test.expectedCode, rr.Code, test.name)
+		if test.expectedCode == http.StatusCreated {
+			var statusResponse statuses.StatusResponse
+			err = json.NewDecoder(rr.Body).Decode(&statusResponse)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, statusResponse.LikeCount)
+		}
+	}
+}
+
+func TestApi_DeleteLike(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		liked        bool
+		expectedCode int
+	}{
+		{"unlike", true, http.StatusOK},
+		{"unlike without like", false, http.StatusNotFound},
+	} {
+		// GIVEN
+		service := NewMockService()
+		api := NewStatusApi(service)
+		status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+		liker := uuid.New()
+		service.statuses = []statuses.Status{status}
+		if test.liked {
+			_, err := service.CreateLike(context.Background(), status.Id, liker)
+			assert.NoError(t, err)
+		}
+
+		router := chi.NewRouter()
+		api.ConfigureRouter(router)
+
+		req, err := http.NewRequest(http.MethodDelete, "/statuses/"+status.Id.String()+"/likes", nil)
+		assert.NoError(t, err)
+		req.Header.Set("X-user", liker.String())
+
+		rr := httptest.NewRecorder()
+
+		// WHEN
+		router.ServeHTTP(rr, req)
+
+		// THEN
+		assert.Equal(t, test.expectedCode, rr.Code, test.name)
+	}
+}
+
+func TestApi_GetLikes(t *testing.T) {
+	// GIVEN
+	service := NewMockService()
+	api := NewStatusApi(service)
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+	service.statuses = []statuses.Status{status}
+	like := statuses.Like{StatusId: status.Id, UserId: uuid.New(), CreatedAt: time.Now().UTC()}
+	service.likes = []statuses.Like{like}
+	service.next = &statuses.Cursor{Time: like.CreatedAt, Id: like.UserId}
+
+	router := chi.NewRouter()
+	api.ConfigureRouter(router)
+
+	req, err := http.NewRequest(http.MethodGet, "/statuses/"+status.Id.String()+"/likes?limit=1", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+
+	// WHEN
+	router.ServeHTTP(rr, req)
+
+	// THEN
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, 1, service.lastQuery.Limit)
+
+	var likesResponse statuses.LikesResponse
+	err = json.NewDecoder(rr.Body).Decode(&likesResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(likesResponse.Likes))
+	assert.Equal(t, like.UserId, likesResponse.Likes[0].UserId)
+	assert.Equal(t, service.next.String(), *likesResponse.Next)
+}
+
+func TestApi_GetLikedStatuses(t *testing.T) {
+	// GIVEN
+	service := NewMockService()
+	api := NewStatusApi(service)
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+	service.statuses = []statuses.Status{status}
+
+	router := chi.NewRouter()
+	api.ConfigureRouter(router)
+
+	req, err := http.NewRequest(http.MethodGet, "/users/"+uuid.New().String()+"/likes", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+
+	// WHEN
+	router.ServeHTTP(rr, req)
+
+	// THEN
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, statuses.DefaultPageLimit, service.lastQuery.Limit)
+
+	var statusesResponse statuses.StatusesResponse
+	err = json.NewDecoder(rr.Body).Decode(&statusesResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(statusesResponse.Statuses))
+	assert.Equal(t, status.Id, statusesResponse.Statuses[0].Id)
+	assert.Nil(t, statusesResponse.Next)
+}
+
+func TestApi_GetTaggedStatuses(t *testing.T) {
+	// GIVEN
+	service := NewMockService()
+	api := NewStatusApi(service)
+	status := statuses.Status{Id: uuid.New(), Content: "hello #dapr", UserId: uuid.New(), Tags: []string{"dapr"}}
+	service.statuses = []statuses.Status{status}
+
+	router := chi.NewRouter()
+	api.ConfigureRouter(router)
+
+	req, err := http.NewRequest(http.MethodGet, "/tags/Dapr/statuses?limit=5", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+
+	// WHEN
+	router.ServeHTTP(rr, req)
+
+	// THEN
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "Dapr", service.lastTag)
+	assert.Equal(t, 5, service.lastQuery.Limit)
+
+	var statusesResponse statuses.StatusesResponse
+	err = json.NewDecoder(rr.Body).Decode(&statusesResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(statusesResponse.Statuses))
+	assert.Equal(t, []string{"dapr"}, statusesResponse.Statuses[0].Tags)
+}
+
+func TestApi_GetStatusContext(t *testing.T) {
+	// GIVEN
+	service := NewMockService()
+	api := NewStatusApi(service)
+	root := statuses.Status{Id: uuid.New(), Content: "root", UserId: uuid.New()}
+	status := statuses.Status{Id: uuid.New(), Content: "status", UserId: uuid.New(), InReplyToId: &root.Id}
+	reply := statuses.Status{Id: uuid.New(), Content: "reply", UserId: uuid.New(), InReplyToId: &status.Id}
+	service.statuses = []statuses.Status{root, status, reply}
+
+	router := chi.NewRouter()
+	api.ConfigureRouter(router)
+
+	req, err := http.NewRequest(http.MethodGet, "/statuses/"+status.Id.String()+"/context", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+
+	// WHEN
+	router.ServeHTTP(rr, req)
+
+	// THEN
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var contextResponse statuses.StatusContextResponse
+	err = json.NewDecoder(rr.Body).Decode(&contextResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(contextResponse.Ancestors))
+	assert.Equal(t, root.Id, contextResponse.Ancestors[0].Id)
+	assert.Equal(t, 1, len(contextResponse.Descendants))
+	assert.Equal(t, reply.Id, contextResponse.Descendants[0].Status.Id)
+	assert.Equal(t, 0, len(contextResponse.Descendants[0].Replies))
+}
+
 func TestApi_DeleteStatus(t *testing.T) {
 	// GIVEN
 	service := NewMockService()
@@ -162,6 +888,7 @@ func TestApi_DeleteStatus(t *testing.T) {
 
 	req, err := http.NewRequest(http.MethodDelete, "/statuses/"+status.Id.String(), nil)
 	assert.NoError(t, err)
+	req.Header.Set("X-user", status.UserId.String())
 
 	rr := httptest.NewRecorder()
 
@@ -180,6 +907,277 @@ func TestApi_DeleteStatus(t *testing.T) {
 	assert.Equal(t, status.UserId, statusResponse.UserId)
 }
 
+func TestApi_DeleteStatus_NotAuthor(t *testing.T) {
+	// GIVEN
+	service := NewMockService()
+	api := NewStatusApi(service)
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+	service.statuses = []statuses.Status{status}
+
+	router := chi.NewRouter()
+	api.ConfigureRouter(router)
+
+	req, err := http.NewRequest(http.MethodDelete, "/statuses/"+status.Id.String(), nil)
+	assert.NoError(t, err)
+	req.Header.Set("X-user", uuid.New().String())
+
+	rr := httptest.NewRecorder()
+
+	// WHEN
+	router.ServeHTTP(rr, req)
+
+	// THEN
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	assert.Equal(t, []statuses.Status{status}, service.statuses)
+}
+
+func TestApi_GetStatuses_Pinned(t *testing.T) {
+	// GIVEN
+	service := NewMockService()
+	api := NewStatusApi(service)
+	userId := uuid.New()
+	pinned := statuses.Status{Id: uuid.New(), Content: "pinned", UserId: userId, PinnedAt: internal.Ptr(time.Now().UTC())}
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: userId}
+	service.statuses = []statuses.Status{status, pinned}
+
+	router := chi.NewRouter()
+	api.ConfigureRouter(router)
+
+	req, err := http.NewRequest(http.MethodGet, "/users/"+userId.String()+"/statuses?pinned=true", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+
+	// WHEN
+	router.ServeHTTP(rr, req)
+
+	// THEN
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var statusesResponse statuses.StatusesResponse
+	err = json.NewDecoder(rr.Body).Decode(&statusesResponse)
+	assert.NoError(t, err)
+	assert.Len(t, statusesResponse.Statuses, 2)
+	assert.Equal(t, pinned.Id, statusesResponse.Statuses[0].Id)
+	assert.True(t, statusesResponse.Statuses[0].Pinned)
+	assert.Equal(t, status.Id, statusesResponse.Statuses[1].Id)
+	assert.False(t, statusesResponse.Statuses[1].Pinned)
+}
+
+func TestApi_PinStatus(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		method       string
+		pinned       bool
+		caller       bool
+		expectedCode int
+	}{
+		{"author pins", http.MethodPost, false, true, http.StatusOK},
+		{"other user pins", http.MethodPost, false, false, http.StatusForbidden},
+		{"status already pinned", http.MethodPost, true, true, http.StatusConflict},
+		{"author unpins", http.MethodDelete, true, true, http.StatusOK},
+		{"status not pinned", http.MethodDelete, false, true, http.StatusConflict},
+	} {
+		// GIVEN
+		service := NewMockService()
+		api := NewStatusApi(service)
+		status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+		if test.pinned {
+			status.PinnedAt = internal.Ptr(time.Now().UTC())
+		}
+		service.statuses = []statuses.Status{status}
+
+		router := chi.NewRouter()
+		api.ConfigureRouter(router)
+
+		req, err := http.NewRequest(test.method, "/users/"+status.UserId.String()+"/pinned-statuses/"+status.Id.String(), nil)
+		assert.NoError(t, err)
+		callerId := uuid.New()
+		if test.caller {
+			callerId = status.UserId
+		}
+		req.Header.Set("X-user", callerId.String())
+
+		rr := httptest.NewRecorder()
+
+		// WHEN
+		router.ServeHTTP(rr, req)
+
+		// THEN
+		assert.Equal(t, test.expectedCode, rr.Code, test.name)
+		if test.expectedCode == http.StatusOK {
+			var statusResponse statuses.StatusResponse
+			err = json.NewDecoder(rr.Body).Decode(&statusResponse)
+			assert.NoError(t, err, test.name)
+			assert.Equal(t, test.method == http.MethodPost, statusResponse.Pinned, test.name)
+		}
+	}
+}
+
+func TestApi_RestoreStatus(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		deletedSince *time.Duration
+		author       bool
+		expectedCode int
+	}{
+		{"author restores", internal.Ptr(time.Minute), true, http.StatusOK},
+		{"other user restores", internal.Ptr(time.Minute), false, http.StatusForbidden},
+		{"status is not deleted", nil, true, http.StatusConflict},
+		{"restore window ended", internal.Ptr(48 * time.Hour), true, http.StatusGone},
+	} {
+		// GIVEN
+		service := NewMockService()
+		api := NewStatusApi(service)
+		status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+		if test.deletedSince != nil {
+			status.DeletedAt = internal.Ptr(time.Now().UTC().Add(-*test.deletedSince))
+		}
+		service.statuses = []statuses.Status{status}
+
+		router := chi.NewRouter()
+		api.ConfigureRouter(router)
+
+		req, err := http.NewRequest(http.MethodPost, "/statuses/"+status.Id.String()+"/restore", nil)
+		assert.NoError(t, err)
+		callerId := uuid.New()
+		if test.author {
+			callerId = status.UserId
+		}
+		req.Header.Set("X-user", callerId.String())
+
+		rr := httptest.NewRecorder()
+
+		// WHEN
+		router.ServeHTTP(rr, req)
+
+		// THEN
+		assert.Equal(t, test.expectedCode, rr.Code, test.name)
+		if test.expectedCode == http.StatusOK {
+			var statusResponse statuses.StatusResponse
+			err = json.NewDecoder(rr.Body).Decode(&statusResponse)
+			assert.NoError(t, err, test.name)
+			assert.Equal(t, status.Id, statusResponse.Id, test.name)
+			assert.Nil(t, statusResponse.DeletedAt, test.name)
+		}
+	}
+}
+
+func TestApi_UpdateStatus(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		exists       bool
+		author       bool
+		expectedCode int
+	}{
+		{"author edits", true, true, http.StatusOK},
+		{"other user edits", true, false, http.StatusForbidden},
+		{"status does not exist", false, true, http.StatusNotFound},
+	} {
+		// GIVEN
+		service := NewMockService()
+		api := NewStatusApi(service)
+		status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+		if test.exists {
+			service.statuses = []statuses.Status{status}
+		}
+		caller := status.UserId
+		if !test.author {
+			caller = uuid.New()
+		}
+		requestBody, err := json.Marshal(statuses.UpdateStatusRequest{Content: "edited status"})
+		assert.NoError(t, err)
+
+		router := chi.NewRouter()
+		api.ConfigureRouter(router)
+
+		req, err := http.NewRequest(http.MethodPatch, "/statuses/"+status.Id.String(), bytes.NewReader(requestBody))
+		assert.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-user", caller.String())
+
+		rr := httptest.NewRecorder()
+
+		// WHEN
+		router.ServeHTTP(rr, req)
+
+		// THEN
+		assert.Equal(t, test.expectedCode, rr.Code, test.name)
+		if test.expectedCode == http.StatusOK {
+			var statusResponse statuses.StatusResponse
+			err = json.NewDecoder(rr.Body).Decode(&statusResponse)
+			assert.NoError(t, err)
+			assert.Equal(t, "edited status", statusResponse.Content)
+		}
+	}
+}
+
+func TestApi_GetStatusHistory(t *testing.T) {
+	// GIVEN
+	service := NewMockService()
+	api := NewStatusApi(service)
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+	service.statuses = []statuses.Status{status}
+
+	router := chi.NewRouter()
+	api.ConfigureRouter(router)
+
+	req, err := http.NewRequest(http.MethodGet, "/statuses/"+status.Id.String()+"/history", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+
+	// WHEN
+	router.ServeHTTP(rr, req)
+
+	// THEN
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var historyResponse statuses.StatusHistoryResponse
+	err = json.NewDecoder(rr.Body).Decode(&historyResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(historyResponse.Revisions))
+	assert.Equal(t, "before edit", historyResponse.Revisions[0].Content)
+}
+
+func TestApi_FollowersOnly_NonFollower(t *testing.T) {
+	// GIVEN
+	service := NewMockService()
+	api := NewStatusApi(service)
+	author := uuid.New()
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: author, Visibility: statuses.Followers}
+	service.statuses = []statuses.Status{status}
+
+	router := chi.NewRouter()
+	api.ConfigureRouter(router)
+
+	for _, path := range []string{"/history", "/context", "/likes"} {
+		for _, test := range []struct {
+			name         string
+			caller       *uuid.UUID
+			expectedCode int
+		}{
+			{"author", &author, http.StatusOK},
+			{"someone else", internal.Ptr(uuid.New()), http.StatusNotFound},
+			{"anonymous", nil, http.StatusNotFound},
+		} {
+			req, err := http.NewRequest(http.MethodGet, "/statuses/"+status.Id.String()+path, nil)
+			assert.NoError(t, err)
+			if test.caller != nil {
+				req.Header.Set("X-user", test.caller.String())
+			}
+
+			rr := httptest.NewRecorder()
+
+			// WHEN
+			router.ServeHTTP(rr, req)
+
+			// THEN
+			assert.Equal(t, test.expectedCode, rr.Code, "%s %s", path, test.name)
+		}
+	}
+}
+
 func TestApi_GetStatus_NonExistentStatus(t *testing.T) {
 	// GIVEN
 	service := NewMockService()
@@ -212,6 +1210,7 @@ func TestApi_DeleteStatus_NonExistentStatus(t *testing.T) {
 
 	req, err := http.NewRequest(http.MethodDelete, "/statuses/"+nonExistentStatusID.String(), nil)
 	assert.NoError(t, err)
+	req.Header.Set("X-user", uuid.New().String())
 
 	rr := httptest.NewRecorder()
 
@@ -242,3 +1241,238 @@ func TestApi_CreateStatus_InvalidRequest(t *testing.T) {
 	// THEN
 	assert.Equal(t, http.StatusBadRequest, rr.Code)
 }
+
+func TestApi_CreateStatus_Invalid(t *testing.T) {
+	// GIVEN
+	service := NewMockService()
+	api := NewStatusApi(service)
+	requestBody, err := json.Marshal(statuses.CreateStatusRequest{Content: ""})
+	assert.NoError(t, err)
+
+	router := chi.NewRouter()
+	api.ConfigureRouter(router)
+
+	req, err := http.NewRequest(http.MethodPost, "/statuses", bytes.NewReader(requestBody))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-user", uuid.New().String())
+
+	rr := httptest.NewRecorder()
+
+	// WHEN
+	router.ServeHTTP(rr, req)
+
+	// THEN
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+
+	var errorResponse internal.ErrorResponse
+	err = json.NewDecoder(rr.Body).Decode(&errorResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, []internal.FieldError{{Field: "content", Message: "must not be empty without media"}}, errorResponse.Fields)
+}
+
+func TestApi_CreateStatus_IdempotencyKey(t *testing.T) {
+	// GIVEN
+	service := NewMockService()
+	api := NewStatusApi(service)
+	userId := uuid.New()
+
+	router := chi.NewRouter()
+	router.Use(internal.NewIdempotency(internal.NewInMemoryIdempotencyStore(), time.Hour).Middleware("/statuses"))
+	api.ConfigureRouter(router)
+
+	createStatus := func(content string) *httptest.ResponseRecorder {
+		requestBody, err := json.Marshal(statuses.CreateStatusRequest{Content: content})
+		assert.NoError(t, err)
+		req, err := http.NewRequest(http.MethodPost, "/statuses", bytes.NewReader(requestBody))
+		assert.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-user", userId.String())
+		req.Header.Set(internal.IdempotencyKeyHeader, "retried")
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		return rr
+	}
+
+	// WHEN
+	first := createStatus("test status")
+	retry := createStatus("test status")
+	reused := createStatus("other status")
+
+	// THEN
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Equal(t, "true", retry.Header().Get(internal.IdempotentReplayedHeader))
+	assert.Equal(t, first.Body.String(), retry.Body.String())
+	assert.Equal(t, http.StatusUnprocessableEntity, reused.Code)
+	assert.Len(t, service.statuses, 1)
+}
+
+func TestApi_CreateLike_RateLimited(t *testing.T) {
+	// GIVEN
+	service := NewMockService()
+	api := NewStatusApi(service)
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+	service.statuses = append(service.statuses, status)
+	limits := map[string]internal.RateLimit{"POST /statuses/{statusId}/likes": {Requests: 1, Period: time.Minute}}
+
+	router := chi.NewRouter()
+	router.Use(internal.NewRateLimiter(internal.NewInMemoryRateLimitStore(), limits).Middleware())
+	api.ConfigureRouter(router)
+
+	like := func(userId uuid.UUID) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/statuses/%s/likes", status.Id), nil)
+		assert.NoError(t, err)
+		req.Header.Set("X-user", userId.String())
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		return rr
+	}
+	userId := uuid.New()
+
+	// WHEN
+	first := like(userId)
+	limited := like(userId)
+	otherUser := like(uuid.New())
+
+	// THEN
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, "1", first.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "0", first.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, http.StatusTooManyRequests, limited.Code)
+	assert.Equal(t, "60", limited.Header().Get("Retry-After"))
+	assert.Equal(t, http.StatusCreated, otherUser.Code)
+}
+
+func TestApi_GetScheduledStatuses(t *testing.T) {
+	// GIVEN
+	service := NewMockService()
+	api := NewStatusApi(service)
+	userId := uuid.New()
+	publishAt := time.Now().Add(time.Hour).UTC()
+	scheduled := statuses.Status{Id: uuid.New(), Content: "later", UserId: userId, PublishAt: &publishAt}
+	service.statuses = []statuses.Status{scheduled, {Id: uuid.New(), Content: "now", UserId: userId}}
+
+	router := chi.NewRouter()
+	api.ConfigureRouter(router)
+
+	for _, test := range []struct {
+		name         string
+		caller       uuid.UUID
+		expectedCode int
+	}{
+		{"own scheduled statuses", userId, http.StatusOK},
+		{"scheduled statuses of someone else", uuid.New(), http.StatusForbidden},
+	} {
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/users/%s/scheduled-statuses", userId), nil)
+		assert.NoError(t, err)
+		req.Header.Set("X-user", test.caller.String())
+
+		rr := httptest.NewRecorder()
+
+		// WHEN
+		router.ServeHTTP(rr, req)
+
+		// THEN
+		assert.Equal(t, test.expectedCode, rr.Code, test.name)
+		if test.expectedCode == http.StatusOK {
+			var statusesResponse statuses.StatusesResponse
+			err = json.NewDecoder(rr.Body).Decode(&statusesResponse)
+			assert.NoError(t, err)
+			assert.Len(t, statusesResponse.Statuses, 1)
+			assert.Equal(t, scheduled.Id, statusesResponse.Statuses[0].Id)
+			assert.Equal(t, publishAt, *statusesResponse.Statuses[0].PublishAt)
+		}
+	}
+}
+
+func TestApi_RescheduleStatus(t *testing.T) {
+	// GIVEN
+	service := NewMockService()
+	api := NewStatusApi(service)
+	userId := uuid.New()
+	publishAt := time.Now().Add(time.Hour).UTC()
+	scheduled := statuses.Status{Id: uuid.New(), Content: "later", UserId: userId, PublishAt: &publishAt}
+	published := statuses.Status{Id: uuid.New(), Content: "now", UserId: userId}
+	service.statuses = []statuses.Status{scheduled, published}
+	rescheduleAt := publishAt.Add(time.Hour)
+	requestBody, err := json.Marshal(statuses.RescheduleStatusRequest{PublishAt: rescheduleAt})
+	assert.NoError(t, err)
+
+	router := chi.NewRouter()
+	api.ConfigureRouter(router)
+
+	for _, test := range []struct {
+		name         string
+		statusId     uuid.UUID
+		expectedCode int
+	}{
+		{"scheduled status", scheduled.Id, http.StatusOK},
+		{"published status", published.Id, http.StatusConflict},
+		{"non existent status", uuid.New(), http.StatusNotFound},
+	} {
+		req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/statuses/%s/schedule", test.statusId), bytes.NewReader(requestBody))
+		assert.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-user", userId.String())
+
+		rr := httptest.NewRecorder()
+
+		// WHEN
+		router.ServeHTTP(rr, req)
+
+		// THEN
+		assert.Equal(t, test.expectedCode, rr.Code, test.name)
+	}
+	assert.Equal(t, rescheduleAt, *service.statuses[0].PublishAt)
+}
+
+func TestApi_VotePoll(t *testing.T) {
+	// GIVEN
+	service := NewMockService()
+	api := NewStatusApi(service)
+	expiresAt := time.Now().Add(time.Hour).UTC()
+	status := statuses.Status{Id: uuid.New(), Content: "poll", UserId: uuid.New(),
+		Poll: &statuses.Poll{Options: []string{"yes", "no"}, ExpiresAt: expiresAt, Votes: []int{0, 0}}}
+	withoutPoll := statuses.Status{Id: uuid.New(), Content: "no poll", UserId: uuid.New()}
+	service.statuses = []statuses.Status{status, withoutPoll}
+
+	router := chi.NewRouter()
+	api.ConfigureRouter(router)
+
+	for _, test := range []struct {
+		name         string
+		statusId     uuid.UUID
+		choices      []int
+		expectedCode int
+	}{
+		{"invalid choices", status.Id, []int{0, 1}, http.StatusUnprocessableEntity},
+		{"vote", status.Id, []int{1}, http.StatusOK},
+		{"second vote", status.Id, []int{0}, http.StatusConflict},
+		{"status without poll", withoutPoll.Id, []int{0}, http.StatusNotFound},
+		{"non existent status", uuid.New(), []int{0}, http.StatusNotFound},
+	} {
+		requestBody, err := json.Marshal(statuses.PollVoteRequest{Choices: test.choices})
+		assert.NoError(t, err)
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/statuses/%s/poll/votes", test.statusId), bytes.NewReader(requestBody))
+		assert.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-user", uuid.New().String())
+
+		rr := httptest.NewRecorder()
+
+		// WHEN
+		router.ServeHTTP(rr, req)
+
+		// THEN
+		assert.Equal(t, test.expectedCode, rr.Code, test.name)
+		if test.expectedCode == http.StatusOK {
+			var pollResponse statuses.PollResponse
+			err = json.NewDecoder(rr.Body).Decode(&pollResponse)
+			assert.NoError(t, err)
+			assert.Equal(t, []statuses.PollOptionResponse{{Title: "yes", Votes: 0}, {Title: "no", Votes: 1}}, pollResponse.Options)
+			assert.Equal(t, 1, pollResponse.VotersCount)
+			assert.False(t, pollResponse.Expired)
+		}
+	}
+}
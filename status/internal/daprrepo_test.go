@@ -0,0 +1,219 @@
+package statuses
+
+import (
+	"context"
+	"errors"
+	dapr "github.com/dapr/go-sdk/client"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+	"yatc/internal"
+	statuses "yatc/status/pkg"
+)
+
+type fakeStateItem struct {
+	value   []byte
+	version int
+}
+
+// fakeStateStore keeps state in memory and checks etags like a Dapr state store with first-write concurrency,
+// a missing etag only matches a key that doesn't exist
+type fakeStateStore struct {
+	dapr.Client
+	mutex   sync.Mutex
+	items   map[string]fakeStateItem
+	version int
+}
+
+func newFakeStateStore() *fakeStateStore {
+	return &fakeStateStore{items: map[string]fakeStateItem{}}
+}
+
+func (store *fakeStateStore) GetState(ctx context.Context, storeName, key string, meta map[string]string) (*dapr.StateItem, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	item, ok := store.items[key]
+	if !ok {
+		return &dapr.StateItem{Key: key}, nil
+	}
+	return &dapr.StateItem{Key: key, Value: item.value, Etag: strconv.Itoa(item.version)}, nil
+}
+
+func (store *fakeStateStore) GetBulkState(ctx context.Context, storeName string, keys []string, meta map[string]string, parallelism int32) ([]*dapr.BulkStateItem, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	items := make([]*dapr.BulkStateItem, len(keys))
+	for i, key := range keys {
+		items[i] = &dapr.BulkStateItem{Key: key, Value: store.items[key].value}
+	}
+	return items, nil
+}
+
+func (store *fakeStateStore) ExecuteStateTransaction(ctx context.Context, storeName string, meta map[string]string, ops []*dapr.StateOperation) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	for _, op := range ops {
+		if op.Item.Options == nil || op.Item.Options.Concurrency != dapr.StateConcurrencyFirstWrite {
+			continue
+		}
+		item, exists := store.items[op.Item.Key]
+		if op.Item.Etag == nil && exists || op.Item.Etag != nil && (!exists || op.Item.Etag.Value != strconv.Itoa(item.version)) {
+			return errors.New("possible etag mismatch")
+		}
+	}
+
+	for _, op := range ops {
+		if op.Type == dapr.StateOperationTypeDelete {
+			delete(store.items, op.Item.Key)
+			continue
+		}
+		store.version++
+		store.items[op.Item.Key] = fakeStateItem{op.Item.Value, store.version}
+	}
+	return nil
+}
+
+func TestDaprStateStoreRepo_CreateLike_Concurrent(t *testing.T) {
+	// GIVEN
+	repo := NewDaprStateStore(newFakeStateStore(), internal.StateStoreConfig{Name: "statestore"})
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New(), CreatedAt: time.Now().UTC()}
+	_, err := repo.Create(status)
+	assert.NoError(t, err)
+
+	// WHEN
+	likers := 4
+	var wg sync.WaitGroup
+	errs := make([]error, likers)
+	for i := 0; i < likers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = repo.CreateLike(statuses.Like{StatusId: status.Id, UserId: uuid.New(), CreatedAt: time.Now().UTC()})
+		}(i)
+	}
+	wg.Wait()
+
+	// THEN
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	liked, err := repo.Get(status.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, likers, liked.LikeCount)
+	likes, err := repo.ListLikes(status.Id, statuses.PageQuery{Limit: statuses.MaxPageLimit})
+	assert.NoError(t, err)
+	assert.Len(t, likes.Likes, likers)
+}
+
+func TestDaprStateStoreRepo_CreateRepost_Concurrent(t *testing.T) {
+	// GIVEN
+	repo := NewDaprStateStore(newFakeStateStore(), internal.StateStoreConfig{Name: "statestore"})
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New(), CreatedAt: time.Now().UTC()}
+	_, err := repo.Create(status)
+	assert.NoError(t, err)
+
+	// WHEN
+	reposters := 4
+	var wg sync.WaitGroup
+	errs := make([]error, reposters)
+	for i := 0; i < reposters; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = repo.CreateRepost(statuses.Repost{StatusId: status.Id, UserId: uuid.New(), CreatedAt: time.Now().UTC()})
+		}(i)
+	}
+	wg.Wait()
+
+	// THEN
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	reposted, err := repo.Get(status.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, reposters, reposted.RepostCount)
+	all, err := repo.Reposts(status.Id)
+	assert.NoError(t, err)
+	assert.Len(t, all, reposters)
+}
+
+func TestDaprStateStoreRepo_Reposts_LegacyReposters(t *testing.T) {
+	// GIVEN
+	store := newFakeStateStore()
+	repo := NewDaprStateStore(store, internal.StateStoreConfig{Name: "statestore"})
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New(), CreatedAt: time.Now().UTC()}
+	_, err := repo.Create(status)
+	assert.NoError(t, err)
+	legacyReposter := uuid.New()
+	store.items[repostsKey(status.Id)] = fakeStateItem{value: []byte(`["` + legacyReposter.String() + `"]`)}
+	repost := statuses.Repost{StatusId: status.Id, UserId: uuid.New(), CreatedAt: time.Now().UTC()}
+
+	// WHEN
+	_, err = repo.CreateRepost(repost)
+
+	// THEN
+	assert.NoError(t, err)
+	reposts, err := repo.Reposts(status.Id)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []statuses.Repost{{StatusId: status.Id, UserId: legacyReposter}, repost}, reposts)
+}
+
+func TestDaprStateStoreRepo_Update_KeepsConcurrentChanges(t *testing.T) {
+	// GIVEN
+	repo := NewDaprStateStore(newFakeStateStore(), internal.StateStoreConfig{Name: "statestore"})
+	now := time.Now().UTC()
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New(), CreatedAt: now}
+	_, err := repo.Create(status)
+	assert.NoError(t, err)
+	edited := status
+	edited.Content = "edited status"
+	edited.EditedAt = &now
+
+	_, err = repo.CreateLike(statuses.Like{StatusId: status.Id, UserId: uuid.New(), CreatedAt: now})
+	assert.NoError(t, err)
+	_, err = repo.Pin(status.Id, now, 5)
+	assert.NoError(t, err)
+
+	// WHEN
+	updated, err := repo.Update(edited)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, "edited status", updated.Content)
+	assert.Equal(t, 1, updated.LikeCount)
+	assert.Equal(t, &now, updated.PinnedAt)
+
+	gotStatus, err := repo.Get(status.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, updated, gotStatus)
+	history, err := repo.History(status.Id)
+	assert.NoError(t, err)
+	assert.Len(t, history, 1)
+	assert.Equal(t, "test status", history[0].Content)
+}
+
+func TestDaprStateStoreRepo_List(t *testing.T) {
+	// GIVEN
+	repo := NewDaprStateStore(newFakeStateStore(), internal.StateStoreConfig{Name: "statestore"})
+	created := make([]statuses.Status, 20)
+	for i := range created {
+		created[i] = statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New(), CreatedAt: time.Now().UTC()}
+		_, err := repo.Create(created[i])
+		assert.NoError(t, err)
+	}
+	_, err := repo.Delete(created[0].Id)
+	assert.NoError(t, err)
+
+	// WHEN
+	all, err := repo.List()
+
+	// THEN
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, created[1:], all)
+}
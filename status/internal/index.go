@@ -0,0 +1,147 @@
+package statuses
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/google/uuid"
+	"sort"
+	"time"
+	statuses "yatc/status/pkg"
+)
+
+// indexEntry is one status in a per-user index
+type indexEntry struct {
+	Id   uuid.UUID `json:"id"`
+	Time time.Time `json:"time"`
+}
+
+func userIndexKey(userId uuid.UUID) string {
+	return fmt.Sprintf("user-statuses-%s", userId.String())
+}
+
+func repliesIndexKey(statusId uuid.UUID) string {
+	return fmt.Sprintf("status-replies-%s", statusId.String())
+}
+
+func tagIndexKey(tag string) string {
+	return fmt.Sprintf("tag-statuses-%s", tag)
+}
+
+// statusKeysShards is how many documents the keys of all statuses are spread over, so concurrent creates rarely
+// write the same one
+const statusKeysShards = 16
+
+func statusKeysShardKey(shard int) string {
+	return fmt.Sprintf("status-keys-%d", shard)
+}
+
+// statusKeysKey is the shard holding the key of a status
+func statusKeysKey(statusId uuid.UUID) string {
+	return statusKeysShardKey(int(statusId[len(statusId)-1]) % statusKeysShards)
+}
+
+// scheduledIndexKey is the index of all scheduled statuses by publish time, polled for due ones
+const scheduledIndexKey = "scheduled"
+
+func userScheduledIndexKey(userId uuid.UUID) string {
+	return fmt.Sprintf("user-scheduled-%s", userId.String())
+}
+
+// tombstonesIndexKey is the index of all tombstoned statuses by deletion time, polled for expired ones
+const tombstonesIndexKey = "tombstones"
+
+// userPinsIndexKey is the index of the statuses a user pinned to their profile
+func userPinsIndexKey(userId uuid.UUID) string {
+	return fmt.Sprintf("user-pins-%s", userId.String())
+}
+
+// likesIndexKey is the index of the users who liked a status
+func likesIndexKey(statusId uuid.UUID) string {
+	return fmt.Sprintf("status-likes-%s", statusId.String())
+}
+
+// userLikesIndexKey is the index of the statuses a user liked
+func userLikesIndexKey(userId uuid.UUID) string {
+	return fmt.Sprintf("user-likes-%s", userId.String())
+}
+
+func sortNewestFirst(entries []indexEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Time.Equal(entries[j].Time) {
+			return bytes.Compare(entries[i].Id[:], entries[j].Id[:]) > 0
+		}
+		return entries[i].Time.After(entries[j].Time)
+	})
+}
+
+func sortOldestFirst(entries []indexEntry) {
+	sortNewestFirst(entries)
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+}
+
+func containsEntry(entries []indexEntry, id uuid.UUID) bool {
+	for _, entry := range entries {
+		if entry.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+// changedTags returns the tags only in previous and the tags only in current
+func changedTags(previous []string, current []string) ([]string, []string) {
+	removed := make([]string, 0)
+	for _, tag := range previous {
+		if !containsTag(current, tag) {
+			removed = append(removed, tag)
+		}
+	}
+
+	added := make([]string, 0)
+	for _, tag := range current {
+		if !containsTag(previous, tag) {
+			added = append(added, tag)
+		}
+	}
+	return removed, added
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func removeEntry(entries []indexEntry, statusId uuid.UUID) []indexEntry {
+	for i, entry := range entries {
+		if entry.Id == statusId {
+			return append(entries[:i], entries[i+1:]...)
+		}
+	}
+	return entries
+}
+
+// pageOf returns the entries of the page described by query and the cursor of the following page, if there is one
+func pageOf(entries []indexEntry, query statuses.PageQuery) ([]indexEntry, *statuses.Cursor) {
+	sorted := make([]indexEntry, len(entries))
+	copy(sorted, entries)
+	sortNewestFirst(sorted)
+
+	page := make([]indexEntry, 0, query.Limit)
+	for _, entry := range sorted {
+		if !query.Matches(entry.Time, entry.Id) {
+			continue
+		}
+		if len(page) == query.Limit {
+			last := page[len(page)-1]
+			return page, &statuses.Cursor{Time: last.Time, Id: last.Id}
+		}
+		page = append(page, entry)
+	}
+	return page, nil
+}
@@ -0,0 +1,26 @@
+package statuses
+
+import (
+	"regexp"
+	"unicode/utf8"
+	statuses "yatc/status/pkg"
+)
+
+// mentionPattern matches an @ that does not follow a letter, digit, @, / or . (e.g. in mail addresses or urls), up to the end of the username
+var mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@/.])(@[\p{L}\p{N}_]+)`)
+
+// ParseMentions returns the @username mentions of content in order of appearance, nil if there are none.
+// The mentions are not resolved yet, only Username as written, Start and End are set.
+func ParseMentions(content string) []statuses.Mention {
+	var mentions []statuses.Mention
+	for _, match := range mentionPattern.FindAllStringSubmatchIndex(content, -1) {
+		start, end := match[2], match[3]
+		runeStart := utf8.RuneCountInString(content[:start])
+		mentions = append(mentions, statuses.Mention{
+			Username: content[start+1 : end],
+			Start:    runeStart,
+			End:      runeStart + utf8.RuneCountInString(content[start:end]),
+		})
+	}
+	return mentions
+}
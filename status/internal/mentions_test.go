@@ -0,0 +1,22 @@
+package statuses
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	statuses "yatc/status/pkg"
+)
+
+func TestParseMentions(t *testing.T) {
+	for _, test := range []struct {
+		content  string
+		expected []statuses.Mention
+	}{
+		{"no mentions here", nil},
+		{"@hans hello", []statuses.Mention{{Username: "hans", Start: 0, End: 5}}},
+		{"Hi @Hans and @peter_2!", []statuses.Mention{{Username: "Hans", Start: 3, End: 8}, {Username: "peter_2", Start: 13, End: 21}}},
+		{"Grüße (@Jürgen)", []statuses.Mention{{Username: "Jürgen", Start: 7, End: 14}}},
+		{"mail me@example.com or https://example.com/@hans, @@double", nil},
+	} {
+		assert.Equal(t, test.expected, ParseMentions(test.content), test.content)
+	}
+}
@@ -0,0 +1,180 @@
+package statuses
+
+import (
+	"embed"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationLockId keeps instances starting at the same time from migrating concurrently
+const migrationLockId = 8082
+
+// Migration is a versioned schema change, read from <version>_<name>.up.sql and <version>_<name>.down.sql
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// loadMigrations reads the migrations in dir of fsys, ordered by version. Every migration needs an up and a down file.
+func loadMigrations(fsys fs.FS, dir string) ([]Migration, error) {
+	files, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, file := range files {
+		name, direction, found := strings.Cut(strings.TrimSuffix(file.Name(), ".sql"), ".")
+		if !found || (direction != "up" && direction != "down") {
+			return nil, fmt.Errorf("migration %s is neither .up.sql nor .down.sql", file.Name())
+		}
+
+		versionPart, migrationName, _ := strings.Cut(name, "_")
+		version, err := strconv.Atoi(versionPart)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s doesn't start with a version: %w", file.Name(), err)
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: migrationName}
+			byVersion[version] = migration
+		}
+		if direction == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d needs both an up and a down file", migration.Version)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// MigrateUp applies all migrations that weren't applied yet and returns the resulting schema version
+func MigrateUp(db *sqlx.DB) (int, error) {
+	migrations, err := loadMigrations(migrationFiles, "migrations")
+	if err != nil {
+		return 0, err
+	}
+
+	return migrate(db, func(tx *sqlx.Tx, applied map[int]bool) (int, error) {
+		version := 0
+		for _, migration := range migrations {
+			if !applied[migration.Version] {
+				_, err := tx.Exec(migration.Up)
+				if err != nil {
+					return 0, fmt.Errorf("migration %d_%s up: %w", migration.Version, migration.Name, err)
+				}
+				_, err = tx.Exec("INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, now())", migration.Version, migration.Name)
+				if err != nil {
+					return 0, err
+				}
+			}
+			version = migration.Version
+		}
+		return version, nil
+	})
+}
+
+// MigrateDown reverts the applied migrations newer than version, newest first, and returns the resulting schema version
+func MigrateDown(db *sqlx.DB, version int) (int, error) {
+	migrations, err := loadMigrations(migrationFiles, "migrations")
+	if err != nil {
+		return 0, err
+	}
+
+	return migrate(db, func(tx *sqlx.Tx, applied map[int]bool) (int, error) {
+		current := 0
+		for i := len(migrations) - 1; i >= 0; i-- {
+			migration := migrations[i]
+			if !applied[migration.Version] {
+				continue
+			}
+			if migration.Version <= version {
+				current = migration.Version
+				break
+			}
+
+			_, err := tx.Exec(migration.Down)
+			if err != nil {
+				return 0, fmt.Errorf("migration %d_%s down: %w", migration.Version, migration.Name, err)
+			}
+			_, err = tx.Exec("DELETE FROM schema_migrations WHERE version=$1", migration.Version)
+			if err != nil {
+				return 0, err
+			}
+		}
+		return current, nil
+	})
+}
+
+// migrate runs change in one transaction, so a failed migration leaves the schema as it was
+func migrate(db *sqlx.DB, change func(tx *sqlx.Tx, applied map[int]bool) (int, error)) (int, error) {
+	tx, err := db.Beginx()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec("SELECT pg_advisory_xact_lock($1)", migrationLockId)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INT PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL
+	)`)
+	if err != nil {
+		return 0, err
+	}
+
+	versions := make([]int, 0)
+	err = tx.Select(&versions, "SELECT version FROM schema_migrations")
+	if err != nil {
+		return 0, err
+	}
+	applied := make(map[int]bool, len(versions))
+	for _, version := range versions {
+		applied[version] = true
+	}
+
+	version, err := change(tx, applied)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS status_outbox;
+DROP TABLE IF EXISTS status_likes;
+DROP TABLE IF EXISTS status_reposts;
+DROP TABLE IF EXISTS status_revisions;
+DROP TABLE IF EXISTS statuses;
@@ -0,0 +1,59 @@
+CREATE TABLE IF NOT EXISTS statuses (
+    id UUID PRIMARY KEY,
+    content TEXT,
+    user_id UUID,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    edited_at TIMESTAMPTZ,
+    in_reply_to_id UUID,
+    repost_count INT NOT NULL DEFAULT 0,
+    like_count INT NOT NULL DEFAULT 0,
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    mentions JSONB NOT NULL DEFAULT '[]'
+);
+-- Databases deployed before migrations have a statuses table with only id, content and user_id
+ALTER TABLE statuses ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE statuses ALTER COLUMN created_at DROP DEFAULT;
+ALTER TABLE statuses ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE statuses ALTER COLUMN updated_at DROP DEFAULT;
+ALTER TABLE statuses ADD COLUMN IF NOT EXISTS edited_at TIMESTAMPTZ;
+ALTER TABLE statuses ADD COLUMN IF NOT EXISTS in_reply_to_id UUID;
+ALTER TABLE statuses ADD COLUMN IF NOT EXISTS repost_count INT NOT NULL DEFAULT 0;
+ALTER TABLE statuses ADD COLUMN IF NOT EXISTS like_count INT NOT NULL DEFAULT 0;
+ALTER TABLE statuses ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE statuses ADD COLUMN IF NOT EXISTS mentions JSONB NOT NULL DEFAULT '[]';
+
+CREATE INDEX IF NOT EXISTS statuses_user_id_created_at ON statuses (user_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS statuses_tags ON statuses USING GIN (tags);
+CREATE INDEX IF NOT EXISTS statuses_in_reply_to_id ON statuses (in_reply_to_id, created_at);
+
+CREATE TABLE IF NOT EXISTS status_revisions (
+    status_id UUID REFERENCES statuses (id) ON DELETE CASCADE,
+    content TEXT,
+    created_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS status_revisions_status_id ON status_revisions (status_id, created_at);
+
+CREATE TABLE IF NOT EXISTS status_reposts (
+    status_id UUID REFERENCES statuses (id) ON DELETE CASCADE,
+    user_id UUID,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (status_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS status_likes (
+    status_id UUID REFERENCES statuses (id) ON DELETE CASCADE,
+    user_id UUID,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (status_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS status_likes_status_id_created_at ON status_likes (status_id, created_at DESC, user_id DESC);
+CREATE INDEX IF NOT EXISTS status_likes_user_id_created_at ON status_likes (user_id, created_at DESC, status_id DESC);
+
+CREATE TABLE IF NOT EXISTS status_outbox (
+    status_id UUID PRIMARY KEY REFERENCES statuses (id) ON DELETE CASCADE,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    sent_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS status_outbox_pending ON status_outbox (created_at, status_id) WHERE sent_at IS NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE status_revisions DROP COLUMN media_ids;
+ALTER TABLE statuses DROP COLUMN media_ids;
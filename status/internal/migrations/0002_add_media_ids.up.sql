@@ -0,0 +1,2 @@
+ALTER TABLE statuses ADD COLUMN media_ids UUID[] NOT NULL DEFAULT '{}';
+ALTER TABLE status_revisions ADD COLUMN media_ids UUID[] NOT NULL DEFAULT '{}';
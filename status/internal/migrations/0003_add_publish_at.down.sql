@@ -0,0 +1,2 @@
+DROP INDEX statuses_publish_at;
+ALTER TABLE statuses DROP COLUMN publish_at;
@@ -0,0 +1,2 @@
+ALTER TABLE statuses ADD COLUMN publish_at TIMESTAMPTZ;
+CREATE INDEX statuses_publish_at ON statuses (publish_at, id) WHERE publish_at IS NOT NULL;
@@ -0,0 +1 @@
+ALTER TABLE statuses DROP COLUMN visibility;
@@ -0,0 +1 @@
+ALTER TABLE statuses ADD COLUMN visibility TEXT NOT NULL DEFAULT 'public';
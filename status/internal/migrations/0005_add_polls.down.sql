@@ -0,0 +1,4 @@
+DROP TABLE status_poll_votes;
+ALTER TABLE statuses DROP COLUMN poll_voter_count;
+ALTER TABLE statuses DROP COLUMN poll_votes;
+ALTER TABLE statuses DROP COLUMN poll;
@@ -0,0 +1,11 @@
+ALTER TABLE statuses ADD COLUMN poll JSONB;
+ALTER TABLE statuses ADD COLUMN poll_votes INT[] NOT NULL DEFAULT '{}';
+ALTER TABLE statuses ADD COLUMN poll_voter_count INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS status_poll_votes (
+    status_id UUID REFERENCES statuses (id) ON DELETE CASCADE,
+    user_id UUID,
+    choices INT[] NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (status_id, user_id)
+);
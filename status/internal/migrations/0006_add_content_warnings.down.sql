@@ -0,0 +1,2 @@
+ALTER TABLE statuses DROP COLUMN sensitive;
+ALTER TABLE statuses DROP COLUMN spoiler_text;
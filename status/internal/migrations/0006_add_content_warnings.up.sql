@@ -0,0 +1,2 @@
+ALTER TABLE statuses ADD COLUMN spoiler_text TEXT NOT NULL DEFAULT '';
+ALTER TABLE statuses ADD COLUMN sensitive BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+DROP INDEX statuses_deleted_at;
+ALTER TABLE statuses DROP COLUMN deleted_at;
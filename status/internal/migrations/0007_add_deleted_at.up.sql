@@ -0,0 +1,2 @@
+ALTER TABLE statuses ADD COLUMN deleted_at TIMESTAMPTZ;
+CREATE INDEX statuses_deleted_at ON statuses (deleted_at, id) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,2 @@
+DROP INDEX statuses_pinned_at;
+ALTER TABLE statuses DROP COLUMN pinned_at;
@@ -0,0 +1,2 @@
+ALTER TABLE statuses ADD COLUMN pinned_at TIMESTAMPTZ;
+CREATE INDEX statuses_pinned_at ON statuses (user_id, pinned_at) WHERE pinned_at IS NOT NULL;
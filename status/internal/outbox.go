@@ -0,0 +1,104 @@
+package statuses
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"time"
+	statuses "yatc/status/pkg"
+)
+
+const (
+	// relayBatchSize bounds how many pending events are published per round
+	relayBatchSize = 100
+	// maxRelayBackoff bounds how long the relay waits after failed rounds
+	maxRelayBackoff = time.Minute
+)
+
+// OutboxEntry is the created event of a status, stored in the same transaction as the status itself
+type OutboxEntry struct {
+	Status    statuses.Status
+	CreatedAt time.Time
+	// SentAt is nil as long as the event wasn't published
+	SentAt *time.Time
+}
+
+// Outbox holds the created events of statuses until the relay published them.
+// Entries of deleted statuses are dropped when they are tombstoned, so their events are never published after the deletion.
+type Outbox interface {
+	// PendingEvents returns the oldest entries that weren't published yet
+	PendingEvents(limit int) ([]OutboxEntry, error)
+	// MarkEventSent marks the entry of a status as published, entries of deleted statuses are ignored
+	MarkEventSent(statusId uuid.UUID, sentAt time.Time) error
+}
+
+// Relay publishes the pending entries of an outbox. Events are published at least once, in the order they were stored.
+type Relay struct {
+	outbox    Outbox
+	publisher Publisher
+	logger    *zap.Logger
+	interval  time.Duration
+}
+
+func NewRelay(outbox Outbox, publisher Publisher, logger *zap.Logger, interval time.Duration) *Relay {
+	return &Relay{outbox, publisher, logger, interval}
+}
+
+// Run relays pending events every interval until ctx is done. After failed rounds it waits twice as long, up to maxRelayBackoff.
+func (relay *Relay) Run(ctx context.Context) {
+	wait := relay.interval
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+
+		err := relay.RelayPending()
+		if err != nil {
+			wait *= 2
+			if wait > maxRelayBackoff {
+				wait = maxRelayBackoff
+			}
+			relay.logger.Error("relaying outbox", zap.Error(err), zap.Duration("retryIn", wait))
+			continue
+		}
+		wait = relay.interval
+	}
+}
+
+// RelayPending publishes pending events until the outbox is empty. It stops at the first failure,
+// so the failed event and the ones after it are retried in order in the next round.
+func (relay *Relay) RelayPending() error {
+	for {
+		entries, err := relay.outbox.PendingEvents(relayBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			err = relay.publish(entry)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(entries) < relayBatchSize {
+			return nil
+		}
+	}
+}
+
+func (relay *Relay) publish(entry OutboxEntry) error {
+	err := relay.publisher.Publish(entry.Status)
+	if err != nil {
+		return err
+	}
+
+	err = publishMentioned(relay.publisher, entry.Status, nil)
+	if err != nil {
+		return err
+	}
+
+	return relay.outbox.MarkEventSent(entry.Status.Id, time.Now().UTC().Truncate(time.Microsecond))
+}
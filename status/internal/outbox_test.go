@@ -0,0 +1,106 @@
+package statuses
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+	statuses "yatc/status/pkg"
+)
+
+// flakyPublisher fails the first failures calls to Publish
+type flakyPublisher struct {
+	*MockPublisher
+	failures  int
+	published []statuses.Status
+}
+
+func (publisher *flakyPublisher) Publish(status statuses.Status) error {
+	if publisher.failures > 0 {
+		publisher.failures--
+		return errors.New("pubsub unavailable")
+	}
+	publisher.published = append(publisher.published, status)
+	return nil
+}
+
+func TestRelay_RelayPending(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	publisher := &flakyPublisher{MockPublisher: NewMockPublisher(), failures: 1}
+	relay := NewRelay(repo, publisher, zap.NewNop(), time.Second)
+
+	now := time.Now().UTC()
+	author, mentioned := uuid.New(), uuid.New()
+	first := statuses.Status{Id: uuid.New(), Content: "first", UserId: author, CreatedAt: now}
+	second := statuses.Status{Id: uuid.New(), Content: "@me and @you", UserId: author, CreatedAt: now.Add(time.Second), Mentions: []statuses.Mention{
+		{UserId: author, Username: "me", Start: 0, End: 3},
+		{UserId: mentioned, Username: "you", Start: 8, End: 12},
+	}}
+	for _, status := range []statuses.Status{second, first} {
+		_, err := repo.Create(status)
+		assert.NoError(t, err)
+	}
+
+	// WHEN
+	failedErr := relay.RelayPending()
+	pendingAfterFailure, err := repo.PendingEvents(relayBatchSize)
+	assert.NoError(t, err)
+	relayErr := relay.RelayPending()
+
+	// THEN
+	assert.Error(t, failedErr)
+	assert.Len(t, pendingAfterFailure, 2)
+	assert.NoError(t, relayErr)
+	assert.Equal(t, []statuses.Status{first, second}, publisher.published)
+	assert.Equal(t, []statuses.MentionEvent{{UserId: mentioned, Status: second}}, publisher.Mentioned)
+
+	pending, err := repo.PendingEvents(relayBatchSize)
+	assert.NoError(t, err)
+	assert.Empty(t, pending)
+	assert.NotNil(t, repo.outbox[first.Id].SentAt)
+}
+
+func TestRelay_RelayPending_DeletedStatus(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	publisher := &flakyPublisher{MockPublisher: NewMockPublisher()}
+	relay := NewRelay(repo, publisher, zap.NewNop(), time.Second)
+	status := statuses.Status{Id: uuid.New(), Content: "deleted before it was relayed", UserId: uuid.New(), CreatedAt: time.Now().UTC()}
+	_, err := repo.Create(status)
+	assert.NoError(t, err)
+	_, err = repo.Delete(status.Id)
+	assert.NoError(t, err)
+
+	// WHEN
+	err = relay.RelayPending()
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Empty(t, publisher.published)
+}
+
+func TestRelay_RelayPending_TombstonedStatus(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	publisher := &flakyPublisher{MockPublisher: NewMockPublisher()}
+	relay := NewRelay(repo, publisher, zap.NewNop(), time.Second)
+	now := time.Now().UTC()
+	status := statuses.Status{Id: uuid.New(), Content: "deleted before it was relayed", UserId: uuid.New(), CreatedAt: now}
+	_, err := repo.Create(status)
+	assert.NoError(t, err)
+	_, err = repo.Tombstone(status.Id, now.Add(time.Second))
+	assert.NoError(t, err)
+
+	// WHEN
+	err = relay.RelayPending()
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Empty(t, publisher.published)
+	pending, err := repo.PendingEvents(relayBatchSize)
+	assert.NoError(t, err)
+	assert.Empty(t, pending)
+}
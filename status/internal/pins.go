@@ -0,0 +1,39 @@
+package statuses
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+	"yatc/internal"
+	statuses "yatc/status/pkg"
+)
+
+var AlreadyPinnedError = errors.New("status already pinned")
+var NotPinnedError = errors.New("status not pinned")
+var TooManyPinsError = errors.New("no more statuses can be pinned, unpin one first")
+var NotOwnPinsError = errors.New("only the user can change the statuses pinned to their profile")
+
+// Pins holds the statuses authors pinned to their profile. Only the author can pin a status, so a pin is kept on the status.
+type Pins interface {
+	// Pin pins a status to the profile of its author, unless the author has max statuses pinned already
+	Pin(statusId uuid.UUID, pinnedAt time.Time, max int) (statuses.Status, error)
+	Unpin(statusId uuid.UUID) (statuses.Status, error)
+	// ListPinned returns the statuses a user pinned, last pinned first
+	ListPinned(userId uuid.UUID) ([]statuses.Status, error)
+}
+
+// withPinnedFirst puts the pinned statuses in front of a page, pinned statuses already on the page are moved to the front
+func withPinnedFirst(pinned []statuses.Status, page statuses.StatusPage) statuses.StatusPage {
+	pinnedIds := internal.NewSet[uuid.UUID]()
+	for _, status := range pinned {
+		pinnedIds.Add(status.Id)
+	}
+
+	all := append(make([]statuses.Status, 0, len(pinned)+len(page.Statuses)), pinned...)
+	for _, status := range page.Statuses {
+		if !pinnedIds.Has(status.Id) {
+			all = append(all, status)
+		}
+	}
+	return statuses.StatusPage{Statuses: all, Next: page.Next}
+}
@@ -0,0 +1,100 @@
+package statuses
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+	"yatc/internal"
+	statuses "yatc/status/pkg"
+)
+
+var NoPollError = errors.New("status has no poll")
+var AlreadyVotedError = errors.New("user already voted in poll")
+var PollExpiredError = errors.New("poll has expired")
+
+const minPollOptions = 2
+const maxPollOptions = 4
+const maxPollOptionLength = 50
+
+// Polls records votes apart from the status a poll belongs to, so concurrent votes don't rewrite the status
+type Polls interface {
+	// Vote records the choices of a user and returns the poll with its new tallies. Every user votes once,
+	// later votes fail with AlreadyVotedError, and votes created once the poll expired with PollExpiredError.
+	Vote(vote statuses.PollVote) (statuses.Poll, error)
+}
+
+// newPoll validates a poll of a status published at publishedAt and returns it without votes
+func newPoll(poll statuses.Poll, publishedAt time.Time) (statuses.Poll, internal.ValidationError) {
+	var validation internal.ValidationError
+
+	if len(poll.Options) < minPollOptions || len(poll.Options) > maxPollOptions {
+		validation = append(validation, internal.FieldError{Field: "poll.options", Message: fmt.Sprintf("must contain %d to %d options, contains %d", minPollOptions, maxPollOptions, len(poll.Options))})
+	}
+
+	seen := make(map[string]bool, len(poll.Options))
+	for i, option := range poll.Options {
+		field := fmt.Sprintf("poll.options[%d]", i)
+		normalized := strings.ToLower(strings.TrimSpace(option))
+		if normalized == "" {
+			validation = append(validation, internal.FieldError{Field: field, Message: "must not be empty"})
+		} else if length := utf8.RuneCountInString(option); length > maxPollOptionLength {
+			validation = append(validation, internal.FieldError{Field: field, Message: fmt.Sprintf("must be at most %d characters long, is %d", maxPollOptionLength, length)})
+		} else if seen[normalized] {
+			validation = append(validation, internal.FieldError{Field: field, Message: "is offered more than once"})
+		}
+		seen[normalized] = true
+	}
+
+	if !poll.ExpiresAt.After(publishedAt) {
+		validation = append(validation, internal.FieldError{Field: "poll.expiresAt", Message: "must be after the status is published"})
+	}
+
+	return statuses.Poll{
+		Options:   poll.Options,
+		Multiple:  poll.Multiple,
+		ExpiresAt: poll.ExpiresAt.UTC().Truncate(time.Microsecond),
+		Votes:     make([]int, len(poll.Options)),
+	}, validation
+}
+
+// validateChoices checks that choices are distinct options of the poll, only one for single choice polls
+func validateChoices(poll statuses.Poll, choices []int) error {
+	var validation internal.ValidationError
+
+	if len(choices) == 0 {
+		validation = append(validation, internal.FieldError{Field: "choices", Message: "must not be empty"})
+	} else if !poll.Multiple && len(choices) > 1 {
+		validation = append(validation, internal.FieldError{Field: "choices", Message: "must contain one choice, the poll is single choice"})
+	}
+
+	chosen := internal.NewSet[int]()
+	for i, choice := range choices {
+		field := fmt.Sprintf("choices[%d]", i)
+		if choice < 0 || choice >= len(poll.Options) {
+			validation = append(validation, internal.FieldError{Field: field, Message: "is not an option of the poll"})
+		} else if chosen.Has(choice) {
+			validation = append(validation, internal.FieldError{Field: field, Message: "is chosen more than once"})
+		}
+		chosen.Add(choice)
+	}
+
+	if len(validation) > 0 {
+		return validation
+	}
+	return nil
+}
+
+// tally adds the choices of a vote to the votes of a poll
+func tally(poll *statuses.Poll, choices []int) {
+	if len(poll.Votes) < len(poll.Options) {
+		votes := make([]int, len(poll.Options))
+		copy(votes, poll.Votes)
+		poll.Votes = votes
+	}
+	for _, choice := range choices {
+		poll.Votes[choice]++
+	}
+	poll.VoterCount++
+}
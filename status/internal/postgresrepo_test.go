@@ -22,6 +22,11 @@ func init() {
 }
 
 func prepare(t *testing.T) (repo Repository, cleanup func() error) {
+	db := prepareDB(t)
+	return NewPostgresRepo(db), db.Close
+}
+
+func prepareDB(t *testing.T) *sqlx.DB {
 	if os.Getenv("DATABASE_CONNECTION_STRING") == "" {
 		t.Skipf("set DATABASE_CONNECTION_STRING to run this integration test")
 	}
@@ -33,18 +38,12 @@ func prepare(t *testing.T) (repo Repository, cleanup func() error) {
 		t.Fatalf("open psql_txdb connection: %s", err)
 	}
 
-	schema := `CREATE TABLE IF NOT EXISTS statuses (
-			id UUID PRIMARY KEY,
-			content TEXT,
-			user_id UUID
-		);`
-
-	_, err = db.Exec(schema)
+	_, err = MigrateUp(db)
 	if err != nil {
-		t.Fatalf("cant apply default scheme to database %s", err)
+		t.Fatalf("cant migrate database %s", err)
 	}
 
-	return NewPostgresRepo(db), db.Close
+	return db
 }
 
 func TestPostgresRepo_Create(t *testing.T) {
@@ -111,6 +110,457 @@ func TestPostgresRepo_List(t *testing.T) {
 	assert.Equal(t, 2, len(allStatus))
 }
 
+func TestPostgresRepo_ListByUser(t *testing.T) {
+	t.Parallel()
+	postgresRepo, cleanup := prepare(t)
+	defer func() {
+		_ = cleanup
+	}()
+	userId := uuid.New()
+	for i := 0; i < 3; i++ {
+		_, err := postgresRepo.Create(statuses.Status{Id: uuid.New(), Content: "test status", UserId: userId})
+		assert.NoError(t, err)
+	}
+	_, err := postgresRepo.Create(statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()})
+	assert.NoError(t, err)
+
+	firstPage, err := postgresRepo.ListByUser(userId, statuses.PageQuery{Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(firstPage.Statuses))
+	assert.NotNil(t, firstPage.Next)
+
+	secondPage, err := postgresRepo.ListByUser(userId, statuses.PageQuery{Limit: 2, Cursor: firstPage.Next})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(secondPage.Statuses))
+	assert.Nil(t, secondPage.Next)
+}
+
+func TestPostgresRepo_Update(t *testing.T) {
+	t.Parallel()
+	postgresRepo, cleanup := prepare(t)
+	defer func() {
+		_ = cleanup
+	}()
+	createStatus := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+
+	_, err := postgresRepo.Create(createStatus)
+	assert.NoError(t, err)
+
+	editedAt := time.Now().UTC().Truncate(time.Microsecond)
+	editedStatus := createStatus
+	editedStatus.Content = "edited status"
+	editedStatus.UpdatedAt = editedAt
+	editedStatus.EditedAt = &editedAt
+
+	_, err = postgresRepo.Update(editedStatus)
+	assert.NoError(t, err)
+
+	gotStatus, err := postgresRepo.Get(createStatus.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, editedStatus, gotStatus)
+
+	history, err := postgresRepo.History(createStatus.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(history))
+	assert.Equal(t, createStatus.Content, history[0].Content)
+}
+
+func TestPostgresRepo_MediaIds(t *testing.T) {
+	t.Parallel()
+	postgresRepo, cleanup := prepare(t)
+	defer func() {
+		_ = cleanup
+	}()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	mediaIds := []uuid.UUID{uuid.New(), uuid.New()}
+	createStatus := statuses.Status{Id: uuid.New(), Content: "with media", UserId: uuid.New(), CreatedAt: now, UpdatedAt: now, MediaIds: mediaIds}
+
+	_, err := postgresRepo.Create(createStatus)
+	assert.NoError(t, err)
+
+	editedAt := now.Add(time.Second)
+	editedStatus := createStatus
+	editedStatus.MediaIds = mediaIds[:1]
+	editedStatus.UpdatedAt = editedAt
+	editedStatus.EditedAt = &editedAt
+	_, err = postgresRepo.Update(editedStatus)
+	assert.NoError(t, err)
+
+	gotStatus, err := postgresRepo.Get(createStatus.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, editedStatus.MediaIds, gotStatus.MediaIds)
+
+	history, err := postgresRepo.History(createStatus.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(history))
+	assert.Equal(t, mediaIds, history[0].MediaIds)
+}
+
+func TestPostgresRepo_Visibility(t *testing.T) {
+	t.Parallel()
+	postgresRepo, cleanup := prepare(t)
+	defer func() {
+		_ = cleanup
+	}()
+	status := statuses.Status{Id: uuid.New(), Content: "followers only", UserId: uuid.New(), Visibility: statuses.Followers}
+
+	_, err := postgresRepo.Create(status)
+	assert.NoError(t, err)
+
+	gotStatus, err := postgresRepo.Get(status.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, statuses.Followers, gotStatus.Visibility)
+}
+
+func TestPostgresRepo_ContentWarning(t *testing.T) {
+	t.Parallel()
+	postgresRepo, cleanup := prepare(t)
+	defer func() {
+		_ = cleanup
+	}()
+	status := statuses.Status{Id: uuid.New(), Content: "the butler did it", UserId: uuid.New(), SpoilerText: "crime novel spoilers"}
+	_, err := postgresRepo.Create(status)
+	assert.NoError(t, err)
+
+	status.SpoilerText = ""
+	status.Sensitive = true
+	_, err = postgresRepo.Update(status)
+	assert.NoError(t, err)
+
+	gotStatus, err := postgresRepo.Get(status.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, "", gotStatus.SpoilerText)
+	assert.True(t, gotStatus.Sensitive)
+}
+
+func TestPostgresRepo_Vote(t *testing.T) {
+	t.Parallel()
+	postgresRepo, cleanup := prepare(t)
+	defer func() {
+		_ = cleanup
+	}()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	status := statuses.Status{Id: uuid.New(), Content: "poll", UserId: uuid.New(), CreatedAt: now, UpdatedAt: now,
+		Poll: &statuses.Poll{Options: []string{"red", "green", "blue"}, Multiple: true, ExpiresAt: now.Add(time.Hour), Votes: []int{0, 0, 0}}}
+	_, err := postgresRepo.Create(status)
+	assert.NoError(t, err)
+	voter := uuid.New()
+
+	poll, err := postgresRepo.Vote(statuses.PollVote{StatusId: status.Id, UserId: voter, Choices: []int{0, 2}, CreatedAt: now})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 0, 1}, poll.Votes)
+
+	_, err = postgresRepo.Vote(statuses.PollVote{StatusId: status.Id, UserId: voter, Choices: []int{1}, CreatedAt: now})
+	assert.ErrorIs(t, err, AlreadyVotedError)
+	_, err = postgresRepo.Vote(statuses.PollVote{StatusId: status.Id, UserId: uuid.New(), Choices: []int{1}, CreatedAt: now.Add(time.Hour)})
+	assert.ErrorIs(t, err, PollExpiredError)
+
+	gotStatus, err := postgresRepo.Get(status.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, status.Poll.Options, gotStatus.Poll.Options)
+	assert.Equal(t, status.Poll.ExpiresAt, gotStatus.Poll.ExpiresAt)
+	assert.True(t, gotStatus.Poll.Multiple)
+	assert.Equal(t, []int{1, 0, 1}, gotStatus.Poll.Votes)
+	assert.Equal(t, 1, gotStatus.Poll.VoterCount)
+}
+
+func TestPostgresRepo_Replies(t *testing.T) {
+	t.Parallel()
+	postgresRepo, cleanup := prepare(t)
+	defer func() {
+		_ = cleanup
+	}()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	parent := statuses.Status{Id: uuid.New(), Content: "parent", UserId: uuid.New(), CreatedAt: now, UpdatedAt: now}
+	secondReply := statuses.Status{Id: uuid.New(), Content: "second reply", UserId: uuid.New(), CreatedAt: now.Add(2 * time.Second), UpdatedAt: now, InReplyToId: &parent.Id}
+	firstReply := statuses.Status{Id: uuid.New(), Content: "first reply", UserId: uuid.New(), CreatedAt: now.Add(time.Second), UpdatedAt: now, InReplyToId: &parent.Id}
+	for _, status := range []statuses.Status{parent, secondReply, firstReply} {
+		_, err := postgresRepo.Create(status)
+		assert.NoError(t, err)
+	}
+
+	replies, err := postgresRepo.Replies(parent.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{firstReply.Id, secondReply.Id}, []uuid.UUID{replies[0].Id, replies[1].Id})
+	assert.Equal(t, &parent.Id, replies[0].InReplyToId)
+}
+
+func TestPostgresRepo_Reposts(t *testing.T) {
+	t.Parallel()
+	postgresRepo, cleanup := prepare(t)
+	defer func() {
+		_ = cleanup
+	}()
+	createStatus := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+	_, err := postgresRepo.Create(createStatus)
+	assert.NoError(t, err)
+	reposter := uuid.New()
+
+	repost := statuses.Repost{StatusId: createStatus.Id, UserId: reposter, CreatedAt: time.Now().UTC().Truncate(time.Microsecond)}
+	reposted, err := postgresRepo.CreateRepost(repost)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, reposted.RepostCount)
+
+	reposts, err := postgresRepo.Reposts(createStatus.Id)
+	assert.NoError(t, err)
+	assert.Len(t, reposts, 1)
+	assert.Equal(t, repost.UserId, reposts[0].UserId)
+	assert.True(t, repost.CreatedAt.Equal(reposts[0].CreatedAt))
+
+	_, err = postgresRepo.CreateRepost(statuses.Repost{StatusId: createStatus.Id, UserId: reposter, CreatedAt: time.Now().UTC()})
+	assert.ErrorIs(t, err, AlreadyRepostedError)
+
+	unreposted, err := postgresRepo.DeleteRepost(createStatus.Id, reposter)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, unreposted.RepostCount)
+
+	_, err = postgresRepo.DeleteRepost(createStatus.Id, reposter)
+	assert.ErrorIs(t, err, NotRepostedError)
+}
+
+func TestPostgresRepo_Likes(t *testing.T) {
+	t.Parallel()
+	postgresRepo, cleanup := prepare(t)
+	defer func() {
+		_ = cleanup
+	}()
+	createStatus := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+	_, err := postgresRepo.Create(createStatus)
+	assert.NoError(t, err)
+
+	likers := make([]uuid.UUID, 3)
+	for i := range likers {
+		likers[i] = uuid.New()
+		_, err := postgresRepo.CreateLike(statuses.Like{StatusId: createStatus.Id, UserId: likers[i], CreatedAt: time.Now().UTC()})
+		assert.NoError(t, err)
+	}
+
+	gotStatus, err := postgresRepo.Get(createStatus.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, len(likers), gotStatus.LikeCount)
+
+	likes, err := postgresRepo.ListLikes(createStatus.Id, statuses.PageQuery{Limit: statuses.MaxPageLimit})
+	assert.NoError(t, err)
+	assert.Equal(t, len(likers), len(likes.Likes))
+
+	likedByUser, err := postgresRepo.ListLikedByUser(likers[0], statuses.PageQuery{Limit: statuses.DefaultPageLimit})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(likedByUser.Statuses))
+	assert.Equal(t, createStatus.Id, likedByUser.Statuses[0].Id)
+
+	unliked, err := postgresRepo.DeleteLike(createStatus.Id, likers[0])
+	assert.NoError(t, err)
+	assert.Equal(t, len(likers)-1, unliked.LikeCount)
+
+	_, err = postgresRepo.DeleteLike(createStatus.Id, likers[0])
+	assert.ErrorIs(t, err, NotLikedError)
+}
+
+func TestPostgresRepo_ListByTag(t *testing.T) {
+	t.Parallel()
+	postgresRepo, cleanup := prepare(t)
+	defer func() {
+		_ = cleanup
+	}()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	tagged := statuses.Status{Id: uuid.New(), Content: "#go #dapr", UserId: uuid.New(), CreatedAt: now, UpdatedAt: now, Tags: []string{"go", "dapr"}}
+	untagged := statuses.Status{Id: uuid.New(), Content: "no tags", UserId: uuid.New(), CreatedAt: now, UpdatedAt: now}
+	for _, status := range []statuses.Status{tagged, untagged} {
+		_, err := postgresRepo.Create(status)
+		assert.NoError(t, err)
+	}
+
+	page, err := postgresRepo.ListByTag("dapr", statuses.PageQuery{Limit: statuses.DefaultPageLimit})
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Status{tagged}, page.Statuses)
+
+	gotUntagged, err := postgresRepo.Get(untagged.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, untagged, gotUntagged)
+}
+
+func TestPostgresRepo_Mentions(t *testing.T) {
+	t.Parallel()
+	postgresRepo, cleanup := prepare(t)
+	defer func() {
+		_ = cleanup
+	}()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	mention := statuses.Mention{UserId: uuid.New(), Username: "Hans", Start: 3, End: 8}
+	status := statuses.Status{Id: uuid.New(), Content: "hi @Hans", UserId: uuid.New(), CreatedAt: now, UpdatedAt: now, Mentions: []statuses.Mention{mention}}
+
+	_, err := postgresRepo.Create(status)
+	assert.NoError(t, err)
+	gotStatus, err := postgresRepo.Get(status.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, status, gotStatus)
+
+	status.Content = "bye"
+	status.Mentions = nil
+	_, err = postgresRepo.Update(status)
+	assert.NoError(t, err)
+	gotStatus, err = postgresRepo.Get(status.Id)
+	assert.NoError(t, err)
+	assert.Nil(t, gotStatus.Mentions)
+}
+
+func TestPostgresRepo_Outbox(t *testing.T) {
+	t.Parallel()
+	postgresRepo, cleanup := prepare(t)
+	defer func() {
+		_ = cleanup
+	}()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	sent := statuses.Status{Id: uuid.New(), Content: "sent", UserId: uuid.New(), CreatedAt: now, UpdatedAt: now}
+	deleted := statuses.Status{Id: uuid.New(), Content: "deleted", UserId: uuid.New(), CreatedAt: now, UpdatedAt: now}
+	tombstoned := statuses.Status{Id: uuid.New(), Content: "tombstoned", UserId: uuid.New(), CreatedAt: now, UpdatedAt: now}
+	for _, status := range []statuses.Status{sent, deleted, tombstoned} {
+		_, err := postgresRepo.Create(status)
+		assert.NoError(t, err)
+	}
+
+	pending, err := postgresRepo.PendingEvents(10)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 3)
+
+	assert.NoError(t, postgresRepo.MarkEventSent(sent.Id, now))
+	_, err = postgresRepo.Delete(deleted.Id)
+	assert.NoError(t, err)
+	_, err = postgresRepo.Tombstone(tombstoned.Id, now)
+	assert.NoError(t, err)
+
+	pending, err = postgresRepo.PendingEvents(10)
+	assert.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestPostgresRepo_Schedule(t *testing.T) {
+	t.Parallel()
+	postgresRepo, cleanup := prepare(t)
+	defer func() {
+		_ = cleanup
+	}()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	userId := uuid.New()
+	dueAt, laterAt := now.Add(-time.Second), now.Add(time.Hour)
+	due := statuses.Status{Id: uuid.New(), Content: "due", UserId: userId, CreatedAt: now, UpdatedAt: now, PublishAt: &dueAt}
+	later := statuses.Status{Id: uuid.New(), Content: "later", UserId: userId, CreatedAt: now, UpdatedAt: now, PublishAt: &laterAt}
+	for _, status := range []statuses.Status{due, later} {
+		_, err := postgresRepo.Create(status)
+		assert.NoError(t, err)
+	}
+
+	page, err := postgresRepo.ListByUser(userId, statuses.PageQuery{Limit: 10})
+	assert.NoError(t, err)
+	assert.Empty(t, page.Statuses)
+	pending, err := postgresRepo.PendingEvents(10)
+	assert.NoError(t, err)
+	assert.Empty(t, pending)
+
+	dueStatuses, err := postgresRepo.ListDue(now, 10)
+	assert.NoError(t, err)
+	assert.Len(t, dueStatuses, 1)
+	assert.Equal(t, due.Id, dueStatuses[0].Id)
+
+	published, err := postgresRepo.PublishScheduled(due.Id, now)
+	assert.NoError(t, err)
+	assert.Nil(t, published.PublishAt)
+	_, err = postgresRepo.PublishScheduled(due.Id, now)
+	assert.ErrorIs(t, err, NotScheduledError)
+
+	pending, err = postgresRepo.PendingEvents(10)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 1)
+
+	rescheduleAt := now.Add(2 * time.Hour)
+	rescheduled, err := postgresRepo.Reschedule(later.Id, rescheduleAt)
+	assert.NoError(t, err)
+	assert.Equal(t, &rescheduleAt, rescheduled.PublishAt)
+
+	_, err = postgresRepo.CancelScheduled(published.Id)
+	assert.ErrorIs(t, err, NotScheduledError)
+	_, err = postgresRepo.CancelScheduled(later.Id)
+	assert.NoError(t, err)
+
+	scheduled, err := postgresRepo.ListScheduled(userId)
+	assert.NoError(t, err)
+	assert.Empty(t, scheduled)
+}
+
+func TestPostgresRepo_Tombstones(t *testing.T) {
+	t.Parallel()
+	postgresRepo, cleanup := prepare(t)
+	defer func() {
+		_ = cleanup
+	}()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	deletedAt := now.Add(-2 * time.Hour)
+	restored := statuses.Status{Id: uuid.New(), Content: "restored", UserId: uuid.New(), CreatedAt: now, UpdatedAt: now}
+	purged := statuses.Status{Id: uuid.New(), Content: "purged", UserId: uuid.New(), CreatedAt: now, UpdatedAt: now}
+	for _, status := range []statuses.Status{restored, purged} {
+		_, err := postgresRepo.Create(status)
+		assert.NoError(t, err)
+
+		tombstone, err := postgresRepo.Tombstone(status.Id, deletedAt)
+		assert.NoError(t, err)
+		assert.Equal(t, &deletedAt, tombstone.DeletedAt)
+	}
+	_, err := postgresRepo.Tombstone(restored.Id, now)
+	assert.ErrorIs(t, err, internal.NotFoundError(restored.Id))
+
+	expired, err := postgresRepo.ListExpired(now.Add(-time.Hour), 10)
+	assert.NoError(t, err)
+	assert.Len(t, expired, 2)
+
+	restoredStatus, err := postgresRepo.Restore(restored.Id, now)
+	assert.NoError(t, err)
+	assert.Equal(t, restored, restoredStatus)
+	_, err = postgresRepo.Restore(restored.Id, now)
+	assert.ErrorIs(t, err, NotDeletedError)
+	_, err = postgresRepo.Purge(restored.Id, now)
+	assert.ErrorIs(t, err, NotDeletedError)
+
+	_, err = postgresRepo.Purge(purged.Id, now)
+	assert.NoError(t, err)
+	_, err = postgresRepo.Get(purged.Id)
+	assert.ErrorIs(t, err, internal.NotFoundError(purged.Id))
+}
+
+func TestPostgresRepo_Pins(t *testing.T) {
+	t.Parallel()
+	postgresRepo, cleanup := prepare(t)
+	defer func() {
+		_ = cleanup
+	}()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	userId := uuid.New()
+	first := statuses.Status{Id: uuid.New(), Content: "first", UserId: userId, CreatedAt: now, UpdatedAt: now}
+	second := statuses.Status{Id: uuid.New(), Content: "second", UserId: userId, CreatedAt: now, UpdatedAt: now}
+	for _, status := range []statuses.Status{first, second} {
+		_, err := postgresRepo.Create(status)
+		assert.NoError(t, err)
+	}
+
+	pinnedAt := now.Add(time.Second)
+	pinned, err := postgresRepo.Pin(first.Id, pinnedAt, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, &pinnedAt, pinned.PinnedAt)
+	_, err = postgresRepo.Pin(first.Id, pinnedAt, 2)
+	assert.ErrorIs(t, err, AlreadyPinnedError)
+	_, err = postgresRepo.Pin(second.Id, pinnedAt, 1)
+	assert.ErrorIs(t, err, TooManyPinsError)
+
+	pinnedStatuses, err := postgresRepo.ListPinned(userId)
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Status{pinned}, pinnedStatuses)
+
+	unpinned, err := postgresRepo.Unpin(first.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, first, unpinned)
+	_, err = postgresRepo.Unpin(first.Id)
+	assert.ErrorIs(t, err, NotPinnedError)
+}
+
 func TestPostgresRepo_Delete(t *testing.T) {
 	t.Parallel()
 	postgresRepo, cleanup := prepare(t)
@@ -138,3 +588,55 @@ func TestPostgresRepo_Delete(t *testing.T) {
 		assert.Failf(t, "expected error NotFound", "got error: %s", err)
 	}
 }
+
+func TestPostgresRepo_Migrations(t *testing.T) {
+	t.Parallel()
+	db := prepareDB(t)
+	defer func() {
+		_ = db.Close
+	}()
+
+	version, err := MigrateDown(db, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, version)
+
+	_, err = db.Exec("SELECT 1 FROM statuses")
+	assert.Error(t, err)
+
+	version, err = MigrateUp(db)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, version)
+
+	_, err = NewPostgresRepo(db).Create(statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()})
+	assert.NoError(t, err)
+}
+
+func TestPostgresRepo_Migrations_FromBaseline(t *testing.T) {
+	t.Parallel()
+	db := prepareDB(t)
+	defer func() {
+		_ = db.Close
+	}()
+
+	_, err := MigrateDown(db, 0)
+	assert.NoError(t, err)
+
+	// The statuses table of databases deployed before there were migrations
+	_, err = db.Exec("CREATE TABLE statuses (id UUID PRIMARY KEY, content TEXT, user_id UUID)")
+	assert.NoError(t, err)
+	existingId := uuid.New()
+	_, err = db.Exec("INSERT INTO statuses (id, content, user_id) VALUES ($1, $2, $3)", existingId, "existing status", uuid.New())
+	assert.NoError(t, err)
+
+	version, err := MigrateUp(db)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, version)
+
+	repo := NewPostgresRepo(db)
+	existing, err := repo.Get(existingId)
+	assert.NoError(t, err)
+	assert.Equal(t, "existing status", existing.Content)
+
+	_, err = repo.Create(statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()})
+	assert.NoError(t, err)
+}
@@ -9,6 +9,11 @@ import (
 
 type Publisher interface {
 	Publish(status statuses.Status) error
+	PublishUpdated(status statuses.Status) error
+	PublishReposted(status statuses.Status) error
+	PublishUnreposted(status statuses.Status) error
+	PublishMentioned(event statuses.MentionEvent) error
+	PublishDeleted(status statuses.Status) error
 }
 
 type DaprStatusPublisher struct {
@@ -23,3 +28,23 @@ func NewDaprStatusPublisher(client client.Client, config internal.PubSubConfig)
 func (pub *DaprStatusPublisher) Publish(status statuses.Status) error {
 	return pub.client.PublishEvent(context.Background(), pub.config.Name, pub.config.Topic, status)
 }
+
+func (pub *DaprStatusPublisher) PublishUpdated(status statuses.Status) error {
+	return pub.client.PublishEvent(context.Background(), pub.config.Name, statuses.UpdatedTopic(pub.config.Topic), status)
+}
+
+func (pub *DaprStatusPublisher) PublishReposted(status statuses.Status) error {
+	return pub.client.PublishEvent(context.Background(), pub.config.Name, statuses.RepostedTopic(pub.config.Topic), status)
+}
+
+func (pub *DaprStatusPublisher) PublishDeleted(status statuses.Status) error {
+	return pub.client.PublishEvent(context.Background(), pub.config.Name, statuses.DeletedTopic(pub.config.Topic), status)
+}
+
+func (pub *DaprStatusPublisher) PublishMentioned(event statuses.MentionEvent) error {
+	return pub.client.PublishEvent(context.Background(), pub.config.Name, statuses.MentionedTopic(pub.config.Topic), event)
+}
+
+func (pub *DaprStatusPublisher) PublishUnreposted(status statuses.Status) error {
+	return pub.client.PublishEvent(context.Background(), pub.config.Name, statuses.UnrepostedTopic(pub.config.Topic), status)
+}
@@ -2,31 +2,117 @@ package statuses
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"fmt"
 	dapr "github.com/dapr/go-sdk/client"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"sync"
+	"time"
 	"yatc/internal"
 	"yatc/status/pkg"
 )
 
 type Repository interface {
+	Outbox
+	Schedule
+	Polls
+	Tombstones
+	Pins
 	List() ([]statuses.Status, error)
+	ListByUser(userId uuid.UUID, query statuses.PageQuery) (statuses.StatusPage, error)
+	ListByTag(tag string, query statuses.PageQuery) (statuses.StatusPage, error)
 	Get(statusId uuid.UUID) (statuses.Status, error)
+	// Delete deletes a status for good, together with its reposts, likes and revisions
 	Delete(statusId uuid.UUID) (statuses.Status, error)
+	// Create stores a status together with its created event in the outbox. Scheduled statuses are only stored
+	// in the schedule, until they are published.
 	Create(status statuses.Status) (statuses.Status, error)
+	// Update applies an edit to a status and keeps its previous version as a revision, the tag index follows changed tags.
+	// Only the edited fields are written, counts, the poll, pins, deletion and schedule are left as they are.
+	Update(status statuses.Status) (statuses.Status, error)
+	History(statusId uuid.UUID) ([]statuses.Revision, error)
+	// Replies returns the direct replies to a status, oldest first
+	Replies(statusId uuid.UUID) ([]statuses.Status, error)
+	// CreateRepost records a repost and returns the reposted status with its new repost count
+	CreateRepost(repost statuses.Repost) (statuses.Status, error)
+	DeleteRepost(statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error)
+	// Reposts returns the current reposts of a status
+	Reposts(statusId uuid.UUID) ([]statuses.Repost, error)
+	ListLikes(statusId uuid.UUID, query statuses.PageQuery) (statuses.LikePage, error)
+	// ListLikedByUser returns the statuses a user liked, ordered by the time of the like
+	ListLikedByUser(userId uuid.UUID, query statuses.PageQuery) (statuses.StatusPage, error)
+	// CreateLike records a like and returns the liked status with its new like count
+	CreateLike(like statuses.Like) (statuses.Status, error)
+	DeleteLike(statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error)
 }
 
+func revisionOf(status statuses.Status) statuses.Revision {
+	createdAt := status.CreatedAt
+	if status.EditedAt != nil {
+		createdAt = *status.EditedAt
+	}
+
+	return statuses.Revision{
+		StatusId:  status.Id,
+		Content:   status.Content,
+		MediaIds:  status.MediaIds,
+		CreatedAt: createdAt,
+	}
+}
+
+// withEdit applies the fields an edit changes to the current version of a status, so counts, polls, pins, deletion
+// and schedule changed since the edit was made are kept
+func withEdit(current statuses.Status, edited statuses.Status) statuses.Status {
+	current.Content = edited.Content
+	current.Tags = edited.Tags
+	current.Mentions = edited.Mentions
+	current.MediaIds = edited.MediaIds
+	current.SpoilerText = edited.SpoilerText
+	current.Sensitive = edited.Sensitive
+	current.UpdatedAt = edited.UpdatedAt
+	current.EditedAt = edited.EditedAt
+	return current
+}
+
+// InMemoryRepo is shared by the request handlers and the background workers, so every method holds the mutex
 type InMemoryRepo struct {
-	Statuses map[uuid.UUID]statuses.Status
+	mutex     sync.RWMutex
+	Statuses  map[uuid.UUID]statuses.Status
+	userIndex map[uuid.UUID][]indexEntry
+	tagIndex  map[string][]indexEntry
+	revisions map[uuid.UUID][]statuses.Revision
+	replies   map[uuid.UUID][]indexEntry
+	reposts   map[uuid.UUID]reposters
+	likes     map[uuid.UUID][]indexEntry
+	userLikes map[uuid.UUID][]indexEntry
+	outbox    map[uuid.UUID]OutboxEntry
+	voters    map[uuid.UUID]*internal.Set[uuid.UUID]
 }
 
 func NewInMemoryRepo() *InMemoryRepo {
-	return &InMemoryRepo{Statuses: map[uuid.UUID]statuses.Status{}}
+	return &InMemoryRepo{
+		Statuses:  map[uuid.UUID]statuses.Status{},
+		userIndex: map[uuid.UUID][]indexEntry{},
+		tagIndex:  map[string][]indexEntry{},
+		revisions: map[uuid.UUID][]statuses.Revision{},
+		replies:   map[uuid.UUID][]indexEntry{},
+		reposts:   map[uuid.UUID]reposters{},
+		likes:     map[uuid.UUID][]indexEntry{},
+		userLikes: map[uuid.UUID][]indexEntry{},
+		outbox:    map[uuid.UUID]OutboxEntry{},
+		voters:    map[uuid.UUID]*internal.Set[uuid.UUID]{},
+	}
 }
 
-func (repo InMemoryRepo) List() ([]statuses.Status, error) {
+func (repo *InMemoryRepo) List() ([]statuses.Status, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
 	values := make([]statuses.Status, 0, len(repo.Statuses))
 
 	for _, value := range repo.Statuses {
@@ -36,7 +122,38 @@ func (repo InMemoryRepo) List() ([]statuses.Status, error) {
 	return values, nil
 }
 
-func (repo InMemoryRepo) Get(statusId uuid.UUID) (statuses.Status, error) {
+func (repo *InMemoryRepo) ListByUser(userId uuid.UUID, query statuses.PageQuery) (statuses.StatusPage, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	entries, next := pageOf(repo.userIndex[userId], query)
+
+	page := make([]statuses.Status, len(entries))
+	for i, entry := range entries {
+		page[i] = repo.Statuses[entry.Id]
+	}
+
+	return statuses.StatusPage{Statuses: page, Next: next}, nil
+}
+
+func (repo *InMemoryRepo) ListByTag(tag string, query statuses.PageQuery) (statuses.StatusPage, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	entries, next := pageOf(repo.tagIndex[tag], query)
+
+	page := make([]statuses.Status, len(entries))
+	for i, entry := range entries {
+		page[i] = repo.Statuses[entry.Id]
+	}
+
+	return statuses.StatusPage{Statuses: page, Next: next}, nil
+}
+
+func (repo *InMemoryRepo) Get(statusId uuid.UUID) (statuses.Status, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
 	status, ok := repo.Statuses[statusId]
 	if !ok {
 		return statuses.Status{}, internal.NotFoundError(statusId)
@@ -44,210 +161,3038 @@ func (repo InMemoryRepo) Get(statusId uuid.UUID) (statuses.Status, error) {
 	return status, nil
 }
 
-func (repo InMemoryRepo) Delete(statusId uuid.UUID) (statuses.Status, error) {
+func (repo *InMemoryRepo) Delete(statusId uuid.UUID) (statuses.Status, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	return repo.remove(statusId)
+}
+
+// remove deletes a status with everything belonging to it, the mutex has to be held
+func (repo *InMemoryRepo) remove(statusId uuid.UUID) (statuses.Status, error) {
 	status, exists := repo.Statuses[statusId]
 	if !exists {
 		return statuses.Status{}, internal.NotFoundError(statusId)
 	}
 	delete(repo.Statuses, statusId)
+	delete(repo.revisions, statusId)
+	delete(repo.reposts, statusId)
+	delete(repo.outbox, statusId)
+	for _, like := range repo.likes[statusId] {
+		repo.userLikes[like.Id] = removeEntry(repo.userLikes[like.Id], statusId)
+	}
+	delete(repo.likes, statusId)
+	delete(repo.voters, statusId)
+	repo.userIndex[status.UserId] = removeEntry(repo.userIndex[status.UserId], statusId)
+	for _, tag := range status.Tags {
+		repo.tagIndex[tag] = removeEntry(repo.tagIndex[tag], statusId)
+	}
+	if status.InReplyToId != nil {
+		repo.replies[*status.InReplyToId] = removeEntry(repo.replies[*status.InReplyToId], statusId)
+	}
 	return status, nil
 }
 
-func (repo InMemoryRepo) Create(status statuses.Status) (statuses.Status, error) {
+func (repo *InMemoryRepo) Create(status statuses.Status) (statuses.Status, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
 	_, exists := repo.Statuses[status.Id]
 	if exists {
 		return statuses.Status{}, errors.New("duplicated status")
 	}
 	repo.Statuses[status.Id] = status
+	if status.PublishAt == nil {
+		repo.publish(status)
+	}
 	return status, nil
 }
 
-type PostgresRepo struct {
-	db *sqlx.DB
+// publish adds a status to the indexes and its created event to the outbox
+func (repo *InMemoryRepo) publish(status statuses.Status) {
+	repo.userIndex[status.UserId] = append(repo.userIndex[status.UserId], indexEntry{status.Id, status.CreatedAt})
+	for _, tag := range status.Tags {
+		repo.tagIndex[tag] = append(repo.tagIndex[tag], indexEntry{status.Id, status.CreatedAt})
+	}
+	if status.InReplyToId != nil {
+		repo.replies[*status.InReplyToId] = append(repo.replies[*status.InReplyToId], indexEntry{status.Id, status.CreatedAt})
+	}
+	repo.outbox[status.Id] = OutboxEntry{Status: status, CreatedAt: status.CreatedAt}
 }
 
-func NewPostgresRepo(db *sqlx.DB) Repository {
-	return &PostgresRepo{db}
+func (repo *InMemoryRepo) ListScheduled(userId uuid.UUID) ([]statuses.Status, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	return repo.scheduled(func(status statuses.Status) bool {
+		return status.UserId == userId
+	}, -1), nil
 }
 
-func (r *PostgresRepo) List() ([]statuses.Status, error) {
-	var allStatuses []statuses.Status
-	err := r.db.Select(&allStatuses, "SELECT * FROM statuses")
-	if err != nil {
-		return nil, err
+func (repo *InMemoryRepo) ListDue(now time.Time, limit int) ([]statuses.Status, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	return repo.scheduled(func(status statuses.Status) bool {
+		return !status.PublishAt.After(now)
+	}, limit), nil
+}
+
+// scheduled returns up to limit scheduled statuses matching filter, due first. A negative limit returns all.
+func (repo *InMemoryRepo) scheduled(filter func(status statuses.Status) bool, limit int) []statuses.Status {
+	entries := make([]indexEntry, 0)
+	for _, status := range repo.Statuses {
+		if status.PublishAt != nil && filter(status) {
+			entries = append(entries, indexEntry{status.Id, *status.PublishAt})
+		}
 	}
-	return allStatuses, nil
+	sortOldestFirst(entries)
+	if limit >= 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	scheduled := make([]statuses.Status, len(entries))
+	for i, entry := range entries {
+		scheduled[i] = repo.Statuses[entry.Id]
+	}
+	return scheduled
 }
 
-func (r *PostgresRepo) Get(statusId uuid.UUID) (statuses.Status, error) {
-	status := statuses.Status{}
-	err := r.db.Get(&status, "SELECT * FROM statuses WHERE id=$1", statusId)
-	if err != nil {
+func (repo *InMemoryRepo) getScheduled(statusId uuid.UUID) (statuses.Status, error) {
+	status, exists := repo.Statuses[statusId]
+	if !exists {
 		return statuses.Status{}, internal.NotFoundError(statusId)
 	}
+	if status.PublishAt == nil {
+		return statuses.Status{}, NotScheduledError
+	}
 	return status, nil
 }
 
-func (r *PostgresRepo) Delete(statusId uuid.UUID) (statuses.Status, error) {
-	status, err := r.Get(statusId)
+func (repo *InMemoryRepo) Reschedule(statusId uuid.UUID, publishAt time.Time) (statuses.Status, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	status, err := repo.getScheduled(statusId)
 	if err != nil {
 		return statuses.Status{}, err
 	}
-	_, err = r.db.Exec("DELETE FROM statuses WHERE id=$1", statusId)
+	status.PublishAt = &publishAt
+	repo.Statuses[statusId] = status
+	return status, nil
+}
+
+func (repo *InMemoryRepo) PublishScheduled(statusId uuid.UUID, publishedAt time.Time) (statuses.Status, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	status, err := repo.getScheduled(statusId)
 	if err != nil {
 		return statuses.Status{}, err
 	}
+	status.PublishAt = nil
+	status.CreatedAt = publishedAt
+	status.UpdatedAt = publishedAt
+	repo.Statuses[statusId] = status
+	repo.publish(status)
 	return status, nil
 }
 
-func (r *PostgresRepo) Create(status statuses.Status) (statuses.Status, error) {
-	_, err := r.db.Exec("INSERT INTO statuses (id, content, user_id) VALUES ($1, $2, $3)", status.Id, status.Content, status.UserId)
+func (repo *InMemoryRepo) CancelScheduled(statusId uuid.UUID) (statuses.Status, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	_, err := repo.getScheduled(statusId)
 	if err != nil {
 		return statuses.Status{}, err
 	}
+	return repo.remove(statusId)
+}
+
+func (repo *InMemoryRepo) Tombstone(statusId uuid.UUID, deletedAt time.Time) (statuses.Status, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	status, exists := repo.Statuses[statusId]
+	if !exists || status.DeletedAt != nil {
+		return statuses.Status{}, internal.NotFoundError(statusId)
+	}
+	status.DeletedAt = &deletedAt
+	repo.Statuses[statusId] = status
+	delete(repo.outbox, statusId)
 	return status, nil
 }
 
-type DaprStateStoreRepo struct {
-	dapr   dapr.Client
-	config internal.StateStoreConfig
+func (repo *InMemoryRepo) Restore(statusId uuid.UUID, restoredAt time.Time) (statuses.Status, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	status, exists := repo.Statuses[statusId]
+	if !exists {
+		return statuses.Status{}, internal.NotFoundError(statusId)
+	}
+	if status.DeletedAt == nil {
+		return statuses.Status{}, NotDeletedError
+	}
+	status.DeletedAt = nil
+	repo.Statuses[statusId] = status
+	repo.outbox[statusId] = OutboxEntry{Status: status, CreatedAt: restoredAt}
+	return status, nil
 }
 
-func NewDaprStateStore(client dapr.Client, config internal.StateStoreConfig) *DaprStateStoreRepo {
-	return &DaprStateStoreRepo{client, config}
+func (repo *InMemoryRepo) ListExpired(deletedBefore time.Time, limit int) ([]statuses.Status, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	entries := make([]indexEntry, 0)
+	for _, status := range repo.Statuses {
+		if status.DeletedAt != nil && status.DeletedAt.Before(deletedBefore) {
+			entries = append(entries, indexEntry{status.Id, *status.DeletedAt})
+		}
+	}
+	sortOldestFirst(entries)
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	expired := make([]statuses.Status, len(entries))
+	for i, entry := range entries {
+		expired[i] = repo.Statuses[entry.Id]
+	}
+	return expired, nil
 }
 
-func (repo *DaprStateStoreRepo) List() ([]statuses.Status, error) {
-	item, err := repo.dapr.GetState(context.Background(), repo.config.Name, "keys", nil)
-	if err != nil {
-		return nil, err
+func (repo *InMemoryRepo) Purge(statusId uuid.UUID, deletedBefore time.Time) (statuses.Status, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	status, exists := repo.Statuses[statusId]
+	if !exists {
+		return statuses.Status{}, internal.NotFoundError(statusId)
 	}
+	if status.DeletedAt == nil || !status.DeletedAt.Before(deletedBefore) {
+		return statuses.Status{}, NotDeletedError
+	}
+	return repo.remove(statusId)
+}
 
-	if item.Value == nil {
-		return make([]statuses.Status, 0), nil
+func (repo *InMemoryRepo) Pin(statusId uuid.UUID, pinnedAt time.Time, max int) (statuses.Status, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	status, exists := repo.Statuses[statusId]
+	if !exists {
+		return statuses.Status{}, internal.NotFoundError(statusId)
+	}
+	if status.PinnedAt != nil {
+		return statuses.Status{}, AlreadyPinnedError
+	}
+	if len(repo.pinned(status.UserId)) >= max {
+		return statuses.Status{}, TooManyPinsError
 	}
+	status.PinnedAt = &pinnedAt
+	repo.Statuses[statusId] = status
+	return status, nil
+}
 
-	listOfKeys := make([]string, 0)
-	err = json.Unmarshal(item.Value, &listOfKeys)
-	if err != nil {
-		return nil, err
+func (repo *InMemoryRepo) Unpin(statusId uuid.UUID) (statuses.Status, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	status, exists := repo.Statuses[statusId]
+	if !exists {
+		return statuses.Status{}, internal.NotFoundError(statusId)
+	}
+	if status.PinnedAt == nil {
+		return statuses.Status{}, NotPinnedError
 	}
+	status.PinnedAt = nil
+	repo.Statuses[statusId] = status
+	return status, nil
+}
 
-	states, err := repo.dapr.GetBulkState(context.Background(), repo.config.Name, listOfKeys, nil, 1)
-	if err != nil {
-		return nil, err
+func (repo *InMemoryRepo) ListPinned(userId uuid.UUID) ([]statuses.Status, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	entries := repo.pinned(userId)
+	sortNewestFirst(entries)
+
+	pinned := make([]statuses.Status, len(entries))
+	for i, entry := range entries {
+		pinned[i] = repo.Statuses[entry.Id]
 	}
+	return pinned, nil
+}
 
-	allStatuses := make([]statuses.Status, len(states))
-	for i, state := range states {
-		var status statuses.Status
-		err = json.Unmarshal(state.Value, &status)
-		if err != nil {
-			return nil, err
+func (repo *InMemoryRepo) pinned(userId uuid.UUID) []indexEntry {
+	entries := make([]indexEntry, 0)
+	for _, status := range repo.Statuses {
+		if status.UserId == userId && status.PinnedAt != nil {
+			entries = append(entries, indexEntry{status.Id, *status.PinnedAt})
 		}
-		allStatuses[i] = status
 	}
-
-	return allStatuses, nil
+	return entries
 }
 
-func (repo *DaprStateStoreRepo) Get(statusId uuid.UUID) (statuses.Status, error) {
-	statusItem, err := repo.dapr.GetState(context.Background(), repo.config.Name, statusId.String(), nil)
-	if err != nil {
-		return statuses.Status{}, err
+func (repo *InMemoryRepo) PendingEvents(limit int) ([]OutboxEntry, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	pending := make([]indexEntry, 0)
+	for _, entry := range repo.outbox {
+		status, exists := repo.Statuses[entry.Status.Id]
+		if entry.SentAt == nil && exists && status.DeletedAt == nil {
+			pending = append(pending, indexEntry{entry.Status.Id, entry.CreatedAt})
+		}
 	}
-	if statusItem.Value == nil {
-		return statuses.Status{}, internal.NotFoundError(statusId)
+	sortOldestFirst(pending)
+	if len(pending) > limit {
+		pending = pending[:limit]
 	}
 
-	var status statuses.Status
-	err = json.Unmarshal(statusItem.Value, &status)
-	if err != nil {
-		return statuses.Status{}, err
+	entries := make([]OutboxEntry, len(pending))
+	for i, entry := range pending {
+		entries[i] = repo.outbox[entry.Id]
 	}
+	return entries, nil
+}
 
-	return status, nil
+func (repo *InMemoryRepo) MarkEventSent(statusId uuid.UUID, sentAt time.Time) error {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	entry, exists := repo.outbox[statusId]
+	if !exists {
+		return nil
+	}
+	entry.SentAt = &sentAt
+	repo.outbox[statusId] = entry
+	return nil
 }
 
-func (repo *DaprStateStoreRepo) Delete(statusId uuid.UUID) (statuses.Status, error) {
-	ctx := context.Background()
-	statusItem, err := repo.dapr.GetState(ctx, repo.config.Name, statusId.String(), nil)
-	if err != nil {
-		return statuses.Status{}, err
+func (repo *InMemoryRepo) Update(status statuses.Status) (statuses.Status, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	previous, exists := repo.Statuses[status.Id]
+	if !exists {
+		return statuses.Status{}, internal.NotFoundError(status.Id)
+	}
+	repo.revisions[status.Id] = append(repo.revisions[status.Id], revisionOf(previous))
+	status = withEdit(previous, status)
+	if status.PublishAt == nil {
+		removed, added := changedTags(previous.Tags, status.Tags)
+		for _, tag := range removed {
+			repo.tagIndex[tag] = removeEntry(repo.tagIndex[tag], status.Id)
+		}
+		for _, tag := range added {
+			repo.tagIndex[tag] = append(repo.tagIndex[tag], indexEntry{status.Id, status.CreatedAt})
+		}
 	}
+	repo.Statuses[status.Id] = status
+	return status, nil
+}
 
-	if statusItem.Value == nil {
-		return statuses.Status{}, internal.NotFoundError(statusId)
+func (repo *InMemoryRepo) Vote(vote statuses.PollVote) (statuses.Poll, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	status, exists := repo.Statuses[vote.StatusId]
+	if !exists {
+		return statuses.Poll{}, internal.NotFoundError(vote.StatusId)
+	}
+	if status.Poll == nil {
+		return statuses.Poll{}, NoPollError
+	}
+	if status.Poll.Expired(vote.CreatedAt) {
+		return statuses.Poll{}, PollExpiredError
 	}
 
-	err = repo.dapr.DeleteState(ctx, repo.config.Name, statusId.String(), nil)
-	if err != nil {
-		return statuses.Status{}, err
+	voters, ok := repo.voters[vote.StatusId]
+	if !ok {
+		voters = &internal.Set[uuid.UUID]{}
+		repo.voters[vote.StatusId] = voters
 	}
+	if voters.Has(vote.UserId) {
+		return statuses.Poll{}, AlreadyVotedError
+	}
+	voters.Add(vote.UserId)
 
-	var status statuses.Status
-	err = json.Unmarshal(statusItem.Value, &status)
-	if err != nil {
-		return statuses.Status{}, err
+	poll := *status.Poll
+	poll.Votes = append([]int(nil), poll.Votes...)
+	tally(&poll, vote.Choices)
+	status.Poll = &poll
+	repo.Statuses[status.Id] = status
+	return poll, nil
+}
+
+func (repo *InMemoryRepo) History(statusId uuid.UUID) ([]statuses.Revision, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	revisions := make([]statuses.Revision, len(repo.revisions[statusId]))
+	copy(revisions, repo.revisions[statusId])
+	return revisions, nil
+}
+
+func (repo *InMemoryRepo) Replies(statusId uuid.UUID) ([]statuses.Status, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	entries := make([]indexEntry, len(repo.replies[statusId]))
+	copy(entries, repo.replies[statusId])
+	sortOldestFirst(entries)
+
+	replies := make([]statuses.Status, len(entries))
+	for i, entry := range entries {
+		replies[i] = repo.Statuses[entry.Id]
 	}
+	return replies, nil
+}
 
-	return status, nil
+// reposters maps the users who repost a status to the time they reposted it at
+type reposters map[uuid.UUID]time.Time
+
+func (reposters reposters) toReposts(statusId uuid.UUID) []statuses.Repost {
+	reposts := make([]statuses.Repost, 0, len(reposters))
+	for userId, createdAt := range reposters {
+		reposts = append(reposts, statuses.Repost{StatusId: statusId, UserId: userId, CreatedAt: createdAt})
+	}
+	return reposts
 }
 
-func (repo *DaprStateStoreRepo) Create(status statuses.Status) (statuses.Status, error) {
-	ctx := context.Background()
-	statusJson, err := json.Marshal(status)
-	if err != nil {
-		return statuses.Status{}, err
+func (repo *InMemoryRepo) CreateRepost(repost statuses.Repost) (statuses.Status, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	status, exists := repo.Statuses[repost.StatusId]
+	if !exists {
+		return statuses.Status{}, internal.NotFoundError(repost.StatusId)
 	}
 
-	item, err := repo.dapr.GetState(ctx, repo.config.Name, "keys", nil)
-	if err != nil {
-		return statuses.Status{}, err
+	reposters, ok := repo.reposts[repost.StatusId]
+	if !ok {
+		reposters = map[uuid.UUID]time.Time{}
+		repo.reposts[repost.StatusId] = reposters
+	}
+	if _, reposted := reposters[repost.UserId]; reposted {
+		return statuses.Status{}, AlreadyRepostedError
 	}
 
-	if item.Value == nil {
-		keySet := internal.Ptr(internal.NewSet[uuid.UUID]())
-		bytes, err := json.Marshal(keySet)
-		if err != nil {
-			return statuses.Status{}, err
-		}
-		item.Value = bytes
+	reposters[repost.UserId] = repost.CreatedAt
+	status.RepostCount++
+	repo.Statuses[status.Id] = status
+	return status, nil
+}
+
+func (repo *InMemoryRepo) DeleteRepost(statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	status, exists := repo.Statuses[statusId]
+	if !exists {
+		return statuses.Status{}, internal.NotFoundError(statusId)
 	}
 
-	var keySet *internal.Set[uuid.UUID]
-	err = json.Unmarshal(item.Value, &keySet)
-	if err != nil {
-		return statuses.Status{}, err
+	if _, reposted := repo.reposts[statusId][userId]; !reposted {
+		return statuses.Status{}, NotRepostedError
 	}
 
-	keySet.Add(status.Id)
+	delete(repo.reposts[statusId], userId)
+	status.RepostCount--
+	repo.Statuses[status.Id] = status
+	return status, nil
+}
 
-	keysJson, err := json.Marshal(keySet)
-	if err != nil {
-		return statuses.Status{}, err
+func (repo *InMemoryRepo) Reposts(statusId uuid.UUID) ([]statuses.Repost, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	return repo.reposts[statusId].toReposts(statusId), nil
+}
+
+func (repo *InMemoryRepo) ListLikes(statusId uuid.UUID, query statuses.PageQuery) (statuses.LikePage, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	entries, next := pageOf(repo.likes[statusId], query)
+
+	likes := make([]statuses.Like, len(entries))
+	for i, entry := range entries {
+		likes[i] = statuses.Like{StatusId: statusId, UserId: entry.Id, CreatedAt: entry.Time}
 	}
 
-	saveStatusOp := dapr.StateOperation{
-		Type: dapr.StateOperationTypeUpsert,
-		Item: &dapr.SetStateItem{
-			Key:   status.Id.String(),
-			Value: statusJson,
-		},
+	return statuses.LikePage{Likes: likes, Next: next}, nil
+}
+
+func (repo *InMemoryRepo) ListLikedByUser(userId uuid.UUID, query statuses.PageQuery) (statuses.StatusPage, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	entries, next := pageOf(repo.userLikes[userId], query)
+
+	page := make([]statuses.Status, len(entries))
+	for i, entry := range entries {
+		page[i] = repo.Statuses[entry.Id]
 	}
 
-	saveKeyOp := dapr.StateOperation{
-		Type: dapr.StateOperationTypeUpsert,
-		Item: &dapr.SetStateItem{
-			Key:   "keys",
-			Value: keysJson,
-		},
+	return statuses.StatusPage{Statuses: page, Next: next}, nil
+}
+
+func (repo *InMemoryRepo) CreateLike(like statuses.Like) (statuses.Status, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	status, exists := repo.Statuses[like.StatusId]
+	if !exists {
+		return statuses.Status{}, internal.NotFoundError(like.StatusId)
+	}
+	if containsEntry(repo.likes[like.StatusId], like.UserId) {
+		return statuses.Status{}, AlreadyLikedError
 	}
 
-	err = repo.dapr.ExecuteStateTransaction(ctx, repo.config.Name, nil, []*dapr.StateOperation{&saveStatusOp, &saveKeyOp})
-	if err != nil {
-		return statuses.Status{}, err
+	repo.likes[like.StatusId] = append(repo.likes[like.StatusId], indexEntry{like.UserId, like.CreatedAt})
+	repo.userLikes[like.UserId] = append(repo.userLikes[like.UserId], indexEntry{like.StatusId, like.CreatedAt})
+	status.LikeCount++
+	repo.Statuses[status.Id] = status
+	return status, nil
+}
+
+func (repo *InMemoryRepo) DeleteLike(statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	status, exists := repo.Statuses[statusId]
+	if !exists {
+		return statuses.Status{}, internal.NotFoundError(statusId)
+	}
+	if !containsEntry(repo.likes[statusId], userId) {
+		return statuses.Status{}, NotLikedError
 	}
 
+	repo.likes[statusId] = removeEntry(repo.likes[statusId], userId)
+	repo.userLikes[userId] = removeEntry(repo.userLikes[userId], statusId)
+	status.LikeCount--
+	repo.Statuses[status.Id] = status
 	return status, nil
 }
+
+const postgresStatusColumns = "id, content, user_id, created_at, updated_at, edited_at, in_reply_to_id, repost_count, like_count, tags, mentions, media_ids, publish_at, visibility, poll, poll_votes, poll_voter_count, spoiler_text, sensitive, deleted_at, pinned_at"
+
+type PostgresRepo struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepo(db *sqlx.DB) Repository {
+	return &PostgresRepo{db}
+}
+
+func (r *PostgresRepo) List() ([]statuses.Status, error) {
+	var rows []postgresStatus
+	err := r.db.Select(&rows, "SELECT "+postgresStatusColumns+" FROM statuses")
+	if err != nil {
+		return nil, err
+	}
+	return toStatuses(rows), nil
+}
+
+func (r *PostgresRepo) ListByUser(userId uuid.UUID, query statuses.PageQuery) (statuses.StatusPage, error) {
+	var cursorTime *time.Time
+	var cursorId *uuid.UUID
+	if query.Cursor != nil {
+		cursorTime = &query.Cursor.Time
+		cursorId = &query.Cursor.Id
+	}
+
+	rows := make([]postgresStatus, 0)
+	err := r.db.Select(&rows, `SELECT `+postgresStatusColumns+` FROM statuses
+		WHERE user_id = $1 AND publish_at IS NULL
+		AND ($2::timestamptz IS NULL OR (created_at, id) < ($2::timestamptz, $3::uuid))
+		AND ($4::timestamptz IS NULL OR created_at >= $4::timestamptz)
+		AND ($5::timestamptz IS NULL OR created_at < $5::timestamptz)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $6`, userId, cursorTime, cursorId, query.Since, query.Until, query.Limit+1)
+	if err != nil {
+		return statuses.StatusPage{}, err
+	}
+	page := toStatuses(rows)
+
+	var next *statuses.Cursor
+	if len(page) > query.Limit {
+		page = page[:query.Limit]
+		last := page[len(page)-1]
+		next = &statuses.Cursor{Time: last.CreatedAt, Id: last.Id}
+	}
+
+	return statuses.StatusPage{Statuses: page, Next: next}, nil
+}
+
+func (r *PostgresRepo) ListByTag(tag string, query statuses.PageQuery) (statuses.StatusPage, error) {
+	var cursorTime *time.Time
+	var cursorId *uuid.UUID
+	if query.Cursor != nil {
+		cursorTime = &query.Cursor.Time
+		cursorId = &query.Cursor.Id
+	}
+
+	rows := make([]postgresStatus, 0)
+	err := r.db.Select(&rows, `SELECT `+postgresStatusColumns+` FROM statuses
+		WHERE tags @> ARRAY[$1::text] AND publish_at IS NULL
+		AND ($2::timestamptz IS NULL OR (created_at, id) < ($2::timestamptz, $3::uuid))
+		AND ($4::timestamptz IS NULL OR created_at >= $4::timestamptz)
+		AND ($5::timestamptz IS NULL OR created_at < $5::timestamptz)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $6`, tag, cursorTime, cursorId, query.Since, query.Until, query.Limit+1)
+	if err != nil {
+		return statuses.StatusPage{}, err
+	}
+	page := toStatuses(rows)
+
+	var next *statuses.Cursor
+	if len(page) > query.Limit {
+		page = page[:query.Limit]
+		last := page[len(page)-1]
+		next = &statuses.Cursor{Time: last.CreatedAt, Id: last.Id}
+	}
+
+	return statuses.StatusPage{Statuses: page, Next: next}, nil
+}
+
+func (r *PostgresRepo) Get(statusId uuid.UUID) (statuses.Status, error) {
+	row := postgresStatus{}
+	err := r.db.Get(&row, "SELECT "+postgresStatusColumns+" FROM statuses WHERE id=$1", statusId)
+	if err != nil {
+		return statuses.Status{}, internal.NotFoundError(statusId)
+	}
+	return row.toStatus(), nil
+}
+
+func (r *PostgresRepo) Delete(statusId uuid.UUID) (statuses.Status, error) {
+	status, err := r.Get(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	_, err = r.db.Exec("DELETE FROM statuses WHERE id=$1", statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return status, nil
+}
+
+func (r *PostgresRepo) Create(status statuses.Status) (statuses.Status, error) {
+	payload, err := json.Marshal(status)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec("INSERT INTO statuses (id, content, user_id, created_at, updated_at, in_reply_to_id, tags, mentions, media_ids, publish_at, visibility, poll, spoiler_text, sensitive) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+		status.Id, status.Content, status.UserId, status.CreatedAt, status.UpdatedAt, status.InReplyToId, tagsArray(status.Tags), postgresMentions(status.Mentions), postgresUUIDs(status.MediaIds), status.PublishAt, status.Visibility, (*postgresPoll)(status.Poll), status.SpoilerText, status.Sensitive)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	if status.PublishAt == nil {
+		_, err = tx.Exec("INSERT INTO status_outbox (status_id, payload, created_at) VALUES ($1, $2, $3)", status.Id, payload, status.CreatedAt)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return status, nil
+}
+
+func (r *PostgresRepo) ListScheduled(userId uuid.UUID) ([]statuses.Status, error) {
+	rows := make([]postgresStatus, 0)
+	err := r.db.Select(&rows, "SELECT "+postgresStatusColumns+" FROM statuses WHERE user_id=$1 AND publish_at IS NOT NULL ORDER BY publish_at, id", userId)
+	if err != nil {
+		return nil, err
+	}
+	return toStatuses(rows), nil
+}
+
+func (r *PostgresRepo) ListDue(now time.Time, limit int) ([]statuses.Status, error) {
+	rows := make([]postgresStatus, 0)
+	err := r.db.Select(&rows, "SELECT "+postgresStatusColumns+" FROM statuses WHERE publish_at <= $1 ORDER BY publish_at, id LIMIT $2", now, limit)
+	if err != nil {
+		return nil, err
+	}
+	return toStatuses(rows), nil
+}
+
+// notScheduled tells why a statement changing a scheduled status didn't match any row
+func (r *PostgresRepo) notScheduled(statusId uuid.UUID) error {
+	_, err := r.Get(statusId)
+	if err != nil {
+		return err
+	}
+	return NotScheduledError
+}
+
+func (r *PostgresRepo) Reschedule(statusId uuid.UUID, publishAt time.Time) (statuses.Status, error) {
+	row := postgresStatus{}
+	err := r.db.Get(&row, "UPDATE statuses SET publish_at=$2 WHERE id=$1 AND publish_at IS NOT NULL RETURNING "+postgresStatusColumns, statusId, publishAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return statuses.Status{}, r.notScheduled(statusId)
+	}
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return row.toStatus(), nil
+}
+
+// PublishScheduled relies on the row lock of the update, a concurrent call waits for it and then matches no row
+func (r *PostgresRepo) PublishScheduled(statusId uuid.UUID, publishedAt time.Time) (statuses.Status, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	row := postgresStatus{}
+	err = tx.Get(&row, `UPDATE statuses SET publish_at=NULL, created_at=$2, updated_at=$2
+		WHERE id=$1 AND publish_at IS NOT NULL RETURNING `+postgresStatusColumns, statusId, publishedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return statuses.Status{}, r.notScheduled(statusId)
+	}
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	status := row.toStatus()
+
+	payload, err := json.Marshal(status)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	_, err = tx.Exec("INSERT INTO status_outbox (status_id, payload, created_at) VALUES ($1, $2, $3)", status.Id, payload, status.CreatedAt)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return status, nil
+}
+
+func (r *PostgresRepo) CancelScheduled(statusId uuid.UUID) (statuses.Status, error) {
+	row := postgresStatus{}
+	err := r.db.Get(&row, "DELETE FROM statuses WHERE id=$1 AND publish_at IS NOT NULL RETURNING "+postgresStatusColumns, statusId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return statuses.Status{}, r.notScheduled(statusId)
+	}
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return row.toStatus(), nil
+}
+
+// Tombstone drops the outbox entry of the status in the same transaction, so a created event that wasn't relayed yet
+// can't follow the deleted event
+func (r *PostgresRepo) Tombstone(statusId uuid.UUID, deletedAt time.Time) (statuses.Status, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	row := postgresStatus{}
+	err = tx.Get(&row, "UPDATE statuses SET deleted_at=$2 WHERE id=$1 AND deleted_at IS NULL RETURNING "+postgresStatusColumns, statusId, deletedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return statuses.Status{}, internal.NotFoundError(statusId)
+	}
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	_, err = tx.Exec("DELETE FROM status_outbox WHERE status_id=$1", statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return row.toStatus(), nil
+}
+
+// notDeleted tells why a statement changing a tombstone didn't match any row
+func (r *PostgresRepo) notDeleted(statusId uuid.UUID) error {
+	_, err := r.Get(statusId)
+	if err != nil {
+		return err
+	}
+	return NotDeletedError
+}
+
+func (r *PostgresRepo) Restore(statusId uuid.UUID, restoredAt time.Time) (statuses.Status, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	row := postgresStatus{}
+	err = tx.Get(&row, "UPDATE statuses SET deleted_at=NULL WHERE id=$1 AND deleted_at IS NOT NULL RETURNING "+postgresStatusColumns, statusId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return statuses.Status{}, r.notDeleted(statusId)
+	}
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	status := row.toStatus()
+
+	payload, err := json.Marshal(status)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	_, err = tx.Exec(`INSERT INTO status_outbox (status_id, payload, created_at) VALUES ($1, $2, $3)
+		ON CONFLICT (status_id) DO UPDATE SET payload=EXCLUDED.payload, created_at=EXCLUDED.created_at, sent_at=NULL`, status.Id, payload, restoredAt)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return status, nil
+}
+
+func (r *PostgresRepo) ListExpired(deletedBefore time.Time, limit int) ([]statuses.Status, error) {
+	rows := make([]postgresStatus, 0)
+	err := r.db.Select(&rows, "SELECT "+postgresStatusColumns+" FROM statuses WHERE deleted_at < $1 ORDER BY deleted_at, id LIMIT $2", deletedBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+	return toStatuses(rows), nil
+}
+
+// Purge relies on the row lock of the delete, a concurrent restore either waits for it or makes it match no row
+func (r *PostgresRepo) Purge(statusId uuid.UUID, deletedBefore time.Time) (statuses.Status, error) {
+	row := postgresStatus{}
+	err := r.db.Get(&row, "DELETE FROM statuses WHERE id=$1 AND deleted_at < $2 RETURNING "+postgresStatusColumns, statusId, deletedBefore)
+	if errors.Is(err, sql.ErrNoRows) {
+		return statuses.Status{}, r.notDeleted(statusId)
+	}
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return row.toStatus(), nil
+}
+
+// Pin holds a lock on the pins of the author until the commit, so concurrent pins are counted one after the other
+func (r *PostgresRepo) Pin(statusId uuid.UUID, pinnedAt time.Time, max int) (statuses.Status, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	row := postgresStatus{}
+	err = tx.Get(&row, "SELECT "+postgresStatusColumns+" FROM statuses WHERE id=$1", statusId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return statuses.Status{}, internal.NotFoundError(statusId)
+	}
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	userId := row.toStatus().UserId
+
+	// A user without pins has no rows to lock, so the lock is taken on the user
+	_, err = tx.Exec("SELECT pg_advisory_xact_lock(hashtext($1))", userPinsIndexKey(userId))
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	var pinned int
+	err = tx.Get(&pinned, "SELECT count(*) FROM statuses WHERE user_id=$1 AND pinned_at IS NOT NULL", userId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	err = tx.Get(&row, "UPDATE statuses SET pinned_at=$2 WHERE id=$1 AND pinned_at IS NULL RETURNING "+postgresStatusColumns, statusId, pinnedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return statuses.Status{}, AlreadyPinnedError
+	}
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	if pinned >= max {
+		return statuses.Status{}, TooManyPinsError
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return row.toStatus(), nil
+}
+
+func (r *PostgresRepo) Unpin(statusId uuid.UUID) (statuses.Status, error) {
+	row := postgresStatus{}
+	err := r.db.Get(&row, "UPDATE statuses SET pinned_at=NULL WHERE id=$1 AND pinned_at IS NOT NULL RETURNING "+postgresStatusColumns, statusId)
+	if errors.Is(err, sql.ErrNoRows) {
+		_, err := r.Get(statusId)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+		return statuses.Status{}, NotPinnedError
+	}
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return row.toStatus(), nil
+}
+
+func (r *PostgresRepo) ListPinned(userId uuid.UUID) ([]statuses.Status, error) {
+	rows := make([]postgresStatus, 0)
+	err := r.db.Select(&rows, "SELECT "+postgresStatusColumns+" FROM statuses WHERE user_id=$1 AND pinned_at IS NOT NULL ORDER BY pinned_at DESC, id DESC", userId)
+	if err != nil {
+		return nil, err
+	}
+	return toStatuses(rows), nil
+}
+
+func (r *PostgresRepo) PendingEvents(limit int) ([]OutboxEntry, error) {
+	rows := make([]struct {
+		Payload   []byte     `db:"payload"`
+		CreatedAt time.Time  `db:"created_at"`
+		SentAt    *time.Time `db:"sent_at"`
+	}, 0)
+	err := r.db.Select(&rows, `SELECT outbox.payload, outbox.created_at, outbox.sent_at FROM status_outbox outbox
+		JOIN statuses ON statuses.id=outbox.status_id
+		WHERE outbox.sent_at IS NULL AND statuses.deleted_at IS NULL ORDER BY outbox.created_at, outbox.status_id LIMIT $1`, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]OutboxEntry, len(rows))
+	for i, row := range rows {
+		var status statuses.Status
+		err = json.Unmarshal(row.Payload, &status)
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = OutboxEntry{Status: status, CreatedAt: row.CreatedAt.UTC(), SentAt: row.SentAt}
+	}
+	return entries, nil
+}
+
+func (r *PostgresRepo) MarkEventSent(statusId uuid.UUID, sentAt time.Time) error {
+	_, err := r.db.Exec("UPDATE status_outbox SET sent_at=$2 WHERE status_id=$1", statusId, sentAt)
+	return err
+}
+
+func (r *PostgresRepo) Update(status statuses.Status) (statuses.Status, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// The row stays locked until the commit, so concurrent edits each keep the version before them as a revision
+	_, err = tx.Exec(`INSERT INTO status_revisions (status_id, content, media_ids, created_at)
+		SELECT id, content, media_ids, COALESCE(edited_at, created_at) FROM statuses WHERE id=$1 FOR UPDATE`, status.Id)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	row := postgresStatus{}
+	err = tx.Get(&row, `UPDATE statuses SET content=$2, updated_at=$3, edited_at=$4, tags=$5, mentions=$6, media_ids=$7, spoiler_text=$8, sensitive=$9
+		WHERE id=$1 RETURNING `+postgresStatusColumns,
+		status.Id, status.Content, status.UpdatedAt, status.EditedAt, tagsArray(status.Tags), postgresMentions(status.Mentions), postgresUUIDs(status.MediaIds), status.SpoilerText, status.Sensitive)
+	if errors.Is(err, sql.ErrNoRows) {
+		return statuses.Status{}, internal.NotFoundError(status.Id)
+	}
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return row.toStatus(), nil
+}
+
+// Vote inserts the vote, whose primary key lets every user vote once, and counts it in the row of the status.
+// The row is locked while counting, so concurrent votes are counted one after the other.
+func (r *PostgresRepo) Vote(vote statuses.PollVote) (statuses.Poll, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return statuses.Poll{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	row := postgresStatus{}
+	err = tx.Get(&row, "SELECT "+postgresStatusColumns+" FROM statuses WHERE id=$1 FOR UPDATE", vote.StatusId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return statuses.Poll{}, internal.NotFoundError(vote.StatusId)
+	}
+	if err != nil {
+		return statuses.Poll{}, err
+	}
+
+	status := row.toStatus()
+	if status.Poll == nil {
+		return statuses.Poll{}, NoPollError
+	}
+	if status.Poll.Expired(vote.CreatedAt) {
+		return statuses.Poll{}, PollExpiredError
+	}
+
+	result, err := tx.Exec("INSERT INTO status_poll_votes (status_id, user_id, choices, created_at) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING",
+		vote.StatusId, vote.UserId, pq.Array(vote.Choices), vote.CreatedAt)
+	if err != nil {
+		return statuses.Poll{}, err
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return statuses.Poll{}, err
+	}
+	if inserted == 0 {
+		return statuses.Poll{}, AlreadyVotedError
+	}
+
+	poll := *status.Poll
+	tally(&poll, vote.Choices)
+	_, err = tx.Exec("UPDATE statuses SET poll_votes=$2, poll_voter_count=$3 WHERE id=$1", vote.StatusId, pq.Array(poll.Votes), poll.VoterCount)
+	if err != nil {
+		return statuses.Poll{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return statuses.Poll{}, err
+	}
+	return poll, nil
+}
+
+func (r *PostgresRepo) History(statusId uuid.UUID) ([]statuses.Revision, error) {
+	rows := make([]struct {
+		statuses.Revision
+		MediaIds postgresUUIDs `db:"media_ids"`
+	}, 0)
+	err := r.db.Select(&rows, "SELECT status_id, content, media_ids, created_at FROM status_revisions WHERE status_id=$1 ORDER BY created_at", statusId)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]statuses.Revision, len(rows))
+	for i, row := range rows {
+		revisions[i] = row.Revision
+		revisions[i].CreatedAt = row.CreatedAt.UTC()
+		if len(row.MediaIds) > 0 {
+			revisions[i].MediaIds = row.MediaIds
+		}
+	}
+	return revisions, nil
+}
+
+func (r *PostgresRepo) Replies(statusId uuid.UUID) ([]statuses.Status, error) {
+	rows := make([]postgresStatus, 0)
+	err := r.db.Select(&rows, "SELECT "+postgresStatusColumns+" FROM statuses WHERE in_reply_to_id=$1 AND publish_at IS NULL ORDER BY created_at, id", statusId)
+	if err != nil {
+		return nil, err
+	}
+	return toStatuses(rows), nil
+}
+
+func (r *PostgresRepo) CreateRepost(repost statuses.Repost) (statuses.Status, error) {
+	_, err := r.Get(repost.StatusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.Exec("INSERT INTO status_reposts (status_id, user_id, created_at) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING",
+		repost.StatusId, repost.UserId, repost.CreatedAt)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	if inserted == 0 {
+		return statuses.Status{}, AlreadyRepostedError
+	}
+
+	row := postgresStatus{}
+	err = tx.Get(&row, "UPDATE statuses SET repost_count = repost_count + 1 WHERE id=$1 RETURNING "+postgresStatusColumns, repost.StatusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return row.toStatus(), nil
+}
+
+func (r *PostgresRepo) DeleteRepost(statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	_, err := r.Get(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.Exec("DELETE FROM status_reposts WHERE status_id=$1 AND user_id=$2", statusId, userId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	if deleted == 0 {
+		return statuses.Status{}, NotRepostedError
+	}
+
+	row := postgresStatus{}
+	err = tx.Get(&row, "UPDATE statuses SET repost_count = repost_count - 1 WHERE id=$1 RETURNING "+postgresStatusColumns, statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return row.toStatus(), nil
+}
+
+func (r *PostgresRepo) Reposts(statusId uuid.UUID) ([]statuses.Repost, error) {
+	reposts := make([]statuses.Repost, 0)
+	err := r.db.Select(&reposts, "SELECT status_id, user_id, created_at FROM status_reposts WHERE status_id=$1", statusId)
+	if err != nil {
+		return nil, err
+	}
+	return reposts, nil
+}
+
+func (r *PostgresRepo) ListLikes(statusId uuid.UUID, query statuses.PageQuery) (statuses.LikePage, error) {
+	var cursorTime *time.Time
+	var cursorId *uuid.UUID
+	if query.Cursor != nil {
+		cursorTime = &query.Cursor.Time
+		cursorId = &query.Cursor.Id
+	}
+
+	likes := make([]statuses.Like, 0)
+	err := r.db.Select(&likes, `SELECT status_id, user_id, created_at FROM status_likes
+		WHERE status_id = $1
+		AND ($2::timestamptz IS NULL OR (created_at, user_id) < ($2::timestamptz, $3::uuid))
+		ORDER BY created_at DESC, user_id DESC
+		LIMIT $4`, statusId, cursorTime, cursorId, query.Limit+1)
+	if err != nil {
+		return statuses.LikePage{}, err
+	}
+	for i := range likes {
+		likes[i].CreatedAt = likes[i].CreatedAt.UTC()
+	}
+
+	var next *statuses.Cursor
+	if len(likes) > query.Limit {
+		likes = likes[:query.Limit]
+		last := likes[len(likes)-1]
+		next = &statuses.Cursor{Time: last.CreatedAt, Id: last.UserId}
+	}
+
+	return statuses.LikePage{Likes: likes, Next: next}, nil
+}
+
+func (r *PostgresRepo) ListLikedByUser(userId uuid.UUID, query statuses.PageQuery) (statuses.StatusPage, error) {
+	var cursorTime *time.Time
+	var cursorId *uuid.UUID
+	if query.Cursor != nil {
+		cursorTime = &query.Cursor.Time
+		cursorId = &query.Cursor.Id
+	}
+
+	var likedStatuses []struct {
+		postgresStatus
+		LikedAt time.Time `db:"liked_at"`
+	}
+	err := r.db.Select(&likedStatuses, `WITH liked AS (
+			SELECT status_id, created_at AS liked_at FROM status_likes
+			WHERE user_id = $1
+			AND ($2::timestamptz IS NULL OR (created_at, status_id) < ($2::timestamptz, $3::uuid))
+			ORDER BY created_at DESC, status_id DESC
+			LIMIT $4
+		)
+		SELECT `+postgresStatusColumns+`, liked_at FROM statuses JOIN liked ON liked.status_id = statuses.id
+		ORDER BY liked_at DESC, id DESC`, userId, cursorTime, cursorId, query.Limit+1)
+	if err != nil {
+		return statuses.StatusPage{}, err
+	}
+
+	var next *statuses.Cursor
+	if len(likedStatuses) > query.Limit {
+		likedStatuses = likedStatuses[:query.Limit]
+		last := likedStatuses[len(likedStatuses)-1]
+		next = &statuses.Cursor{Time: last.LikedAt.UTC(), Id: last.Id}
+	}
+
+	page := make([]statuses.Status, len(likedStatuses))
+	for i, likedStatus := range likedStatuses {
+		page[i] = likedStatus.toStatus()
+	}
+
+	return statuses.StatusPage{Statuses: page, Next: next}, nil
+}
+
+func (r *PostgresRepo) CreateLike(like statuses.Like) (statuses.Status, error) {
+	_, err := r.Get(like.StatusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.Exec("INSERT INTO status_likes (status_id, user_id, created_at) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING",
+		like.StatusId, like.UserId, like.CreatedAt)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	if inserted == 0 {
+		return statuses.Status{}, AlreadyLikedError
+	}
+
+	// Incrementing in the database keeps the count right when likes arrive concurrently
+	row := postgresStatus{}
+	err = tx.Get(&row, "UPDATE statuses SET like_count = like_count + 1 WHERE id=$1 RETURNING "+postgresStatusColumns, like.StatusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return row.toStatus(), nil
+}
+
+func (r *PostgresRepo) DeleteLike(statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	_, err := r.Get(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.Exec("DELETE FROM status_likes WHERE status_id=$1 AND user_id=$2", statusId, userId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	if deleted == 0 {
+		return statuses.Status{}, NotLikedError
+	}
+
+	row := postgresStatus{}
+	err = tx.Get(&row, "UPDATE statuses SET like_count = like_count - 1 WHERE id=$1 RETURNING "+postgresStatusColumns, statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return row.toStatus(), nil
+}
+
+// postgresStatus is a status as it is scanned from the statuses table
+type postgresStatus struct {
+	statuses.Status
+	Tags           pq.StringArray   `db:"tags"`
+	Mentions       postgresMentions `db:"mentions"`
+	MediaIds       postgresUUIDs    `db:"media_ids"`
+	Poll           *postgresPoll    `db:"poll"`
+	PollVotes      pq.Int64Array    `db:"poll_votes"`
+	PollVoterCount int              `db:"poll_voter_count"`
+}
+
+func (row postgresStatus) toStatus() statuses.Status {
+	status := row.Status
+	if len(row.Tags) > 0 {
+		status.Tags = row.Tags
+	}
+	if len(row.Mentions) > 0 {
+		status.Mentions = row.Mentions
+	}
+	if len(row.MediaIds) > 0 {
+		status.MediaIds = row.MediaIds
+	}
+	if row.Poll != nil {
+		poll := statuses.Poll(*row.Poll)
+		poll.Votes = make([]int, len(poll.Options))
+		for i := 0; i < len(row.PollVotes) && i < len(poll.Votes); i++ {
+			poll.Votes[i] = int(row.PollVotes[i])
+		}
+		poll.VoterCount = row.PollVoterCount
+		status.Poll = &poll
+	}
+	normalizeTimestamps(&status)
+	return status
+}
+
+// tagsArray stores missing tags as an empty array, the column is not nullable
+func tagsArray(tags []string) pq.StringArray {
+	if tags == nil {
+		return pq.StringArray{}
+	}
+	return tags
+}
+
+// postgresMentions is stored as a json array, missing mentions as an empty one
+type postgresMentions []statuses.Mention
+
+func (mentions postgresMentions) Value() (driver.Value, error) {
+	if mentions == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]statuses.Mention(mentions))
+}
+
+func (mentions *postgresMentions) Scan(src any) error {
+	value, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("cannot scan %T into mentions", src)
+	}
+	return json.Unmarshal(value, (*[]statuses.Mention)(mentions))
+}
+
+// postgresPoll stores the options and expiry of a poll as json, its tallies are kept in columns of their own
+type postgresPoll statuses.Poll
+
+func (poll postgresPoll) Value() (driver.Value, error) {
+	definition := statuses.Poll(poll)
+	definition.Votes = nil
+	definition.VoterCount = 0
+	return json.Marshal(definition)
+}
+
+func (poll *postgresPoll) Scan(src any) error {
+	value, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("cannot scan %T into poll", src)
+	}
+	return json.Unmarshal(value, (*statuses.Poll)(poll))
+}
+
+// postgresUUIDs is stored as an uuid array, missing ids as an empty one
+type postgresUUIDs []uuid.UUID
+
+func (ids postgresUUIDs) Value() (driver.Value, error) {
+	values := make(pq.StringArray, len(ids))
+	for i, id := range ids {
+		values[i] = id.String()
+	}
+	return values.Value()
+}
+
+func (ids *postgresUUIDs) Scan(src any) error {
+	var values pq.StringArray
+	err := values.Scan(src)
+	if err != nil {
+		return err
+	}
+
+	*ids = make(postgresUUIDs, len(values))
+	for i, value := range values {
+		(*ids)[i], err = uuid.Parse(value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toStatuses(rows []postgresStatus) []statuses.Status {
+	all := make([]statuses.Status, len(rows))
+	for i, row := range rows {
+		all[i] = row.toStatus()
+	}
+	return all
+}
+
+// normalizeTimestamps drops the location the postgres driver attaches, so scanned statuses equal the ones that were stored
+func normalizeTimestamps(status *statuses.Status) {
+	status.CreatedAt = status.CreatedAt.UTC()
+	status.UpdatedAt = status.UpdatedAt.UTC()
+	if status.EditedAt != nil {
+		status.EditedAt = internal.Ptr(status.EditedAt.UTC())
+	}
+	if status.PublishAt != nil {
+		status.PublishAt = internal.Ptr(status.PublishAt.UTC())
+	}
+	if status.DeletedAt != nil {
+		status.DeletedAt = internal.Ptr(status.DeletedAt.UTC())
+	}
+	if status.PinnedAt != nil {
+		status.PinnedAt = internal.Ptr(status.PinnedAt.UTC())
+	}
+	if status.Poll != nil {
+		status.Poll.ExpiresAt = status.Poll.ExpiresAt.UTC()
+	}
+}
+
+type DaprStateStoreRepo struct {
+	dapr   dapr.Client
+	config internal.StateStoreConfig
+}
+
+func NewDaprStateStore(client dapr.Client, config internal.StateStoreConfig) *DaprStateStoreRepo {
+	return &DaprStateStoreRepo{client, config}
+}
+
+func (repo *DaprStateStoreRepo) List() ([]statuses.Status, error) {
+	ctx := context.Background()
+	shardKeys := make([]string, statusKeysShards)
+	for shard := range shardKeys {
+		shardKeys[shard] = statusKeysShardKey(shard)
+	}
+
+	shards, err := repo.dapr.GetBulkState(ctx, repo.config.Name, shardKeys, nil, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	listOfKeys := make([]string, 0)
+	for _, shard := range shards {
+		if shard.Value == nil {
+			continue
+		}
+		keySet := internal.NewSet[uuid.UUID]()
+		err = json.Unmarshal(shard.Value, &keySet)
+		if err != nil {
+			return nil, err
+		}
+		for _, statusId := range keySet.ToArray() {
+			listOfKeys = append(listOfKeys, statusId.String())
+		}
+	}
+	if len(listOfKeys) == 0 {
+		return make([]statuses.Status, 0), nil
+	}
+
+	states, err := repo.dapr.GetBulkState(ctx, repo.config.Name, listOfKeys, nil, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	allStatuses := make([]statuses.Status, 0, len(states))
+	for _, state := range states {
+		if state.Value == nil {
+			continue
+		}
+		var status statuses.Status
+		err = json.Unmarshal(state.Value, &status)
+		if err != nil {
+			return nil, err
+		}
+		allStatuses = append(allStatuses, status)
+	}
+
+	err = repo.withPollTallies(ctx, allStatuses)
+	if err != nil {
+		return nil, err
+	}
+	return allStatuses, nil
+}
+
+// changeStatusKeysOp adds the key of a status to its shard of the keys of all statuses, or removes it.
+// The shard is written with its etag.
+func (repo *DaprStateStoreRepo) changeStatusKeysOp(ctx context.Context, statusId uuid.UUID, add bool) (*dapr.StateOperation, error) {
+	item, err := repo.dapr.GetState(ctx, repo.config.Name, statusKeysKey(statusId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	keySet := internal.NewSet[uuid.UUID]()
+	if item.Value != nil {
+		err = json.Unmarshal(item.Value, &keySet)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if add {
+		keySet.Add(statusId)
+	} else {
+		keySet.Remove(statusId)
+	}
+
+	keysJson, err := json.Marshal(&keySet)
+	if err != nil {
+		return nil, err
+	}
+	return saveIfUnchangedOp(statusKeysKey(statusId), keysJson, item.Etag), nil
+}
+
+func (repo *DaprStateStoreRepo) getIndex(ctx context.Context, key string) ([]indexEntry, error) {
+	entries, _, err := repo.getIndexWithEtag(ctx, key)
+	return entries, err
+}
+
+func (repo *DaprStateStoreRepo) getIndexWithEtag(ctx context.Context, key string) ([]indexEntry, string, error) {
+	item, err := repo.dapr.GetState(ctx, repo.config.Name, key, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	entries := make([]indexEntry, 0)
+	if item.Value == nil {
+		return entries, item.Etag, nil
+	}
+
+	err = json.Unmarshal(item.Value, &entries)
+	if err != nil {
+		return nil, "", err
+	}
+	return entries, item.Etag, nil
+}
+
+// saveIndexOp writes an index with the etag it was read with. Creates and deletes of different statuses change the same
+// indexes, so a transaction racing with another one fails rather than dropping its entry, and is retried.
+func (repo *DaprStateStoreRepo) saveIndexOp(key string, entries []indexEntry, etag string) (*dapr.StateOperation, error) {
+	entriesJson, err := json.Marshal(entries)
+	if err != nil {
+		return nil, err
+	}
+	return saveIfUnchangedOp(key, entriesJson, etag), nil
+}
+
+func (repo *DaprStateStoreRepo) ListByUser(userId uuid.UUID, query statuses.PageQuery) (statuses.StatusPage, error) {
+	ctx := context.Background()
+	entries, err := repo.getIndex(ctx, userIndexKey(userId))
+	if err != nil {
+		return statuses.StatusPage{}, err
+	}
+
+	pageEntries, next := pageOf(entries, query)
+	page, err := repo.getEntries(ctx, pageEntries)
+	if err != nil {
+		return statuses.StatusPage{}, err
+	}
+
+	return statuses.StatusPage{Statuses: page, Next: next}, nil
+}
+
+// getEntries returns the statuses of the given index entries in the same order, skipping missing ones
+func (repo *DaprStateStoreRepo) getEntries(ctx context.Context, entries []indexEntry) ([]statuses.Status, error) {
+	if len(entries) == 0 {
+		return make([]statuses.Status, 0), nil
+	}
+
+	keys := make([]string, len(entries))
+	for i, entry := range entries {
+		keys[i] = entry.Id.String()
+	}
+
+	states, err := repo.dapr.GetBulkState(ctx, repo.config.Name, keys, nil, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	// Bulk state items are not returned in the order of the requested keys
+	statusesByKey := make(map[string]statuses.Status, len(states))
+	for _, state := range states {
+		if state.Value == nil {
+			continue
+		}
+		var status statuses.Status
+		err = json.Unmarshal(state.Value, &status)
+		if err != nil {
+			return nil, err
+		}
+		statusesByKey[state.Key] = status
+	}
+
+	found := make([]statuses.Status, 0, len(keys))
+	for _, key := range keys {
+		status, ok := statusesByKey[key]
+		if ok {
+			found = append(found, status)
+		}
+	}
+
+	err = repo.withPollTallies(ctx, found)
+	if err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
+func (repo *DaprStateStoreRepo) Replies(statusId uuid.UUID) ([]statuses.Status, error) {
+	ctx := context.Background()
+	entries, err := repo.getIndex(ctx, repliesIndexKey(statusId))
+	if err != nil {
+		return nil, err
+	}
+
+	sortOldestFirst(entries)
+	return repo.getEntries(ctx, entries)
+}
+
+func (repo *DaprStateStoreRepo) ListByTag(tag string, query statuses.PageQuery) (statuses.StatusPage, error) {
+	ctx := context.Background()
+	entries, err := repo.getIndex(ctx, tagIndexKey(tag))
+	if err != nil {
+		return statuses.StatusPage{}, err
+	}
+
+	pageEntries, next := pageOf(entries, query)
+	page, err := repo.getEntries(ctx, pageEntries)
+	if err != nil {
+		return statuses.StatusPage{}, err
+	}
+
+	return statuses.StatusPage{Statuses: page, Next: next}, nil
+}
+
+// changeTagIndexOps removes the status from the index of each removed tag and adds it to the index of each added tag
+func (repo *DaprStateStoreRepo) changeTagIndexOps(ctx context.Context, status statuses.Status, removed []string, added []string) ([]*dapr.StateOperation, error) {
+	operations := make([]*dapr.StateOperation, 0, len(removed)+len(added))
+	for _, tag := range removed {
+		entries, etag, err := repo.getIndexWithEtag(ctx, tagIndexKey(tag))
+		if err != nil {
+			return nil, err
+		}
+
+		op, err := repo.saveIndexOp(tagIndexKey(tag), removeEntry(entries, status.Id), etag)
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, op)
+	}
+
+	for _, tag := range added {
+		entries, etag, err := repo.getIndexWithEtag(ctx, tagIndexKey(tag))
+		if err != nil {
+			return nil, err
+		}
+
+		op, err := repo.saveIndexOp(tagIndexKey(tag), append(entries, indexEntry{status.Id, status.CreatedAt}), etag)
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, op)
+	}
+
+	return operations, nil
+}
+
+func (repo *DaprStateStoreRepo) Get(statusId uuid.UUID) (statuses.Status, error) {
+	statusItem, err := repo.dapr.GetState(context.Background(), repo.config.Name, statusId.String(), nil)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	if statusItem.Value == nil {
+		return statuses.Status{}, internal.NotFoundError(statusId)
+	}
+
+	var status statuses.Status
+	err = json.Unmarshal(statusItem.Value, &status)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	found := []statuses.Status{status}
+	err = repo.withPollTallies(context.Background(), found)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return found[0], nil
+}
+
+// getWithEtag returns a status as it is stored, without its poll tallies, and its etag
+func (repo *DaprStateStoreRepo) getWithEtag(ctx context.Context, statusId uuid.UUID) (statuses.Status, string, error) {
+	statusItem, err := repo.dapr.GetState(ctx, repo.config.Name, statusId.String(), nil)
+	if err != nil {
+		return statuses.Status{}, "", err
+	}
+	if statusItem.Value == nil {
+		return statuses.Status{}, "", internal.NotFoundError(statusId)
+	}
+
+	var status statuses.Status
+	err = json.Unmarshal(statusItem.Value, &status)
+	if err != nil {
+		return statuses.Status{}, "", err
+	}
+	return status, statusItem.Etag, nil
+}
+
+// Delete retries when one of the indexes changed since it was read
+func (repo *DaprStateStoreRepo) Delete(statusId uuid.UUID) (statuses.Status, error) {
+	var err error
+	for attempt := 0; attempt < maxWriteAttempts; attempt++ {
+		ctx := context.Background()
+		var status statuses.Status
+		status, _, err = repo.getWithEtag(ctx, statusId)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+
+		var operations []*dapr.StateOperation
+		operations, err = repo.deleteOps(ctx, status, "")
+		if err != nil {
+			return statuses.Status{}, err
+		}
+
+		err = repo.dapr.ExecuteStateTransaction(ctx, repo.config.Name, nil, operations)
+		if err == nil {
+			return status, nil
+		}
+		// Dapr doesn't tell etag mismatches apart from other failures of a transaction
+		err = fmt.Errorf("%w: %v", concurrentWriteError, err)
+	}
+	return statuses.Status{}, err
+}
+
+// deleteOps delete a status with everything belonging to it and remove it from all indexes. The indexes are written
+// with the etags they were read with. With an etag, the status is only deleted if it wasn't written since it was read.
+func (repo *DaprStateStoreRepo) deleteOps(ctx context.Context, status statuses.Status, etag string) ([]*dapr.StateOperation, error) {
+	statusId := status.Id
+	entries, entriesEtag, err := repo.getIndexWithEtag(ctx, userIndexKey(status.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	saveIndexOp, err := repo.saveIndexOp(userIndexKey(status.UserId), removeEntry(entries, statusId), entriesEtag)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteStatusOp := dapr.StateOperation{
+		Type: dapr.StateOperationTypeDelete,
+		Item: &dapr.SetStateItem{
+			Key: statusId.String(),
+		},
+	}
+	if etag != "" {
+		deleteStatusOp.Item.Etag = &dapr.ETag{Value: etag}
+		deleteStatusOp.Item.Options = &dapr.StateOptions{Concurrency: dapr.StateConcurrencyFirstWrite}
+	}
+
+	deleteRevisionsOp := dapr.StateOperation{
+		Type: dapr.StateOperationTypeDelete,
+		Item: &dapr.SetStateItem{
+			Key: revisionsKey(statusId),
+		},
+	}
+
+	deleteRepostsOp := dapr.StateOperation{
+		Type: dapr.StateOperationTypeDelete,
+		Item: &dapr.SetStateItem{
+			Key: repostsKey(statusId),
+		},
+	}
+
+	deleteLikesOp := dapr.StateOperation{
+		Type: dapr.StateOperationTypeDelete,
+		Item: &dapr.SetStateItem{
+			Key: likesIndexKey(statusId),
+		},
+	}
+
+	// The votes stay behind, nothing reads them without the poll tallies
+	deletePollOp := dapr.StateOperation{
+		Type: dapr.StateOperationTypeDelete,
+		Item: &dapr.SetStateItem{
+			Key: pollKey(statusId),
+		},
+	}
+
+	tagOps, err := repo.changeTagIndexOps(ctx, status, status.Tags, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	saveKeyOp, err := repo.changeStatusKeysOp(ctx, statusId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	operations := []*dapr.StateOperation{&deleteStatusOp, &deleteRevisionsOp, &deleteRepostsOp, &deleteLikesOp, &deletePollOp, deleteOutboxEntryOp(statusId), saveIndexOp, saveKeyOp}
+	operations = append(operations, tagOps...)
+	if status.InReplyToId != nil {
+		replies, repliesEtag, err := repo.getIndexWithEtag(ctx, repliesIndexKey(*status.InReplyToId))
+		if err != nil {
+			return nil, err
+		}
+
+		saveRepliesOp, err := repo.saveIndexOp(repliesIndexKey(*status.InReplyToId), removeEntry(replies, statusId), repliesEtag)
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, saveRepliesOp)
+	}
+
+	if status.DeletedAt != nil {
+		tombstonesOp, err := repo.changeTombstonesOp(ctx, statusId, nil)
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, tombstonesOp)
+	}
+
+	if status.PinnedAt != nil {
+		pinned, pinnedEtag, err := repo.getIndexWithEtag(ctx, userPinsIndexKey(status.UserId))
+		if err != nil {
+			return nil, err
+		}
+
+		savePinsOp, err := repo.saveIndexOp(userPinsIndexKey(status.UserId), removeEntry(pinned, statusId), pinnedEtag)
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, savePinsOp)
+	}
+
+	return operations, nil
+}
+
+func revisionsKey(statusId uuid.UUID) string {
+	return fmt.Sprintf("status-revisions-%s", statusId.String())
+}
+
+// Update writes the status and its revisions with their etags, so an edit can't undo a concurrent like, repost, pin or
+// deletion. Conflicting changes are retried.
+func (repo *DaprStateStoreRepo) Update(status statuses.Status) (statuses.Status, error) {
+	var err error
+	for attempt := 0; attempt < maxWriteAttempts; attempt++ {
+		var updated statuses.Status
+		updated, err = repo.tryUpdate(context.Background(), status)
+		if !errors.Is(err, concurrentWriteError) {
+			return updated, err
+		}
+	}
+	return statuses.Status{}, err
+}
+
+func (repo *DaprStateStoreRepo) tryUpdate(ctx context.Context, edited statuses.Status) (statuses.Status, error) {
+	previous, etag, err := repo.getWithEtag(ctx, edited.Id)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	revisions, revisionsEtag, err := repo.getRevisionsWithEtag(ctx, edited.Id)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	status := withEdit(previous, edited)
+	statusJson, err := json.Marshal(status)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	revisionsJson, err := json.Marshal(append(revisions, revisionOf(previous)))
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	operations := []*dapr.StateOperation{
+		saveIfUnchangedOp(status.Id.String(), statusJson, etag),
+		saveIfUnchangedOp(revisionsKey(status.Id), revisionsJson, revisionsEtag),
+	}
+	// Scheduled statuses are added to the tag indexes once they are published
+	if status.PublishAt == nil {
+		removed, added := changedTags(previous.Tags, status.Tags)
+		tagOps, err := repo.changeTagIndexOps(ctx, status, removed, added)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+		operations = append(operations, tagOps...)
+	}
+
+	err = repo.dapr.ExecuteStateTransaction(ctx, repo.config.Name, nil, operations)
+	if err != nil {
+		// Dapr doesn't tell etag mismatches apart from other failures of a transaction
+		return statuses.Status{}, fmt.Errorf("%w: %v", concurrentWriteError, err)
+	}
+
+	updated := []statuses.Status{status}
+	err = repo.withPollTallies(ctx, updated)
+	return updated[0], err
+}
+
+func (repo *DaprStateStoreRepo) History(statusId uuid.UUID) ([]statuses.Revision, error) {
+	revisions, _, err := repo.getRevisionsWithEtag(context.Background(), statusId)
+	return revisions, err
+}
+
+func (repo *DaprStateStoreRepo) getRevisionsWithEtag(ctx context.Context, statusId uuid.UUID) ([]statuses.Revision, string, error) {
+	item, err := repo.dapr.GetState(ctx, repo.config.Name, revisionsKey(statusId), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	revisions := make([]statuses.Revision, 0)
+	if item.Value == nil {
+		return revisions, item.Etag, nil
+	}
+
+	err = json.Unmarshal(item.Value, &revisions)
+	if err != nil {
+		return nil, "", err
+	}
+	return revisions, item.Etag, nil
+}
+
+func repostsKey(statusId uuid.UUID) string {
+	return fmt.Sprintf("status-reposts-%s", statusId.String())
+}
+
+func (repo *DaprStateStoreRepo) getReposters(ctx context.Context, statusId uuid.UUID) (reposters, string, error) {
+	item, err := repo.dapr.GetState(ctx, repo.config.Name, repostsKey(statusId), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	reposters := reposters{}
+	if item.Value == nil {
+		return reposters, item.Etag, nil
+	}
+
+	// Reposters used to be stored as a set, the time of those reposts is unknown
+	if item.Value[0] == '[' {
+		legacy := internal.NewSet[uuid.UUID]()
+		err = json.Unmarshal(item.Value, &legacy)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, reposterId := range legacy.ToArray() {
+			reposters[reposterId] = time.Time{}
+		}
+		return reposters, item.Etag, nil
+	}
+
+	err = json.Unmarshal(item.Value, &reposters)
+	if err != nil {
+		return nil, "", err
+	}
+	return reposters, item.Etag, nil
+}
+
+// changeRepost applies change to a status and its reposters. Both are written with their etags, so two reposts of the
+// same status can't overwrite each others repost count. Conflicting changes are retried.
+func (repo *DaprStateStoreRepo) changeRepost(statusId uuid.UUID, change func(status *statuses.Status, reposters reposters) error) (statuses.Status, error) {
+	var err error
+	for attempt := 0; attempt < maxWriteAttempts; attempt++ {
+		var status statuses.Status
+		status, err = repo.tryChangeRepost(context.Background(), statusId, change)
+		if !errors.Is(err, concurrentWriteError) {
+			return status, err
+		}
+	}
+	return statuses.Status{}, err
+}
+
+func (repo *DaprStateStoreRepo) tryChangeRepost(ctx context.Context, statusId uuid.UUID, change func(status *statuses.Status, reposters reposters) error) (statuses.Status, error) {
+	status, etag, err := repo.getWithEtag(ctx, statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	reposters, repostersEtag, err := repo.getReposters(ctx, statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	err = change(&status, reposters)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	statusJson, err := json.Marshal(status)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	repostersJson, err := json.Marshal(reposters)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	err = repo.dapr.ExecuteStateTransaction(ctx, repo.config.Name, nil, []*dapr.StateOperation{
+		saveIfUnchangedOp(statusId.String(), statusJson, etag),
+		saveIfUnchangedOp(repostsKey(statusId), repostersJson, repostersEtag),
+	})
+	if err != nil {
+		// Dapr doesn't tell etag mismatches apart from other failures of a transaction
+		return statuses.Status{}, fmt.Errorf("%w: %v", concurrentWriteError, err)
+	}
+
+	changed := []statuses.Status{status}
+	err = repo.withPollTallies(ctx, changed)
+	return changed[0], err
+}
+
+func (repo *DaprStateStoreRepo) CreateRepost(repost statuses.Repost) (statuses.Status, error) {
+	return repo.changeRepost(repost.StatusId, func(status *statuses.Status, reposters reposters) error {
+		if _, reposted := reposters[repost.UserId]; reposted {
+			return AlreadyRepostedError
+		}
+		reposters[repost.UserId] = repost.CreatedAt
+		status.RepostCount++
+		return nil
+	})
+}
+
+func (repo *DaprStateStoreRepo) DeleteRepost(statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	return repo.changeRepost(statusId, func(status *statuses.Status, reposters reposters) error {
+		if _, reposted := reposters[userId]; !reposted {
+			return NotRepostedError
+		}
+		delete(reposters, userId)
+		status.RepostCount--
+		return nil
+	})
+}
+
+func (repo *DaprStateStoreRepo) Reposts(statusId uuid.UUID) ([]statuses.Repost, error) {
+	reposters, _, err := repo.getReposters(context.Background(), statusId)
+	if err != nil {
+		return nil, err
+	}
+	return reposters.toReposts(statusId), nil
+}
+
+// maxWriteAttempts bounds how often a write is retried after racing with another write to the same keys
+const maxWriteAttempts = 5
+
+var concurrentWriteError = errors.New("state changed since it was read")
+
+// saveIfUnchangedOp fails the whole transaction if the key was written since it was read with the given etag
+func saveIfUnchangedOp(key string, value []byte, etag string) *dapr.StateOperation {
+	item := &dapr.SetStateItem{
+		Key:     key,
+		Value:   value,
+		Options: &dapr.StateOptions{Concurrency: dapr.StateConcurrencyFirstWrite},
+	}
+	if etag != "" {
+		item.Etag = &dapr.ETag{Value: etag}
+	}
+
+	return &dapr.StateOperation{
+		Type: dapr.StateOperationTypeUpsert,
+		Item: item,
+	}
+}
+
+// changeLike applies change to a status and both like indexes of a like. The status is written with its etag,
+// so two likes of the same status can't overwrite each others like count. Conflicting changes are retried.
+func (repo *DaprStateStoreRepo) changeLike(statusId uuid.UUID, userId uuid.UUID,
+	change func(status *statuses.Status, likes []indexEntry, userLikes []indexEntry) ([]indexEntry, []indexEntry, error)) (statuses.Status, error) {
+	var err error
+	for attempt := 0; attempt < maxWriteAttempts; attempt++ {
+		var status statuses.Status
+		status, err = repo.tryChangeLike(context.Background(), statusId, userId, change)
+		if !errors.Is(err, concurrentWriteError) {
+			return status, err
+		}
+	}
+	return statuses.Status{}, err
+}
+
+func (repo *DaprStateStoreRepo) tryChangeLike(ctx context.Context, statusId uuid.UUID, userId uuid.UUID,
+	change func(status *statuses.Status, likes []indexEntry, userLikes []indexEntry) ([]indexEntry, []indexEntry, error)) (statuses.Status, error) {
+	statusItem, err := repo.dapr.GetState(ctx, repo.config.Name, statusId.String(), nil)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	if statusItem.Value == nil {
+		return statuses.Status{}, internal.NotFoundError(statusId)
+	}
+
+	var status statuses.Status
+	err = json.Unmarshal(statusItem.Value, &status)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	likes, likesEtag, err := repo.getIndexWithEtag(ctx, likesIndexKey(statusId))
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	userLikes, userLikesEtag, err := repo.getIndexWithEtag(ctx, userLikesIndexKey(userId))
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	likes, userLikes, err = change(&status, likes, userLikes)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	statusJson, err := json.Marshal(status)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	likesJson, err := json.Marshal(likes)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	userLikesJson, err := json.Marshal(userLikes)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	err = repo.dapr.ExecuteStateTransaction(ctx, repo.config.Name, nil, []*dapr.StateOperation{
+		saveIfUnchangedOp(statusId.String(), statusJson, statusItem.Etag),
+		saveIfUnchangedOp(likesIndexKey(statusId), likesJson, likesEtag),
+		saveIfUnchangedOp(userLikesIndexKey(userId), userLikesJson, userLikesEtag),
+	})
+	if err != nil {
+		// Dapr doesn't tell etag mismatches apart from other failures of a transaction
+		return statuses.Status{}, fmt.Errorf("%w: %v", concurrentWriteError, err)
+	}
+
+	return status, nil
+}
+
+func (repo *DaprStateStoreRepo) CreateLike(like statuses.Like) (statuses.Status, error) {
+	return repo.changeLike(like.StatusId, like.UserId, func(status *statuses.Status, likes []indexEntry, userLikes []indexEntry) ([]indexEntry, []indexEntry, error) {
+		if containsEntry(likes, like.UserId) {
+			return nil, nil, AlreadyLikedError
+		}
+		status.LikeCount++
+		return append(likes, indexEntry{like.UserId, like.CreatedAt}), append(userLikes, indexEntry{like.StatusId, like.CreatedAt}), nil
+	})
+}
+
+func (repo *DaprStateStoreRepo) DeleteLike(statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	return repo.changeLike(statusId, userId, func(status *statuses.Status, likes []indexEntry, userLikes []indexEntry) ([]indexEntry, []indexEntry, error) {
+		if !containsEntry(likes, userId) {
+			return nil, nil, NotLikedError
+		}
+		status.LikeCount--
+		return removeEntry(likes, userId), removeEntry(userLikes, statusId), nil
+	})
+}
+
+func pollKey(statusId uuid.UUID) string {
+	return fmt.Sprintf("status-poll-%s", statusId.String())
+}
+
+func pollVoteKey(statusId uuid.UUID, userId uuid.UUID) string {
+	return fmt.Sprintf("status-poll-vote-%s-%s", statusId.String(), userId.String())
+}
+
+// pollTally is stored apart from the status, the poll in the status only holds its options and expiry
+type pollTally struct {
+	Votes      []int `json:"votes"`
+	VoterCount int   `json:"voterCount"`
+}
+
+// withPollTallies sets the stored tallies on the polls of all, polls nobody voted in yet have none stored
+func (repo *DaprStateStoreRepo) withPollTallies(ctx context.Context, all []statuses.Status) error {
+	keys := make([]string, 0)
+	for _, status := range all {
+		if status.Poll != nil {
+			keys = append(keys, pollKey(status.Id))
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	states, err := repo.dapr.GetBulkState(ctx, repo.config.Name, keys, nil, 1)
+	if err != nil {
+		return err
+	}
+
+	talliesByKey := make(map[string]pollTally, len(states))
+	for _, state := range states {
+		if state.Value == nil {
+			continue
+		}
+		var tally pollTally
+		err = json.Unmarshal(state.Value, &tally)
+		if err != nil {
+			return err
+		}
+		talliesByKey[state.Key] = tally
+	}
+
+	for i, status := range all {
+		if status.Poll == nil {
+			continue
+		}
+		poll := *status.Poll
+		poll.Votes = make([]int, len(poll.Options))
+		copy(poll.Votes, talliesByKey[pollKey(status.Id)].Votes)
+		poll.VoterCount = talliesByKey[pollKey(status.Id)].VoterCount
+		all[i].Poll = &poll
+	}
+	return nil
+}
+
+// Vote writes the vote under a key of its own, which only succeeds if the user didn't vote yet, and the tallies
+// of the poll with their etag. The status isn't written, so votes don't conflict with other changes of it.
+// Conflicting votes are retried.
+func (repo *DaprStateStoreRepo) Vote(vote statuses.PollVote) (statuses.Poll, error) {
+	var err error
+	for attempt := 0; attempt < maxWriteAttempts; attempt++ {
+		var poll statuses.Poll
+		poll, err = repo.tryVote(context.Background(), vote)
+		if !errors.Is(err, concurrentWriteError) {
+			return poll, err
+		}
+	}
+	return statuses.Poll{}, err
+}
+
+func (repo *DaprStateStoreRepo) tryVote(ctx context.Context, vote statuses.PollVote) (statuses.Poll, error) {
+	statusItem, err := repo.dapr.GetState(ctx, repo.config.Name, vote.StatusId.String(), nil)
+	if err != nil {
+		return statuses.Poll{}, err
+	}
+	if statusItem.Value == nil {
+		return statuses.Poll{}, internal.NotFoundError(vote.StatusId)
+	}
+
+	var status statuses.Status
+	err = json.Unmarshal(statusItem.Value, &status)
+	if err != nil {
+		return statuses.Poll{}, err
+	}
+	if status.Poll == nil {
+		return statuses.Poll{}, NoPollError
+	}
+	if status.Poll.Expired(vote.CreatedAt) {
+		return statuses.Poll{}, PollExpiredError
+	}
+
+	voteItem, err := repo.dapr.GetState(ctx, repo.config.Name, pollVoteKey(vote.StatusId, vote.UserId), nil)
+	if err != nil {
+		return statuses.Poll{}, err
+	}
+	if voteItem.Value != nil {
+		return statuses.Poll{}, AlreadyVotedError
+	}
+
+	tallyItem, err := repo.dapr.GetState(ctx, repo.config.Name, pollKey(vote.StatusId), nil)
+	if err != nil {
+		return statuses.Poll{}, err
+	}
+
+	var stored pollTally
+	if tallyItem.Value != nil {
+		err = json.Unmarshal(tallyItem.Value, &stored)
+		if err != nil {
+			return statuses.Poll{}, err
+		}
+	}
+
+	poll := *status.Poll
+	poll.Votes = make([]int, len(poll.Options))
+	copy(poll.Votes, stored.Votes)
+	poll.VoterCount = stored.VoterCount
+	tally(&poll, vote.Choices)
+
+	voteJson, err := json.Marshal(vote)
+	if err != nil {
+		return statuses.Poll{}, err
+	}
+
+	tallyJson, err := json.Marshal(pollTally{Votes: poll.Votes, VoterCount: poll.VoterCount})
+	if err != nil {
+		return statuses.Poll{}, err
+	}
+
+	err = repo.dapr.ExecuteStateTransaction(ctx, repo.config.Name, nil, []*dapr.StateOperation{
+		saveIfUnchangedOp(pollVoteKey(vote.StatusId, vote.UserId), voteJson, ""),
+		saveIfUnchangedOp(pollKey(vote.StatusId), tallyJson, tallyItem.Etag),
+	})
+	if err != nil {
+		// Dapr doesn't tell etag mismatches apart from other failures of a transaction
+		return statuses.Poll{}, fmt.Errorf("%w: %v", concurrentWriteError, err)
+	}
+
+	return poll, nil
+}
+
+func (repo *DaprStateStoreRepo) ListLikes(statusId uuid.UUID, query statuses.PageQuery) (statuses.LikePage, error) {
+	entries, err := repo.getIndex(context.Background(), likesIndexKey(statusId))
+	if err != nil {
+		return statuses.LikePage{}, err
+	}
+
+	pageEntries, next := pageOf(entries, query)
+	likes := make([]statuses.Like, len(pageEntries))
+	for i, entry := range pageEntries {
+		likes[i] = statuses.Like{StatusId: statusId, UserId: entry.Id, CreatedAt: entry.Time}
+	}
+
+	return statuses.LikePage{Likes: likes, Next: next}, nil
+}
+
+// ListLikedByUser skips liked statuses that were deleted since
+func (repo *DaprStateStoreRepo) ListLikedByUser(userId uuid.UUID, query statuses.PageQuery) (statuses.StatusPage, error) {
+	ctx := context.Background()
+	entries, err := repo.getIndex(ctx, userLikesIndexKey(userId))
+	if err != nil {
+		return statuses.StatusPage{}, err
+	}
+
+	pageEntries, next := pageOf(entries, query)
+	page, err := repo.getEntries(ctx, pageEntries)
+	if err != nil {
+		return statuses.StatusPage{}, err
+	}
+
+	return statuses.StatusPage{Statuses: page, Next: next}, nil
+}
+
+// Create writes the status and its outbox entry in one transaction. The outbox index and the shards of the keys
+// of all statuses are shared, so they are written with their etags and conflicting creates are retried.
+func (repo *DaprStateStoreRepo) Create(status statuses.Status) (statuses.Status, error) {
+	var err error
+	for attempt := 0; attempt < maxWriteAttempts; attempt++ {
+		err = repo.tryCreate(context.Background(), status)
+		if !errors.Is(err, concurrentWriteError) {
+			break
+		}
+	}
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return status, nil
+}
+
+func (repo *DaprStateStoreRepo) tryCreate(ctx context.Context, status statuses.Status) error {
+	statusJson, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	saveKeyOp, err := repo.changeStatusKeysOp(ctx, status.Id, true)
+	if err != nil {
+		return err
+	}
+
+	saveStatusOp := dapr.StateOperation{
+		Type: dapr.StateOperationTypeUpsert,
+		Item: &dapr.SetStateItem{
+			Key:   status.Id.String(),
+			Value: statusJson,
+		},
+	}
+
+	var indexOps []*dapr.StateOperation
+	if status.PublishAt == nil {
+		indexOps, err = repo.publishOps(ctx, status)
+	} else {
+		indexOps, err = repo.changeScheduleOps(ctx, status, status.PublishAt)
+	}
+	if err != nil {
+		return err
+	}
+
+	operations := append([]*dapr.StateOperation{&saveStatusOp, saveKeyOp}, indexOps...)
+	err = repo.dapr.ExecuteStateTransaction(ctx, repo.config.Name, nil, operations)
+	if err != nil {
+		// Dapr doesn't tell etag mismatches apart from other failures of a transaction
+		return fmt.Errorf("%w: %v", concurrentWriteError, err)
+	}
+
+	return nil
+}
+
+// publishOps add a status to the user, tag and replies indexes and its created event to the outbox
+func (repo *DaprStateStoreRepo) publishOps(ctx context.Context, status statuses.Status) ([]*dapr.StateOperation, error) {
+	entries, entriesEtag, err := repo.getIndexWithEtag(ctx, userIndexKey(status.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	saveIndexOp, err := repo.saveIndexOp(userIndexKey(status.UserId), append(entries, indexEntry{status.Id, status.CreatedAt}), entriesEtag)
+	if err != nil {
+		return nil, err
+	}
+
+	tagOps, err := repo.changeTagIndexOps(ctx, status, nil, status.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	operations := append([]*dapr.StateOperation{saveIndexOp}, tagOps...)
+	if status.InReplyToId != nil {
+		replies, repliesEtag, err := repo.getIndexWithEtag(ctx, repliesIndexKey(*status.InReplyToId))
+		if err != nil {
+			return nil, err
+		}
+
+		saveRepliesOp, err := repo.saveIndexOp(repliesIndexKey(*status.InReplyToId), append(replies, indexEntry{status.Id, status.CreatedAt}), repliesEtag)
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, saveRepliesOp)
+	}
+
+	outboxOps, err := repo.addToOutboxOps(ctx, status, status.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return append(operations, outboxOps...), nil
+}
+
+// changeScheduleOps moves a status in both schedule indexes to publishAt, or removes it from them if publishAt is nil.
+// The shared index is written with its etag.
+func (repo *DaprStateStoreRepo) changeScheduleOps(ctx context.Context, status statuses.Status, publishAt *time.Time) ([]*dapr.StateOperation, error) {
+	scheduled, etag, err := repo.getIndexWithEtag(ctx, scheduledIndexKey)
+	if err != nil {
+		return nil, err
+	}
+
+	userScheduled, userScheduledEtag, err := repo.getIndexWithEtag(ctx, userScheduledIndexKey(status.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	scheduled = removeEntry(scheduled, status.Id)
+	userScheduled = removeEntry(userScheduled, status.Id)
+	if publishAt != nil {
+		scheduled = append(scheduled, indexEntry{status.Id, *publishAt})
+		userScheduled = append(userScheduled, indexEntry{status.Id, *publishAt})
+	}
+
+	scheduledJson, err := json.Marshal(scheduled)
+	if err != nil {
+		return nil, err
+	}
+
+	saveUserScheduledOp, err := repo.saveIndexOp(userScheduledIndexKey(status.UserId), userScheduled, userScheduledEtag)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*dapr.StateOperation{saveIfUnchangedOp(scheduledIndexKey, scheduledJson, etag), saveUserScheduledOp}, nil
+}
+
+func (repo *DaprStateStoreRepo) ListScheduled(userId uuid.UUID) ([]statuses.Status, error) {
+	ctx := context.Background()
+	entries, err := repo.getIndex(ctx, userScheduledIndexKey(userId))
+	if err != nil {
+		return nil, err
+	}
+
+	sortOldestFirst(entries)
+	return repo.getEntries(ctx, entries)
+}
+
+func (repo *DaprStateStoreRepo) ListDue(now time.Time, limit int) ([]statuses.Status, error) {
+	ctx := context.Background()
+	entries, err := repo.getIndex(ctx, scheduledIndexKey)
+	if err != nil {
+		return nil, err
+	}
+
+	due := make([]indexEntry, 0)
+	for _, entry := range entries {
+		if !entry.Time.After(now) {
+			due = append(due, entry)
+		}
+	}
+	sortOldestFirst(due)
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	return repo.getEntries(ctx, due)
+}
+
+// getScheduled returns a scheduled status with its etag
+func (repo *DaprStateStoreRepo) getScheduled(ctx context.Context, statusId uuid.UUID) (statuses.Status, string, error) {
+	status, etag, err := repo.getWithEtag(ctx, statusId)
+	if err != nil {
+		return statuses.Status{}, "", err
+	}
+	if status.PublishAt == nil {
+		return statuses.Status{}, "", NotScheduledError
+	}
+	return status, etag, nil
+}
+
+// changeScheduled applies change to a scheduled status. The status is written with its etag, so of two concurrent
+// changes only one succeeds, the other one is retried and sees the status as changed by the first.
+func (repo *DaprStateStoreRepo) changeScheduled(statusId uuid.UUID,
+	change func(ctx context.Context, status *statuses.Status, etag string) ([]*dapr.StateOperation, error)) (statuses.Status, error) {
+	var err error
+	for attempt := 0; attempt < maxWriteAttempts; attempt++ {
+		ctx := context.Background()
+		var status statuses.Status
+		var etag string
+		status, etag, err = repo.getScheduled(ctx, statusId)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+
+		var operations []*dapr.StateOperation
+		operations, err = change(ctx, &status, etag)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+
+		err = repo.dapr.ExecuteStateTransaction(ctx, repo.config.Name, nil, operations)
+		if err == nil {
+			return status, nil
+		}
+		// Dapr doesn't tell etag mismatches apart from other failures of a transaction
+		err = fmt.Errorf("%w: %v", concurrentWriteError, err)
+	}
+	return statuses.Status{}, err
+}
+
+func (repo *DaprStateStoreRepo) Reschedule(statusId uuid.UUID, publishAt time.Time) (statuses.Status, error) {
+	return repo.changeScheduled(statusId, func(ctx context.Context, status *statuses.Status, etag string) ([]*dapr.StateOperation, error) {
+		status.PublishAt = &publishAt
+		statusJson, err := json.Marshal(status)
+		if err != nil {
+			return nil, err
+		}
+
+		scheduleOps, err := repo.changeScheduleOps(ctx, *status, &publishAt)
+		if err != nil {
+			return nil, err
+		}
+		return append([]*dapr.StateOperation{saveIfUnchangedOp(statusId.String(), statusJson, etag)}, scheduleOps...), nil
+	})
+}
+
+func (repo *DaprStateStoreRepo) PublishScheduled(statusId uuid.UUID, publishedAt time.Time) (statuses.Status, error) {
+	return repo.changeScheduled(statusId, func(ctx context.Context, status *statuses.Status, etag string) ([]*dapr.StateOperation, error) {
+		status.PublishAt = nil
+		status.CreatedAt = publishedAt
+		status.UpdatedAt = publishedAt
+		statusJson, err := json.Marshal(status)
+		if err != nil {
+			return nil, err
+		}
+
+		scheduleOps, err := repo.changeScheduleOps(ctx, *status, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		publishOps, err := repo.publishOps(ctx, *status)
+		if err != nil {
+			return nil, err
+		}
+
+		operations := append([]*dapr.StateOperation{saveIfUnchangedOp(statusId.String(), statusJson, etag)}, scheduleOps...)
+		return append(operations, publishOps...), nil
+	})
+}
+
+func (repo *DaprStateStoreRepo) CancelScheduled(statusId uuid.UUID) (statuses.Status, error) {
+	return repo.changeScheduled(statusId, func(ctx context.Context, status *statuses.Status, etag string) ([]*dapr.StateOperation, error) {
+		deleteStatusOp := dapr.StateOperation{
+			Type: dapr.StateOperationTypeDelete,
+			Item: &dapr.SetStateItem{
+				Key:     statusId.String(),
+				Etag:    &dapr.ETag{Value: etag},
+				Options: &dapr.StateOptions{Concurrency: dapr.StateConcurrencyFirstWrite},
+			},
+		}
+
+		deleteRevisionsOp := dapr.StateOperation{
+			Type: dapr.StateOperationTypeDelete,
+			Item: &dapr.SetStateItem{
+				Key: revisionsKey(statusId),
+			},
+		}
+
+		scheduleOps, err := repo.changeScheduleOps(ctx, *status, nil)
+		if err != nil {
+			return nil, err
+		}
+		return append([]*dapr.StateOperation{&deleteStatusOp, &deleteRevisionsOp}, scheduleOps...), nil
+	})
+}
+
+// changeTombstonesOp moves a status in the tombstones index to deletedAt, or removes it if deletedAt is nil.
+// The index is shared by all statuses, so it is written with its etag.
+func (repo *DaprStateStoreRepo) changeTombstonesOp(ctx context.Context, statusId uuid.UUID, deletedAt *time.Time) (*dapr.StateOperation, error) {
+	tombstones, etag, err := repo.getIndexWithEtag(ctx, tombstonesIndexKey)
+	if err != nil {
+		return nil, err
+	}
+
+	tombstones = removeEntry(tombstones, statusId)
+	if deletedAt != nil {
+		tombstones = append(tombstones, indexEntry{statusId, *deletedAt})
+	}
+
+	tombstonesJson, err := json.Marshal(tombstones)
+	if err != nil {
+		return nil, err
+	}
+	return saveIfUnchangedOp(tombstonesIndexKey, tombstonesJson, etag), nil
+}
+
+// changeTombstone applies change to a status and moves it in the tombstones index. A tombstoned status leaves the outbox,
+// a restored one is stored in it again as of changedAt. The status is written with its etag, so of two concurrent changes
+// only one succeeds, the other one is retried and sees the status as changed by the first.
+func (repo *DaprStateStoreRepo) changeTombstone(statusId uuid.UUID, changedAt time.Time, change func(status *statuses.Status) error) (statuses.Status, error) {
+	var err error
+	for attempt := 0; attempt < maxWriteAttempts; attempt++ {
+		ctx := context.Background()
+		var status statuses.Status
+		var etag string
+		status, etag, err = repo.getWithEtag(ctx, statusId)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+
+		err = change(&status)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+
+		var statusJson []byte
+		statusJson, err = json.Marshal(status)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+
+		var tombstonesOp *dapr.StateOperation
+		tombstonesOp, err = repo.changeTombstonesOp(ctx, statusId, status.DeletedAt)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+
+		operations := []*dapr.StateOperation{saveIfUnchangedOp(statusId.String(), statusJson, etag), tombstonesOp}
+		if status.DeletedAt != nil {
+			// A created event that wasn't relayed yet must not follow the deleted event
+			operations = append(operations, deleteOutboxEntryOp(statusId))
+		} else {
+			var outboxOps []*dapr.StateOperation
+			outboxOps, err = repo.addToOutboxOps(ctx, status, changedAt)
+			if err != nil {
+				return statuses.Status{}, err
+			}
+			operations = append(operations, outboxOps...)
+		}
+
+		err = repo.dapr.ExecuteStateTransaction(ctx, repo.config.Name, nil, operations)
+		if err == nil {
+			changed := []statuses.Status{status}
+			err = repo.withPollTallies(ctx, changed)
+			return changed[0], err
+		}
+		// Dapr doesn't tell etag mismatches apart from other failures of a transaction
+		err = fmt.Errorf("%w: %v", concurrentWriteError, err)
+	}
+	return statuses.Status{}, err
+}
+
+func (repo *DaprStateStoreRepo) Tombstone(statusId uuid.UUID, deletedAt time.Time) (statuses.Status, error) {
+	return repo.changeTombstone(statusId, deletedAt, func(status *statuses.Status) error {
+		if status.DeletedAt != nil {
+			return internal.NotFoundError(statusId)
+		}
+		status.DeletedAt = &deletedAt
+		return nil
+	})
+}
+
+func (repo *DaprStateStoreRepo) Restore(statusId uuid.UUID, restoredAt time.Time) (statuses.Status, error) {
+	return repo.changeTombstone(statusId, restoredAt, func(status *statuses.Status) error {
+		if status.DeletedAt == nil {
+			return NotDeletedError
+		}
+		status.DeletedAt = nil
+		return nil
+	})
+}
+
+func (repo *DaprStateStoreRepo) ListExpired(deletedBefore time.Time, limit int) ([]statuses.Status, error) {
+	ctx := context.Background()
+	entries, err := repo.getIndex(ctx, tombstonesIndexKey)
+	if err != nil {
+		return nil, err
+	}
+
+	expired := make([]indexEntry, 0)
+	for _, entry := range entries {
+		if entry.Time.Before(deletedBefore) {
+			expired = append(expired, entry)
+		}
+	}
+	sortOldestFirst(expired)
+	if len(expired) > limit {
+		expired = expired[:limit]
+	}
+	return repo.getEntries(ctx, expired)
+}
+
+// Purge deletes the status with its etag, a concurrent restore makes the delete fail and the retry find no tombstone
+func (repo *DaprStateStoreRepo) Purge(statusId uuid.UUID, deletedBefore time.Time) (statuses.Status, error) {
+	var err error
+	for attempt := 0; attempt < maxWriteAttempts; attempt++ {
+		ctx := context.Background()
+		var status statuses.Status
+		var etag string
+		status, etag, err = repo.getWithEtag(ctx, statusId)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+		if status.DeletedAt == nil || !status.DeletedAt.Before(deletedBefore) {
+			return statuses.Status{}, NotDeletedError
+		}
+
+		var operations []*dapr.StateOperation
+		operations, err = repo.deleteOps(ctx, status, etag)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+
+		err = repo.dapr.ExecuteStateTransaction(ctx, repo.config.Name, nil, operations)
+		if err == nil {
+			return status, nil
+		}
+		// Dapr doesn't tell etag mismatches apart from other failures of a transaction
+		err = fmt.Errorf("%w: %v", concurrentWriteError, err)
+	}
+	return statuses.Status{}, err
+}
+
+// changePin applies change to a status and the pins of its author. Both are written with their etags, so two pins
+// of the same author can't both take the last free pin. Conflicting changes are retried.
+func (repo *DaprStateStoreRepo) changePin(statusId uuid.UUID, change func(status *statuses.Status, pinned []indexEntry) ([]indexEntry, error)) (statuses.Status, error) {
+	var err error
+	for attempt := 0; attempt < maxWriteAttempts; attempt++ {
+		ctx := context.Background()
+		var status statuses.Status
+		var etag string
+		status, etag, err = repo.getWithEtag(ctx, statusId)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+
+		var pinned []indexEntry
+		var pinnedEtag string
+		pinned, pinnedEtag, err = repo.getIndexWithEtag(ctx, userPinsIndexKey(status.UserId))
+		if err != nil {
+			return statuses.Status{}, err
+		}
+
+		pinned, err = change(&status, pinned)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+
+		var statusJson, pinnedJson []byte
+		statusJson, err = json.Marshal(status)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+		pinnedJson, err = json.Marshal(pinned)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+
+		err = repo.dapr.ExecuteStateTransaction(ctx, repo.config.Name, nil, []*dapr.StateOperation{
+			saveIfUnchangedOp(statusId.String(), statusJson, etag),
+			saveIfUnchangedOp(userPinsIndexKey(status.UserId), pinnedJson, pinnedEtag),
+		})
+		if err == nil {
+			changed := []statuses.Status{status}
+			err = repo.withPollTallies(ctx, changed)
+			return changed[0], err
+		}
+		// Dapr doesn't tell etag mismatches apart from other failures of a transaction
+		err = fmt.Errorf("%w: %v", concurrentWriteError, err)
+	}
+	return statuses.Status{}, err
+}
+
+func (repo *DaprStateStoreRepo) Pin(statusId uuid.UUID, pinnedAt time.Time, max int) (statuses.Status, error) {
+	return repo.changePin(statusId, func(status *statuses.Status, pinned []indexEntry) ([]indexEntry, error) {
+		if status.PinnedAt != nil {
+			return nil, AlreadyPinnedError
+		}
+		if len(pinned) >= max {
+			return nil, TooManyPinsError
+		}
+		status.PinnedAt = &pinnedAt
+		return append(pinned, indexEntry{statusId, pinnedAt}), nil
+	})
+}
+
+func (repo *DaprStateStoreRepo) Unpin(statusId uuid.UUID) (statuses.Status, error) {
+	return repo.changePin(statusId, func(status *statuses.Status, pinned []indexEntry) ([]indexEntry, error) {
+		if status.PinnedAt == nil {
+			return nil, NotPinnedError
+		}
+		status.PinnedAt = nil
+		return removeEntry(pinned, statusId), nil
+	})
+}
+
+func (repo *DaprStateStoreRepo) ListPinned(userId uuid.UUID) ([]statuses.Status, error) {
+	ctx := context.Background()
+	entries, err := repo.getIndex(ctx, userPinsIndexKey(userId))
+	if err != nil {
+		return nil, err
+	}
+
+	sortNewestFirst(entries)
+	return repo.getEntries(ctx, entries)
+}
+
+// outboxKey is the index of the pending entries of the outbox
+const outboxKey = "outbox"
+
+func outboxEntryKey(statusId uuid.UUID) string {
+	return fmt.Sprintf("outbox-%s", statusId.String())
+}
+
+// deleteOutboxEntryOp drops the entry of a status from the outbox, PendingEvents drops it from the index
+func deleteOutboxEntryOp(statusId uuid.UUID) *dapr.StateOperation {
+	return &dapr.StateOperation{
+		Type: dapr.StateOperationTypeDelete,
+		Item: &dapr.SetStateItem{
+			Key: outboxEntryKey(statusId),
+		},
+	}
+}
+
+// addToOutboxOps store the created event of a status as of storedAt, replacing an earlier one
+func (repo *DaprStateStoreRepo) addToOutboxOps(ctx context.Context, status statuses.Status, storedAt time.Time) ([]*dapr.StateOperation, error) {
+	pending, etag, err := repo.getIndexWithEtag(ctx, outboxKey)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingJson, err := json.Marshal(append(removeEntry(pending, status.Id), indexEntry{status.Id, storedAt}))
+	if err != nil {
+		return nil, err
+	}
+
+	entryJson, err := json.Marshal(OutboxEntry{Status: status, CreatedAt: storedAt})
+	if err != nil {
+		return nil, err
+	}
+
+	saveEntryOp := dapr.StateOperation{
+		Type: dapr.StateOperationTypeUpsert,
+		Item: &dapr.SetStateItem{
+			Key:   outboxEntryKey(status.Id),
+			Value: entryJson,
+		},
+	}
+
+	return []*dapr.StateOperation{saveIfUnchangedOp(outboxKey, pendingJson, etag), &saveEntryOp}, nil
+}
+
+func (repo *DaprStateStoreRepo) PendingEvents(limit int) ([]OutboxEntry, error) {
+	ctx := context.Background()
+	all, etag, err := repo.getIndexWithEtag(ctx, outboxKey)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]indexEntry, len(all))
+	copy(pending, all)
+	sortOldestFirst(pending)
+	if len(pending) > limit {
+		pending = pending[:limit]
+	}
+	if len(pending) == 0 {
+		return make([]OutboxEntry, 0), nil
+	}
+
+	// The statuses are read along with the entries, so entries of deleted statuses are skipped
+	keys := make([]string, 0, 2*len(pending))
+	entryKeys := internal.NewSet[string]()
+	for _, entry := range pending {
+		keys = append(keys, outboxEntryKey(entry.Id), entry.Id.String())
+		entryKeys.Add(outboxEntryKey(entry.Id))
+	}
+
+	states, err := repo.dapr.GetBulkState(ctx, repo.config.Name, keys, nil, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	// Bulk state items are not returned in the order of the requested keys
+	entriesByKey := make(map[string]OutboxEntry, len(pending))
+	published := internal.NewSet[uuid.UUID]()
+	for _, state := range states {
+		if state.Value == nil {
+			continue
+		}
+		if entryKeys.Has(state.Key) {
+			var entry OutboxEntry
+			err = json.Unmarshal(state.Value, &entry)
+			if err != nil {
+				return nil, err
+			}
+			entriesByKey[state.Key] = entry
+			continue
+		}
+
+		var status statuses.Status
+		err = json.Unmarshal(state.Value, &status)
+		if err != nil {
+			return nil, err
+		}
+		if status.DeletedAt == nil {
+			published.Add(status.Id)
+		}
+	}
+
+	entries := make([]OutboxEntry, 0, len(pending))
+	remaining := all
+	for _, pendingEntry := range pending {
+		entry, ok := entriesByKey[outboxEntryKey(pendingEntry.Id)]
+		if ok && published.Has(pendingEntry.Id) {
+			entries = append(entries, entry)
+		} else {
+			remaining = removeEntry(remaining, pendingEntry.Id)
+		}
+	}
+
+	if len(remaining) < len(all) {
+		// Entries of deleted statuses are dropped from the index if nothing else changed it in the meantime
+		remainingJson, err := json.Marshal(remaining)
+		if err != nil {
+			return nil, err
+		}
+		_ = repo.dapr.ExecuteStateTransaction(ctx, repo.config.Name, nil, []*dapr.StateOperation{saveIfUnchangedOp(outboxKey, remainingJson, etag)})
+	}
+
+	return entries, nil
+}
+
+func (repo *DaprStateStoreRepo) MarkEventSent(statusId uuid.UUID, sentAt time.Time) error {
+	var err error
+	for attempt := 0; attempt < maxWriteAttempts; attempt++ {
+		err = repo.tryMarkEventSent(context.Background(), statusId, sentAt)
+		if !errors.Is(err, concurrentWriteError) {
+			return err
+		}
+	}
+	return err
+}
+
+func (repo *DaprStateStoreRepo) tryMarkEventSent(ctx context.Context, statusId uuid.UUID, sentAt time.Time) error {
+	pending, etag, err := repo.getIndexWithEtag(ctx, outboxKey)
+	if err != nil {
+		return err
+	}
+	if !containsEntry(pending, statusId) {
+		return nil
+	}
+
+	pendingJson, err := json.Marshal(removeEntry(pending, statusId))
+	if err != nil {
+		return err
+	}
+	operations := []*dapr.StateOperation{saveIfUnchangedOp(outboxKey, pendingJson, etag)}
+
+	item, err := repo.dapr.GetState(ctx, repo.config.Name, outboxEntryKey(statusId), nil)
+	if err != nil {
+		return err
+	}
+	if item.Value != nil {
+		var entry OutboxEntry
+		err = json.Unmarshal(item.Value, &entry)
+		if err != nil {
+			return err
+		}
+		entry.SentAt = &sentAt
+
+		entryJson, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		operations = append(operations, &dapr.StateOperation{
+			Type: dapr.StateOperationTypeUpsert,
+			Item: &dapr.SetStateItem{
+				Key:   outboxEntryKey(statusId),
+				Value: entryJson,
+			},
+		})
+	}
+
+	err = repo.dapr.ExecuteStateTransaction(ctx, repo.config.Name, nil, operations)
+	if err != nil {
+		// Dapr doesn't tell etag mismatches apart from other failures of a transaction
+		return fmt.Errorf("%w: %v", concurrentWriteError, err)
+	}
+	return nil
+}
@@ -3,7 +3,10 @@ package statuses
 import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"sync"
 	"testing"
+	"time"
+	"yatc/internal"
 	statuses "yatc/status/pkg"
 )
 
@@ -114,3 +117,412 @@ func TestInMemoryRepo_Create_DuplicateStatus(t *testing.T) {
 	assert.Error(t, err)
 	assert.Empty(t, createdStatus)
 }
+
+func TestInMemoryRepo_ListByUser(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	userId := uuid.New()
+	created := make([]statuses.Status, 5)
+	for i := range created {
+		created[i] = statuses.Status{Id: uuid.New(), Content: "test status", UserId: userId}
+		_, err := repo.Create(created[i])
+		assert.NoError(t, err)
+	}
+	_, err := repo.Create(statuses.Status{Id: uuid.New(), Content: "other status", UserId: uuid.New()})
+	assert.NoError(t, err)
+
+	// WHEN
+	firstPage, err := repo.ListByUser(userId, statuses.PageQuery{Limit: 3})
+	assert.NoError(t, err)
+	secondPage, err := repo.ListByUser(userId, statuses.PageQuery{Limit: 3, Cursor: firstPage.Next})
+	assert.NoError(t, err)
+
+	// THEN
+	assert.Equal(t, 3, len(firstPage.Statuses))
+	assert.NotNil(t, firstPage.Next)
+	assert.Equal(t, 2, len(secondPage.Statuses))
+	assert.Nil(t, secondPage.Next)
+
+	seen := internal.NewSet[uuid.UUID]()
+	for _, status := range append(firstPage.Statuses, secondPage.Statuses...) {
+		assert.Equal(t, userId, status.UserId)
+		assert.False(t, seen.Has(status.Id), "status returned twice")
+		seen.Add(status.Id)
+	}
+}
+
+func TestInMemoryRepo_ListByUser_SinceUntil(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	userId := uuid.New()
+	old := statuses.Status{Id: uuid.New(), Content: "old status", UserId: userId, CreatedAt: time.Now().UTC().Add(-time.Hour)}
+	_, err := repo.Create(old)
+	assert.NoError(t, err)
+	recent := statuses.Status{Id: uuid.New(), Content: "recent status", UserId: userId, CreatedAt: time.Now().UTC()}
+	_, err = repo.Create(recent)
+	assert.NoError(t, err)
+	boundary := time.Now().UTC().Add(-time.Minute)
+
+	// WHEN
+	sincePage, err := repo.ListByUser(userId, statuses.PageQuery{Limit: 10, Since: &boundary})
+	assert.NoError(t, err)
+	untilPage, err := repo.ListByUser(userId, statuses.PageQuery{Limit: 10, Until: &boundary})
+	assert.NoError(t, err)
+
+	// THEN
+	assert.Equal(t, []statuses.Status{recent}, sincePage.Statuses)
+	assert.Equal(t, []statuses.Status{old}, untilPage.Statuses)
+}
+
+func TestInMemoryRepo_ListByUser_NewestFirst(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	userId := uuid.New()
+	now := time.Now().UTC()
+	first := statuses.Status{Id: uuid.New(), Content: "first", UserId: userId, CreatedAt: now.Add(-time.Minute)}
+	second := statuses.Status{Id: uuid.New(), Content: "second", UserId: userId, CreatedAt: now}
+	_, err := repo.Create(second)
+	assert.NoError(t, err)
+	_, err = repo.Create(first)
+	assert.NoError(t, err)
+
+	// WHEN
+	page, err := repo.ListByUser(userId, statuses.PageQuery{Limit: 10})
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Status{second, first}, page.Statuses)
+}
+
+func TestInMemoryRepo_ListByUser_Deleted(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+	_, err := repo.Create(status)
+	assert.NoError(t, err)
+	_, err = repo.Delete(status.Id)
+	assert.NoError(t, err)
+
+	// WHEN
+	page, err := repo.ListByUser(status.UserId, statuses.PageQuery{Limit: 10})
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Empty(t, page.Statuses)
+}
+
+func TestInMemoryRepo_Update(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	createdAt := time.Now().UTC().Add(-time.Hour)
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New(), CreatedAt: createdAt}
+	_, err := repo.Create(status)
+	assert.NoError(t, err)
+
+	editedAt := time.Now().UTC()
+	edited := status
+	edited.Content = "edited status"
+	edited.EditedAt = &editedAt
+
+	// WHEN
+	updatedStatus, err := repo.Update(edited)
+	assert.NoError(t, err)
+	edited.Content = "edited again"
+	_, err = repo.Update(edited)
+	assert.NoError(t, err)
+
+	// THEN
+	assert.Equal(t, "edited status", updatedStatus.Content)
+	fetchedStatus, err := repo.Get(status.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, "edited again", fetchedStatus.Content)
+
+	history, err := repo.History(status.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Revision{
+		{StatusId: status.Id, Content: "test status", CreatedAt: createdAt},
+		{StatusId: status.Id, Content: "edited status", CreatedAt: editedAt},
+	}, history)
+}
+
+func TestInMemoryRepo_Update_NonExistentStatus(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+
+	// WHEN
+	updatedStatus, err := repo.Update(status)
+
+	// THEN
+	assert.ErrorIs(t, err, internal.NotFoundError(status.Id))
+	assert.Empty(t, updatedStatus)
+}
+
+func TestInMemoryRepo_Replies(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	now := time.Now().UTC()
+	parent := statuses.Status{Id: uuid.New(), Content: "parent", UserId: uuid.New(), CreatedAt: now}
+	secondReply := statuses.Status{Id: uuid.New(), Content: "second reply", UserId: uuid.New(), CreatedAt: now.Add(2 * time.Second), InReplyToId: &parent.Id}
+	firstReply := statuses.Status{Id: uuid.New(), Content: "first reply", UserId: uuid.New(), CreatedAt: now.Add(time.Second), InReplyToId: &parent.Id}
+	deletedReply := statuses.Status{Id: uuid.New(), Content: "deleted reply", UserId: uuid.New(), CreatedAt: now.Add(3 * time.Second), InReplyToId: &parent.Id}
+	for _, status := range []statuses.Status{parent, secondReply, firstReply, deletedReply} {
+		_, err := repo.Create(status)
+		assert.NoError(t, err)
+	}
+	_, err := repo.Delete(deletedReply.Id)
+	assert.NoError(t, err)
+
+	// WHEN
+	replies, err := repo.Replies(parent.Id)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Status{firstReply, secondReply}, replies)
+
+	noReplies, err := repo.Replies(firstReply.Id)
+	assert.NoError(t, err)
+	assert.Empty(t, noReplies)
+}
+
+func TestInMemoryRepo_Reposts(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+	_, err := repo.Create(status)
+	assert.NoError(t, err)
+	firstReposter, secondReposter := uuid.New(), uuid.New()
+
+	// WHEN
+	_, err = repo.CreateRepost(statuses.Repost{StatusId: status.Id, UserId: firstReposter})
+	assert.NoError(t, err)
+	secondRepost := statuses.Repost{StatusId: status.Id, UserId: secondReposter, CreatedAt: time.Now().UTC()}
+	reposted, err := repo.CreateRepost(secondRepost)
+	assert.NoError(t, err)
+	_, duplicateErr := repo.CreateRepost(statuses.Repost{StatusId: status.Id, UserId: firstReposter})
+	unreposted, err := repo.DeleteRepost(status.Id, firstReposter)
+	assert.NoError(t, err)
+	_, missingErr := repo.DeleteRepost(status.Id, firstReposter)
+
+	// THEN
+	assert.Equal(t, 2, reposted.RepostCount)
+	assert.ErrorIs(t, duplicateErr, AlreadyRepostedError)
+	assert.Equal(t, 1, unreposted.RepostCount)
+	assert.ErrorIs(t, missingErr, NotRepostedError)
+
+	fetchedStatus, err := repo.Get(status.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fetchedStatus.RepostCount)
+
+	reposts, err := repo.Reposts(status.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Repost{secondRepost}, reposts)
+}
+
+func TestInMemoryRepo_CreateRepost_NonExistentStatus(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	statusId := uuid.New()
+
+	// WHEN
+	_, err := repo.CreateRepost(statuses.Repost{StatusId: statusId, UserId: uuid.New()})
+
+	// THEN
+	assert.ErrorIs(t, err, internal.NotFoundError(statusId))
+}
+
+func TestInMemoryRepo_Likes(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	now := time.Now().UTC()
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+	_, err := repo.Create(status)
+	assert.NoError(t, err)
+	firstLike := statuses.Like{StatusId: status.Id, UserId: uuid.New(), CreatedAt: now}
+	secondLike := statuses.Like{StatusId: status.Id, UserId: uuid.New(), CreatedAt: now.Add(time.Second)}
+
+	// WHEN
+	_, err = repo.CreateLike(firstLike)
+	assert.NoError(t, err)
+	liked, err := repo.CreateLike(secondLike)
+	assert.NoError(t, err)
+	_, duplicateErr := repo.CreateLike(firstLike)
+
+	// THEN
+	assert.Equal(t, 2, liked.LikeCount)
+	assert.ErrorIs(t, duplicateErr, AlreadyLikedError)
+
+	firstPage, err := repo.ListLikes(status.Id, statuses.PageQuery{Limit: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Like{secondLike}, firstPage.Likes)
+	assert.NotNil(t, firstPage.Next)
+
+	secondPage, err := repo.ListLikes(status.Id, statuses.PageQuery{Limit: 1, Cursor: firstPage.Next})
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Like{firstLike}, secondPage.Likes)
+	assert.Nil(t, secondPage.Next)
+
+	likedByUser, err := repo.ListLikedByUser(firstLike.UserId, statuses.PageQuery{Limit: statuses.DefaultPageLimit})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(likedByUser.Statuses))
+	assert.Equal(t, status.Id, likedByUser.Statuses[0].Id)
+
+	unliked, err := repo.DeleteLike(status.Id, firstLike.UserId)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, unliked.LikeCount)
+	_, err = repo.DeleteLike(status.Id, firstLike.UserId)
+	assert.ErrorIs(t, err, NotLikedError)
+
+	likedByUser, err = repo.ListLikedByUser(firstLike.UserId, statuses.PageQuery{Limit: statuses.DefaultPageLimit})
+	assert.NoError(t, err)
+	assert.Empty(t, likedByUser.Statuses)
+}
+
+func TestInMemoryRepo_CreateLike_Concurrent(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+	_, err := repo.Create(status)
+	assert.NoError(t, err)
+
+	// WHEN
+	likers := 50
+	var wg sync.WaitGroup
+	for i := 0; i < likers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := repo.CreateLike(statuses.Like{StatusId: status.Id, UserId: uuid.New(), CreatedAt: time.Now().UTC()})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// THEN
+	liked, err := repo.Get(status.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, likers, liked.LikeCount)
+	likes, err := repo.ListLikes(status.Id, statuses.PageQuery{Limit: statuses.MaxPageLimit})
+	assert.NoError(t, err)
+	assert.Len(t, likes.Likes, likers)
+}
+
+func TestInMemoryRepo_Likes_DeletedStatus(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
+	_, err := repo.Create(status)
+	assert.NoError(t, err)
+	like := statuses.Like{StatusId: status.Id, UserId: uuid.New(), CreatedAt: time.Now().UTC()}
+	_, err = repo.CreateLike(like)
+	assert.NoError(t, err)
+
+	// WHEN
+	_, err = repo.Delete(status.Id)
+	assert.NoError(t, err)
+
+	// THEN
+	likedByUser, err := repo.ListLikedByUser(like.UserId, statuses.PageQuery{Limit: statuses.DefaultPageLimit})
+	assert.NoError(t, err)
+	assert.Empty(t, likedByUser.Statuses)
+}
+
+func TestInMemoryRepo_ListByTag(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	now := time.Now().UTC()
+	tagged := statuses.Status{Id: uuid.New(), Content: "#go", UserId: uuid.New(), CreatedAt: now, Tags: []string{"go"}}
+	retagged := statuses.Status{Id: uuid.New(), Content: "#go #dapr", UserId: uuid.New(), CreatedAt: now.Add(time.Second), Tags: []string{"go", "dapr"}}
+	deleted := statuses.Status{Id: uuid.New(), Content: "#go", UserId: uuid.New(), CreatedAt: now.Add(2 * time.Second), Tags: []string{"go"}}
+	untagged := statuses.Status{Id: uuid.New(), Content: "no tags", UserId: uuid.New(), CreatedAt: now.Add(3 * time.Second)}
+	for _, status := range []statuses.Status{tagged, retagged, deleted, untagged} {
+		_, err := repo.Create(status)
+		assert.NoError(t, err)
+	}
+
+	// WHEN
+	_, err := repo.Delete(deleted.Id)
+	assert.NoError(t, err)
+	retagged.Content = "#dapr"
+	retagged.Tags = []string{"dapr"}
+	_, err = repo.Update(retagged)
+	assert.NoError(t, err)
+
+	// THEN
+	goPage, err := repo.ListByTag("go", statuses.PageQuery{Limit: statuses.DefaultPageLimit})
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Status{tagged}, goPage.Statuses)
+
+	daprPage, err := repo.ListByTag("dapr", statuses.PageQuery{Limit: statuses.DefaultPageLimit})
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Status{retagged}, daprPage.Statuses)
+}
+
+func TestInMemoryRepo_Vote(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	now := time.Now().UTC()
+	status := statuses.Status{Id: uuid.New(), Content: "poll", UserId: uuid.New(), CreatedAt: now,
+		Poll: &statuses.Poll{Options: []string{"yes", "no"}, ExpiresAt: now.Add(time.Hour), Votes: []int{0, 0}}}
+	_, err := repo.Create(status)
+	assert.NoError(t, err)
+	voter := uuid.New()
+
+	// WHEN
+	poll, err := repo.Vote(statuses.PollVote{StatusId: status.Id, UserId: voter, Choices: []int{0}, CreatedAt: now})
+	_, againErr := repo.Vote(statuses.PollVote{StatusId: status.Id, UserId: voter, Choices: []int{1}, CreatedAt: now})
+	_, expiredErr := repo.Vote(statuses.PollVote{StatusId: status.Id, UserId: uuid.New(), Choices: []int{1}, CreatedAt: now.Add(time.Hour)})
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 0}, poll.Votes)
+	assert.ErrorIs(t, againErr, AlreadyVotedError)
+	assert.ErrorIs(t, expiredErr, PollExpiredError)
+
+	// an edit keeps the tallies
+	edited, err := repo.Get(status.Id)
+	assert.NoError(t, err)
+	edited.Content = "edited poll"
+	edited.Poll = status.Poll
+	_, err = repo.Update(edited)
+	assert.NoError(t, err)
+
+	fetched, err := repo.Get(status.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 0}, fetched.Poll.Votes)
+	assert.Equal(t, 1, fetched.Poll.VoterCount)
+}
+
+func TestInMemoryRepo_Pins(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	now := time.Now().UTC()
+	userId := uuid.New()
+	first := statuses.Status{Id: uuid.New(), Content: "first", UserId: userId, CreatedAt: now}
+	second := statuses.Status{Id: uuid.New(), Content: "second", UserId: userId, CreatedAt: now}
+	for _, status := range []statuses.Status{first, second} {
+		_, err := repo.Create(status)
+		assert.NoError(t, err)
+	}
+
+	// WHEN
+	_, firstErr := repo.Pin(first.Id, now, 2)
+	_, secondErr := repo.Pin(second.Id, now.Add(time.Second), 2)
+	_, alreadyPinnedErr := repo.Pin(second.Id, now, 3)
+	pinned, listErr := repo.ListPinned(userId)
+
+	// THEN
+	assert.NoError(t, firstErr)
+	assert.NoError(t, secondErr)
+	assert.ErrorIs(t, alreadyPinnedErr, AlreadyPinnedError)
+	assert.NoError(t, listErr)
+	assert.Len(t, pinned, 2)
+	assert.Equal(t, second.Id, pinned[0].Id)
+
+	_, err := repo.Unpin(second.Id)
+	assert.NoError(t, err)
+	_, err = repo.Unpin(second.Id)
+	assert.ErrorIs(t, err, NotPinnedError)
+	_, err = repo.Pin(second.Id, now, 1)
+	assert.ErrorIs(t, err, TooManyPinsError)
+}
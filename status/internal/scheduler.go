@@ -0,0 +1,85 @@
+package statuses
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"time"
+	"yatc/internal"
+	statuses "yatc/status/pkg"
+)
+
+var NotScheduledError = errors.New("status is not scheduled")
+
+// schedulerBatchSize bounds how many due statuses are published per round
+const schedulerBatchSize = 100
+
+// Schedule holds statuses that are created with a future publish time. Scheduled statuses are left out of
+// all listings and get no created event until they are published.
+type Schedule interface {
+	// ListScheduled returns the scheduled statuses of a user, due first
+	ListScheduled(userId uuid.UUID) ([]statuses.Status, error)
+	// ListDue returns scheduled statuses whose publish time passed, due first
+	ListDue(now time.Time, limit int) ([]statuses.Status, error)
+	// Reschedule moves the publish time of a scheduled status
+	Reschedule(statusId uuid.UUID, publishAt time.Time) (statuses.Status, error)
+	// PublishScheduled turns a scheduled status into a published one created at publishedAt and stores its
+	// created event in the outbox. Only one of several concurrent calls succeeds, the others fail with NotScheduledError.
+	PublishScheduled(statusId uuid.UUID, publishedAt time.Time) (statuses.Status, error)
+	// CancelScheduled deletes a status that wasn't published yet
+	CancelScheduled(statusId uuid.UUID) (statuses.Status, error)
+}
+
+// Scheduler publishes scheduled statuses once they are due. The schedule is kept in the repository, so it
+// survives restarts, and several replicas can run a Scheduler without publishing a status twice.
+type Scheduler struct {
+	schedule Schedule
+	logger   *zap.Logger
+	interval time.Duration
+}
+
+func NewScheduler(schedule Schedule, logger *zap.Logger, interval time.Duration) *Scheduler {
+	return &Scheduler{schedule, logger, interval}
+}
+
+// Run publishes due statuses every interval until ctx is done
+func (scheduler *Scheduler) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(scheduler.interval):
+		}
+
+		err := scheduler.PublishDue(time.Now().UTC().Truncate(time.Microsecond))
+		if err != nil {
+			scheduler.logger.Error("publishing scheduled statuses", zap.Error(err))
+		}
+	}
+}
+
+// PublishDue publishes all statuses due at now, as created at now. Their created events are published by the Relay.
+func (scheduler *Scheduler) PublishDue(now time.Time) error {
+	for {
+		due, err := scheduler.schedule.ListDue(now, schedulerBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, status := range due {
+			_, err = scheduler.schedule.PublishScheduled(status.Id, now)
+			// Another replica published or the author cancelled it in the meantime
+			if errors.Is(err, NotScheduledError) || errors.Is(err, internal.NotFoundError(status.Id)) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(due) < schedulerBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package statuses
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+	statuses "yatc/status/pkg"
+)
+
+func TestScheduler_PublishDue(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	scheduler := NewScheduler(repo, zap.NewNop(), time.Second)
+
+	now := time.Now().UTC()
+	userId := uuid.New()
+	dueAt, laterAt := now.Add(-time.Second), now.Add(time.Hour)
+	due := statuses.Status{Id: uuid.New(), Content: "due", UserId: userId, PublishAt: &dueAt}
+	later := statuses.Status{Id: uuid.New(), Content: "later", UserId: userId, PublishAt: &laterAt}
+	for _, status := range []statuses.Status{due, later} {
+		_, err := repo.Create(status)
+		assert.NoError(t, err)
+	}
+
+	page, err := repo.ListByUser(userId, statuses.PageQuery{Limit: 10})
+	assert.NoError(t, err)
+	assert.Empty(t, page.Statuses)
+
+	// WHEN
+	err = scheduler.PublishDue(now)
+
+	// THEN
+	assert.NoError(t, err)
+
+	published, err := repo.Get(due.Id)
+	assert.NoError(t, err)
+	assert.Nil(t, published.PublishAt)
+	assert.Equal(t, now, published.CreatedAt)
+
+	page, err = repo.ListByUser(userId, statuses.PageQuery{Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Status{published}, page.Statuses)
+
+	scheduled, err := repo.ListScheduled(userId)
+	assert.NoError(t, err)
+	assert.Len(t, scheduled, 1)
+	assert.Equal(t, later.Id, scheduled[0].Id)
+
+	pending, err := repo.PendingEvents(10)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 1)
+	assert.Equal(t, due.Id, pending[0].Status.Id)
+
+	// a second replica running late must not publish it again
+	assert.NoError(t, scheduler.PublishDue(now))
+	_, err = repo.PublishScheduled(due.Id, now)
+	assert.ErrorIs(t, err, NotScheduledError)
+
+	pending, err = repo.PendingEvents(10)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 1)
+}
+
+func TestInMemoryRepo_Schedule(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	now := time.Now().UTC()
+	userId := uuid.New()
+	first, second := now.Add(time.Hour), now.Add(2*time.Hour)
+	cancelled := statuses.Status{Id: uuid.New(), Content: "cancelled", UserId: userId, PublishAt: &first}
+	rescheduled := statuses.Status{Id: uuid.New(), Content: "rescheduled", UserId: userId, PublishAt: &second}
+	for _, status := range []statuses.Status{cancelled, rescheduled} {
+		_, err := repo.Create(status)
+		assert.NoError(t, err)
+	}
+
+	// WHEN
+	earlier := now.Add(time.Minute)
+	_, rescheduleErr := repo.Reschedule(rescheduled.Id, earlier)
+	_, cancelErr := repo.CancelScheduled(cancelled.Id)
+
+	// THEN
+	assert.NoError(t, rescheduleErr)
+	assert.NoError(t, cancelErr)
+
+	scheduled, err := repo.ListScheduled(userId)
+	assert.NoError(t, err)
+	assert.Len(t, scheduled, 1)
+	assert.Equal(t, &earlier, scheduled[0].PublishAt)
+
+	due, err := repo.ListDue(now.Add(time.Minute), 10)
+	assert.NoError(t, err)
+	assert.Len(t, due, 1)
+
+	due, err = repo.ListDue(now, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, due)
+}
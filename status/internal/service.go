@@ -2,53 +2,663 @@ package statuses
 
 import (
 	"context"
+	"errors"
 	"github.com/google/uuid"
+	"strings"
+	"time"
+	"yatc/internal"
+	"yatc/media/pkg/media"
 	"yatc/status/pkg"
+	"yatc/user/pkg/followers"
+	"yatc/user/pkg/users"
 )
 
+var NotAuthorError = errors.New("only the author can change a status")
+var ParentNotFoundError = errors.New("status replied to does not exist")
+var AlreadyRepostedError = errors.New("status already reposted by user")
+var NotRepostedError = errors.New("status not reposted by user")
+var AlreadyLikedError = errors.New("status already liked by user")
+var NotLikedError = errors.New("status not liked by user")
+var NotOwnScheduleError = errors.New("only the author can see their scheduled statuses")
+var publishAtInPastError = internal.ValidationError{{Field: "publishAt", Message: "must be in the future"}}
+
 type Service struct {
-	repo      Repository
-	publisher Publisher
+	repo            Repository
+	publisher       Publisher
+	userService     users.Service
+	followerService followers.Service
+	mediaService    media.Service
+	config          internal.StatusConfig
+}
+
+func NewStatusService(repo Repository, publisher Publisher, userService users.Service, followerService followers.Service, mediaService media.Service, config internal.StatusConfig) *Service {
+	return &Service{repo: repo, publisher: publisher, userService: userService, followerService: followerService, mediaService: mediaService, config: config}
 }
 
-func NewStatusService(repo Repository, publisher Publisher) *Service {
-	return &Service{repo: repo, publisher: publisher}
+// GetStatuses returns the statuses of a user callerId can see. They are filtered after paging,
+// so a page can hold fewer statuses than the limit while its cursor still continues after it.
+func (statusService *Service) GetStatuses(userId uuid.UUID, callerId uuid.UUID, query statuses.PageQuery) (statuses.StatusPage, error) {
+	page, err := statusService.repo.ListByUser(userId, query)
+	if err != nil {
+		return statuses.StatusPage{}, err
+	}
+
+	page.Statuses, err = statusService.visibleTo(callerId, page.Statuses)
+	if err != nil {
+		return statuses.StatusPage{}, err
+	}
+	return page, nil
 }
 
-func (statusService *Service) GetStatuses(userId uuid.UUID) ([]statuses.Status, error) {
-	list, err := statusService.repo.List()
+// GetPinnedStatuses returns the statuses a user pinned that callerId can see, last pinned first
+func (statusService *Service) GetPinnedStatuses(userId uuid.UUID, callerId uuid.UUID) ([]statuses.Status, error) {
+	pinned, err := statusService.repo.ListPinned(userId)
 	if err != nil {
 		return nil, err
 	}
 
-	foundStatuses := make([]statuses.Status, 0)
-	for _, status := range list {
-		if status.UserId == userId {
-			foundStatuses = append(foundStatuses, status)
+	return statusService.visibleTo(callerId, pinned)
+}
+
+// GetTaggedStatuses is a public feed, so it only contains public statuses
+func (statusService *Service) GetTaggedStatuses(tag string, query statuses.PageQuery) (statuses.StatusPage, error) {
+	page, err := statusService.repo.ListByTag(NormalizeTag(tag), query)
+	if err != nil {
+		return statuses.StatusPage{}, err
+	}
+
+	page.Statuses, err = statusService.visibleTo(uuid.Nil, page.Statuses)
+	if err != nil {
+		return statuses.StatusPage{}, err
+	}
+	return page, nil
+}
+
+func (statusService *Service) GetStatus(statusId uuid.UUID, callerId uuid.UUID) (statuses.Status, error) {
+	return statusService.getVisible(statusId, callerId)
+}
+
+// getPublished returns a status unless it is scheduled or deleted, scheduled statuses are only visible to their author
+// through GetScheduledStatuses
+func (statusService *Service) getPublished(statusId uuid.UUID) (statuses.Status, error) {
+	status, err := statusService.getExisting(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	if status.PublishAt != nil {
+		return statuses.Status{}, internal.NotFoundError(statusId)
+	}
+	return status, nil
+}
+
+// getExisting returns a status unless it is deleted, deleted statuses are only found by RestoreStatus
+func (statusService *Service) getExisting(statusId uuid.UUID) (statuses.Status, error) {
+	status, err := statusService.repo.Get(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	if status.DeletedAt != nil {
+		return statuses.Status{}, internal.NotFoundError(statusId)
+	}
+	return status, nil
+}
+
+// GetStatusHistory returns the revisions of a status callerId can see
+func (statusService *Service) GetStatusHistory(statusId uuid.UUID, callerId uuid.UUID) ([]statuses.Revision, error) {
+	_, err := statusService.getVisible(statusId, callerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return statusService.repo.History(statusId)
+}
+
+// GetStatusContext returns the statuses the status replies to and the tree of replies to it, as far as callerId can
+// see them. Ancestors that were deleted or can't be seen end the conversation there, replies to them are left out.
+func (statusService *Service) GetStatusContext(statusId uuid.UUID, callerId uuid.UUID) (statuses.StatusContext, error) {
+	status, err := statusService.getVisible(statusId, callerId)
+	if err != nil {
+		return statuses.StatusContext{}, err
+	}
+
+	ancestors := make([]statuses.Status, 0)
+	for parentId := status.InReplyToId; parentId != nil; {
+		parent, err := statusService.getExisting(*parentId)
+		if err != nil {
+			if errors.Is(err, internal.NotFoundError(*parentId)) {
+				break
+			}
+			return statuses.StatusContext{}, err
+		}
+		visible, err := statusService.visibleTo(callerId, []statuses.Status{parent})
+		if err != nil {
+			return statuses.StatusContext{}, err
+		}
+		if len(visible) == 0 {
+			break
 		}
+		ancestors = append([]statuses.Status{parent}, ancestors...)
+		parentId = parent.InReplyToId
 	}
 
-	return foundStatuses, nil
+	descendants, err := statusService.threadsOf(statusId, callerId)
+	if err != nil {
+		return statuses.StatusContext{}, err
+	}
+
+	return statuses.StatusContext{Ancestors: ancestors, Descendants: descendants}, nil
 }
 
-func (statusService *Service) GetStatus(statusId uuid.UUID) (statuses.Status, error) {
-	return statusService.repo.Get(statusId)
+func (statusService *Service) threadsOf(statusId uuid.UUID, callerId uuid.UUID) ([]statuses.Thread, error) {
+	replies, err := statusService.repo.Replies(statusId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Replies to a deleted reply are left out with it, as they would be once it is purged, and so are replies to one
+	// the caller can't see
+	visible, err := statusService.visibleTo(callerId, replies)
+	if err != nil {
+		return nil, err
+	}
+
+	threads := make([]statuses.Thread, 0, len(visible))
+	for _, reply := range visible {
+		replyThreads, err := statusService.threadsOf(reply.Id, callerId)
+		if err != nil {
+			return nil, err
+		}
+		threads = append(threads, statuses.Thread{Status: reply, Replies: replyThreads})
+	}
+
+	return threads, nil
 }
 
 func (statusService *Service) CreateStatus(ctx context.Context, status statuses.Status) (statuses.Status, error) {
-	createdStatus, err := statusService.repo.Create(status)
+	err := statusService.validate(status.UserId, status.Content, status.SpoilerText, status.MediaIds)
 	if err != nil {
 		return statuses.Status{}, err
 	}
 
-	err = statusService.publisher.Publish(status)
+	if status.Visibility == "" {
+		status.Visibility = statuses.Public
+	}
+	if !validVisibility(status.Visibility) {
+		return statuses.Status{}, invalidVisibilityError
+	}
+
+	if status.InReplyToId != nil {
+		_, err = statusService.getVisible(*status.InReplyToId, status.UserId)
+		if err != nil {
+			if errors.Is(err, internal.NotFoundError(*status.InReplyToId)) {
+				return statuses.Status{}, ParentNotFoundError
+			}
+			return statuses.Status{}, err
+		}
+	}
+
+	// Postgres stores timestamps with microsecond precision
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	status.CreatedAt = now
+	status.UpdatedAt = now
+	if status.PublishAt != nil {
+		if !status.PublishAt.After(now) {
+			return statuses.Status{}, publishAtInPastError
+		}
+		status.PublishAt = internal.Ptr(status.PublishAt.UTC().Truncate(time.Microsecond))
+	}
+
+	if status.Poll != nil {
+		publishedAt := now
+		if status.PublishAt != nil {
+			publishedAt = *status.PublishAt
+		}
+		poll, validation := newPoll(*status.Poll, publishedAt)
+		if len(validation) > 0 {
+			return statuses.Status{}, validation
+		}
+		status.Poll = &poll
+	}
+
+	err = statusService.checkDuplicate(status)
 	if err != nil {
 		return statuses.Status{}, err
 	}
 
-	return createdStatus, nil
+	status.Tags = ParseTags(status.Content)
+	status.Mentions, err = statusService.resolveMentions(status.Content)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	// The created event is stored in the outbox together with the status and published by the Relay,
+	// for scheduled statuses once the Scheduler published them
+	return statusService.repo.Create(status)
 }
 
-func (statusService *Service) DeleteStatus(statusId uuid.UUID) (statuses.Status, error) {
-	return statusService.repo.Delete(statusId)
+func (statusService *Service) UpdateStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, edit statuses.StatusEdit) (statuses.Status, error) {
+	status, err := statusService.getExisting(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	if status.UserId != userId {
+		return statuses.Status{}, NotAuthorError
+	}
+
+	mediaIds := status.MediaIds
+	if edit.MediaIds != nil {
+		mediaIds = *edit.MediaIds
+	}
+	spoilerText := status.SpoilerText
+	if edit.SpoilerText != nil {
+		spoilerText = *edit.SpoilerText
+	}
+	err = statusService.validate(userId, edit.Content, spoilerText, mediaIds)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	mentions, err := statusService.resolveMentions(edit.Content)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	previousMentions := status.Mentions
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	status.Content = edit.Content
+	status.Tags = ParseTags(edit.Content)
+	status.Mentions = mentions
+	status.MediaIds = mediaIds
+	status.SpoilerText = spoilerText
+	if edit.Sensitive != nil {
+		status.Sensitive = *edit.Sensitive
+	}
+	status.UpdatedAt = now
+	status.EditedAt = &now
+
+	updatedStatus, err := statusService.repo.Update(status)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	// Nobody else knows about a scheduled status yet
+	if updatedStatus.PublishAt != nil {
+		return updatedStatus, nil
+	}
+
+	err = statusService.publisher.PublishUpdated(updatedStatus)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	// Subscribers find the reposts of the edited status through the reposters
+	reposts, err := statusService.repo.Reposts(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	for _, repost := range reposts {
+		reposted := updatedStatus
+		reposted.Repost = internal.Ptr(repost)
+		err = statusService.publisher.PublishUpdated(reposted)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+	}
+
+	err = publishMentioned(statusService.publisher, updatedStatus, previousMentions)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	return updatedStatus, nil
+}
+
+// resolveMentions looks up the users mentioned in content, mentions of unknown usernames are dropped
+func (statusService *Service) resolveMentions(content string) ([]statuses.Mention, error) {
+	parsed := ParseMentions(content)
+	if len(parsed) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, len(parsed))
+	for i, mention := range parsed {
+		names[i] = mention.Username
+	}
+
+	mentionedUsers, err := statusService.userService.GetUsersByName(names)
+	if err != nil {
+		return nil, err
+	}
+
+	usersByName := make(map[string]users.User, len(mentionedUsers))
+	for _, user := range mentionedUsers {
+		usersByName[strings.ToLower(user.Name)] = user
+	}
+
+	var mentions []statuses.Mention
+	for _, mention := range parsed {
+		user, found := usersByName[strings.ToLower(mention.Username)]
+		if !found {
+			continue
+		}
+		mention.UserId = user.Id
+		mention.Username = user.Name
+		mentions = append(mentions, mention)
+	}
+	return mentions, nil
+}
+
+// publishMentioned tells every user mentioned in status once, unless they were already mentioned before or are the author
+func publishMentioned(publisher Publisher, status statuses.Status, previous []statuses.Mention) error {
+	notified := internal.NewSet[uuid.UUID]()
+	notified.Add(status.UserId)
+	for _, mention := range previous {
+		notified.Add(mention.UserId)
+	}
+
+	for _, mention := range status.Mentions {
+		if notified.Has(mention.UserId) {
+			continue
+		}
+		notified.Add(mention.UserId)
+
+		err := publisher.PublishMentioned(statuses.MentionEvent{UserId: mention.UserId, Status: status})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateRepost reposts a public status, reposts of restricted statuses would show them to the followers of the reposter
+func (statusService *Service) CreateRepost(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	original, err := statusService.getVisible(statusId, userId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	if !original.VisibleTo(uuid.Nil, false) {
+		return statuses.Status{}, NotRepostableError
+	}
+
+	repost := statuses.Repost{
+		StatusId:  statusId,
+		UserId:    userId,
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+
+	status, err := statusService.repo.CreateRepost(repost)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	status.Repost = &repost
+
+	err = statusService.publisher.PublishReposted(status)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	return status, nil
+}
+
+func (statusService *Service) DeleteRepost(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	reposts, err := statusService.repo.Reposts(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	status, err := statusService.repo.DeleteRepost(statusId, userId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	// Subscribers need to know whose repost to remove
+	unreposted := status
+	unreposted.Repost = &statuses.Repost{StatusId: statusId, UserId: userId}
+	for _, repost := range reposts {
+		if repost.UserId == userId {
+			unreposted.Repost.CreatedAt = repost.CreatedAt
+		}
+	}
+	err = statusService.publisher.PublishUnreposted(unreposted)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	return status, nil
+}
+
+// GetLikes returns a page of the likes of a status callerId can see
+func (statusService *Service) GetLikes(statusId uuid.UUID, callerId uuid.UUID, query statuses.PageQuery) (statuses.LikePage, error) {
+	_, err := statusService.getVisible(statusId, callerId)
+	if err != nil {
+		return statuses.LikePage{}, err
+	}
+
+	return statusService.repo.ListLikes(statusId, query)
+}
+
+// GetLikedStatuses is a public feed, so it only contains public statuses
+func (statusService *Service) GetLikedStatuses(userId uuid.UUID, query statuses.PageQuery) (statuses.StatusPage, error) {
+	page, err := statusService.repo.ListLikedByUser(userId, query)
+	if err != nil {
+		return statuses.StatusPage{}, err
+	}
+
+	page.Statuses, err = statusService.visibleTo(uuid.Nil, page.Statuses)
+	if err != nil {
+		return statuses.StatusPage{}, err
+	}
+	return page, nil
+}
+
+func (statusService *Service) CreateLike(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	_, err := statusService.getVisible(statusId, userId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	like := statuses.Like{
+		StatusId:  statusId,
+		UserId:    userId,
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+
+	return statusService.repo.CreateLike(like)
+}
+
+func (statusService *Service) DeleteLike(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	return statusService.repo.DeleteLike(statusId, userId)
+}
+
+// DeleteStatus deletes a status of its author. Published statuses are kept as a tombstone, which RestoreStatus can bring
+// back until the Purger deletes it for good. Its reposts are undone first, so subscribers can remove them as well.
+// A pinned status is unpinned, it stays unpinned when it is restored.
+func (statusService *Service) DeleteStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	status, err := statusService.getExisting(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	if status.UserId != userId {
+		return statuses.Status{}, NotAuthorError
+	}
+
+	if status.PublishAt != nil {
+		return statusService.repo.CancelScheduled(statusId)
+	}
+
+	reposts, err := statusService.repo.Reposts(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	if status.PinnedAt != nil {
+		_, err = statusService.repo.Unpin(statusId)
+		if err != nil && !errors.Is(err, NotPinnedError) {
+			return statuses.Status{}, err
+		}
+	}
+
+	deletedStatus, err := statusService.repo.Tombstone(statusId, time.Now().UTC().Truncate(time.Microsecond))
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	for _, repost := range reposts {
+		unreposted := deletedStatus
+		unreposted.Repost = internal.Ptr(repost)
+		err = statusService.publisher.PublishUnreposted(unreposted)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+	}
+
+	err = statusService.publisher.PublishDeleted(deletedStatus)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	return deletedStatus, nil
+}
+
+// RestoreStatus brings back a deleted status of its author within the restore window. Subscribers get it as created
+// again through the outbox, and the reposts that were undone when it was deleted.
+func (statusService *Service) RestoreStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	status, err := statusService.repo.Get(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	if status.UserId != userId {
+		return statuses.Status{}, NotAuthorError
+	}
+
+	if status.DeletedAt == nil {
+		return statuses.Status{}, NotDeletedError
+	}
+
+	if time.Since(*status.DeletedAt) >= statusService.config.RestoreWindow {
+		return statuses.Status{}, RestoreWindowExpiredError
+	}
+
+	restoredStatus, err := statusService.repo.Restore(statusId, time.Now().UTC().Truncate(time.Microsecond))
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	reposts, err := statusService.repo.Reposts(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	for _, repost := range reposts {
+		reposted := restoredStatus
+		reposted.Repost = internal.Ptr(repost)
+		err = statusService.publisher.PublishReposted(reposted)
+		if err != nil {
+			return statuses.Status{}, err
+		}
+	}
+
+	return restoredStatus, nil
+}
+
+// PinStatus pins a published status of userId to their profile, only userId can change their pins
+func (statusService *Service) PinStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, callerId uuid.UUID) (statuses.Status, error) {
+	err := statusService.checkPinnable(statusId, userId, callerId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	return statusService.repo.Pin(statusId, time.Now().UTC().Truncate(time.Microsecond), statusService.config.MaxPinnedStatuses)
+}
+
+func (statusService *Service) UnpinStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, callerId uuid.UUID) (statuses.Status, error) {
+	err := statusService.checkPinnable(statusId, userId, callerId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	return statusService.repo.Unpin(statusId)
+}
+
+func (statusService *Service) checkPinnable(statusId uuid.UUID, userId uuid.UUID, callerId uuid.UUID) error {
+	if userId != callerId {
+		return NotOwnPinsError
+	}
+
+	status, err := statusService.getPublished(statusId)
+	if err != nil {
+		return err
+	}
+
+	if status.UserId != userId {
+		return NotAuthorError
+	}
+	return nil
+}
+
+// GetScheduledStatuses returns the scheduled statuses of a user, due first. Only the user can see them.
+func (statusService *Service) GetScheduledStatuses(userId uuid.UUID, callerId uuid.UUID) ([]statuses.Status, error) {
+	if userId != callerId {
+		return nil, NotOwnScheduleError
+	}
+	return statusService.repo.ListScheduled(userId)
+}
+
+func (statusService *Service) RescheduleStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, publishAt time.Time) (statuses.Status, error) {
+	status, err := statusService.repo.Get(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	if status.UserId != userId {
+		return statuses.Status{}, NotAuthorError
+	}
+
+	if !publishAt.After(time.Now()) {
+		return statuses.Status{}, publishAtInPastError
+	}
+
+	return statusService.repo.Reschedule(statusId, publishAt.UTC().Truncate(time.Microsecond))
+}
+
+// CancelScheduledStatus deletes a scheduled status of its author before it is published
+func (statusService *Service) CancelScheduledStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	status, err := statusService.repo.Get(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	if status.UserId != userId {
+		return statuses.Status{}, NotAuthorError
+	}
+
+	return statusService.repo.CancelScheduled(statusId)
+}
+
+// VotePoll records the vote of a user in the poll of a status and returns the poll with its new tallies
+func (statusService *Service) VotePoll(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, choices []int) (statuses.Poll, error) {
+	status, err := statusService.getVisible(statusId, userId)
+	if err != nil {
+		return statuses.Poll{}, err
+	}
+
+	if status.Poll == nil {
+		return statuses.Poll{}, NoPollError
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	if status.Poll.Expired(now) {
+		return statuses.Poll{}, PollExpiredError
+	}
+
+	err = validateChoices(*status.Poll, choices)
+	if err != nil {
+		return statuses.Poll{}, err
+	}
+
+	return statusService.repo.Vote(statuses.PollVote{StatusId: statusId, UserId: userId, Choices: choices, CreatedAt: now})
 }
@@ -2,39 +2,327 @@ package statuses
 
 import (
 	"context"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"strings"
 	"testing"
+	"time"
+	"yatc/internal"
+	"yatc/media/pkg/media"
 	statuses "yatc/status/pkg"
+	"yatc/user/pkg/users"
 )
 
 type MockRepository struct {
 	CreateCalled bool
+	UpdateCalled bool
+	statuses     map[uuid.UUID]statuses.Status
+	created      []uuid.UUID
+	reposts      map[uuid.UUID][]statuses.Repost
+	sent         map[uuid.UUID]bool
+	voters       map[uuid.UUID][]uuid.UUID
 }
 
 func NewMockRepository() *MockRepository {
-	return &MockRepository{}
+	return &MockRepository{statuses: map[uuid.UUID]statuses.Status{}, reposts: map[uuid.UUID][]statuses.Repost{}, sent: map[uuid.UUID]bool{}, voters: map[uuid.UUID][]uuid.UUID{}}
+}
+
+func (repo *MockRepository) Vote(vote statuses.PollVote) (statuses.Poll, error) {
+	status := repo.statuses[vote.StatusId]
+	for _, voter := range repo.voters[vote.StatusId] {
+		if voter == vote.UserId {
+			return statuses.Poll{}, AlreadyVotedError
+		}
+	}
+	repo.voters[vote.StatusId] = append(repo.voters[vote.StatusId], vote.UserId)
+
+	poll := *status.Poll
+	poll.Votes = append([]int(nil), poll.Votes...)
+	tally(&poll, vote.Choices)
+	status.Poll = &poll
+	repo.statuses[vote.StatusId] = status
+	return poll, nil
+}
+
+func (repo *MockRepository) PendingEvents(limit int) ([]OutboxEntry, error) {
+	pending := make([]OutboxEntry, 0)
+	for _, id := range repo.created {
+		status, exists := repo.statuses[id]
+		if exists && status.DeletedAt == nil && !repo.sent[id] && len(pending) < limit {
+			pending = append(pending, OutboxEntry{Status: status, CreatedAt: status.CreatedAt})
+		}
+	}
+	return pending, nil
+}
+
+func (repo *MockRepository) MarkEventSent(statusId uuid.UUID, sentAt time.Time) error {
+	repo.sent[statusId] = true
+	return nil
 }
 
 func (repo *MockRepository) Create(status statuses.Status) (statuses.Status, error) {
 	repo.CreateCalled = true
+	repo.statuses[status.Id] = status
+	if status.PublishAt == nil {
+		repo.created = append(repo.created, status.Id)
+	}
+	return status, nil
+}
+
+func (repo *MockRepository) ListScheduled(userId uuid.UUID) ([]statuses.Status, error) {
+	scheduled := make([]statuses.Status, 0)
+	for _, status := range repo.statuses {
+		if status.UserId == userId && status.PublishAt != nil {
+			scheduled = append(scheduled, status)
+		}
+	}
+	return scheduled, nil
+}
+
+func (repo *MockRepository) ListDue(now time.Time, limit int) ([]statuses.Status, error) {
+	panic("implement me")
+}
+
+func (repo *MockRepository) getScheduled(statusId uuid.UUID) (statuses.Status, error) {
+	status, err := repo.Get(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	if status.PublishAt == nil {
+		return statuses.Status{}, NotScheduledError
+	}
 	return status, nil
 }
 
+func (repo *MockRepository) Reschedule(statusId uuid.UUID, publishAt time.Time) (statuses.Status, error) {
+	status, err := repo.getScheduled(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	status.PublishAt = &publishAt
+	repo.statuses[statusId] = status
+	return status, nil
+}
+
+func (repo *MockRepository) PublishScheduled(statusId uuid.UUID, publishedAt time.Time) (statuses.Status, error) {
+	status, err := repo.getScheduled(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	status.PublishAt = nil
+	status.CreatedAt = publishedAt
+	status.UpdatedAt = publishedAt
+	repo.statuses[statusId] = status
+	repo.created = append(repo.created, statusId)
+	return status, nil
+}
+
+func (repo *MockRepository) CancelScheduled(statusId uuid.UUID) (statuses.Status, error) {
+	_, err := repo.getScheduled(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	return repo.Delete(statusId)
+}
+
 func (repo *MockRepository) Get(statusId uuid.UUID) (statuses.Status, error) {
-	return statuses.Status{}, nil
+	status, ok := repo.statuses[statusId]
+	if !ok {
+		return statuses.Status{}, internal.NotFoundError(statusId)
+	}
+	return status, nil
 }
 
 func (repo *MockRepository) List() ([]statuses.Status, error) {
 	return []statuses.Status{}, nil
 }
 
+func (repo *MockRepository) ListByUser(userId uuid.UUID, query statuses.PageQuery) (statuses.StatusPage, error) {
+	page := make([]statuses.Status, 0)
+	for _, id := range repo.created {
+		status, ok := repo.statuses[id]
+		if ok && status.UserId == userId && (query.Since == nil || !status.CreatedAt.Before(*query.Since)) {
+			page = append(page, status)
+		}
+	}
+	return statuses.StatusPage{Statuses: page}, nil
+}
+
+func (repo *MockRepository) ListByTag(tag string, query statuses.PageQuery) (statuses.StatusPage, error) {
+	return statuses.StatusPage{}, nil
+}
+
 func (repo *MockRepository) Delete(statusId uuid.UUID) (statuses.Status, error) {
-	return statuses.Status{}, nil
+	status, err := repo.Get(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	delete(repo.statuses, statusId)
+	delete(repo.reposts, statusId)
+	return status, nil
+}
+
+func (repo *MockRepository) Tombstone(statusId uuid.UUID, deletedAt time.Time) (statuses.Status, error) {
+	status, err := repo.Get(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	status.DeletedAt = &deletedAt
+	repo.statuses[statusId] = status
+	return status, nil
+}
+
+func (repo *MockRepository) Restore(statusId uuid.UUID, restoredAt time.Time) (statuses.Status, error) {
+	status, err := repo.Get(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	if status.DeletedAt == nil {
+		return statuses.Status{}, NotDeletedError
+	}
+	status.DeletedAt = nil
+	repo.statuses[statusId] = status
+	delete(repo.sent, statusId)
+	return status, nil
+}
+
+func (repo *MockRepository) ListExpired(deletedBefore time.Time, limit int) ([]statuses.Status, error) {
+	panic("implement me")
+}
+
+func (repo *MockRepository) Purge(statusId uuid.UUID, deletedBefore time.Time) (statuses.Status, error) {
+	panic("implement me")
+}
+
+func (repo *MockRepository) Pin(statusId uuid.UUID, pinnedAt time.Time, max int) (statuses.Status, error) {
+	status, err := repo.Get(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	pinned, _ := repo.ListPinned(status.UserId)
+	if status.PinnedAt != nil {
+		return statuses.Status{}, AlreadyPinnedError
+	}
+	if len(pinned) >= max {
+		return statuses.Status{}, TooManyPinsError
+	}
+	status.PinnedAt = &pinnedAt
+	repo.statuses[statusId] = status
+	return status, nil
+}
+
+func (repo *MockRepository) Unpin(statusId uuid.UUID) (statuses.Status, error) {
+	status, err := repo.Get(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	if status.PinnedAt == nil {
+		return statuses.Status{}, NotPinnedError
+	}
+	status.PinnedAt = nil
+	repo.statuses[statusId] = status
+	return status, nil
+}
+
+func (repo *MockRepository) ListPinned(userId uuid.UUID) ([]statuses.Status, error) {
+	pinned := make([]statuses.Status, 0)
+	for _, status := range repo.statuses {
+		if status.UserId == userId && status.PinnedAt != nil {
+			pinned = append(pinned, status)
+		}
+	}
+	return pinned, nil
+}
+
+func (repo *MockRepository) Update(status statuses.Status) (statuses.Status, error) {
+	repo.UpdateCalled = true
+	repo.statuses[status.Id] = status
+	return status, nil
+}
+
+func (repo *MockRepository) History(statusId uuid.UUID) ([]statuses.Revision, error) {
+	return []statuses.Revision{}, nil
+}
+
+func (repo *MockRepository) Replies(statusId uuid.UUID) ([]statuses.Status, error) {
+	replies := make([]statuses.Status, 0)
+	for _, id := range repo.created {
+		status, exists := repo.statuses[id]
+		if exists && status.InReplyToId != nil && *status.InReplyToId == statusId {
+			replies = append(replies, status)
+		}
+	}
+	return replies, nil
+}
+
+func (repo *MockRepository) CreateRepost(repost statuses.Repost) (statuses.Status, error) {
+	status, err := repo.Get(repost.StatusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	status.RepostCount++
+	repo.statuses[status.Id] = status
+	repo.reposts[status.Id] = append(repo.reposts[status.Id], repost)
+	return status, nil
+}
+
+func (repo *MockRepository) DeleteRepost(statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	status, err := repo.Get(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	status.RepostCount--
+	repo.statuses[status.Id] = status
+	for i, repost := range repo.reposts[statusId] {
+		if repost.UserId == userId {
+			repo.reposts[statusId] = append(repo.reposts[statusId][:i], repo.reposts[statusId][i+1:]...)
+			break
+		}
+	}
+	return status, nil
+}
+
+func (repo *MockRepository) Reposts(statusId uuid.UUID) ([]statuses.Repost, error) {
+	return repo.reposts[statusId], nil
+}
+
+func (repo *MockRepository) ListLikes(statusId uuid.UUID, query statuses.PageQuery) (statuses.LikePage, error) {
+	return statuses.LikePage{}, nil
+}
+
+func (repo *MockRepository) ListLikedByUser(userId uuid.UUID, query statuses.PageQuery) (statuses.StatusPage, error) {
+	return statuses.StatusPage{}, nil
+}
+
+func (repo *MockRepository) CreateLike(like statuses.Like) (statuses.Status, error) {
+	status, err := repo.Get(like.StatusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	status.LikeCount++
+	repo.statuses[status.Id] = status
+	return status, nil
+}
+
+func (repo *MockRepository) DeleteLike(statusId uuid.UUID, userId uuid.UUID) (statuses.Status, error) {
+	status, err := repo.Get(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	status.LikeCount--
+	repo.statuses[status.Id] = status
+	return status, nil
 }
 
 type MockPublisher struct {
-	PublishCalled bool
+	PublishCalled        bool
+	PublishUpdatedCalled bool
+	Updated              []statuses.Status
+	Reposted             []statuses.Status
+	Unreposted           []statuses.Status
+	Mentioned            []statuses.MentionEvent
+	Deleted              []statuses.Status
 }
 
 func NewMockPublisher() *MockPublisher {
@@ -46,11 +334,134 @@ func (publisher *MockPublisher) Publish(status statuses.Status) error {
 	return nil
 }
 
+func (publisher *MockPublisher) PublishUpdated(status statuses.Status) error {
+	publisher.PublishUpdatedCalled = true
+	publisher.Updated = append(publisher.Updated, status)
+	return nil
+}
+
+func (publisher *MockPublisher) PublishReposted(status statuses.Status) error {
+	publisher.Reposted = append(publisher.Reposted, status)
+	return nil
+}
+
+func (publisher *MockPublisher) PublishUnreposted(status statuses.Status) error {
+	publisher.Unreposted = append(publisher.Unreposted, status)
+	return nil
+}
+
+func (publisher *MockPublisher) PublishDeleted(status statuses.Status) error {
+	publisher.Deleted = append(publisher.Deleted, status)
+	return nil
+}
+
+func (publisher *MockPublisher) PublishMentioned(event statuses.MentionEvent) error {
+	publisher.Mentioned = append(publisher.Mentioned, event)
+	return nil
+}
+
+type MockUserService struct {
+	users []users.User
+}
+
+func NewMockUserService(known ...users.User) *MockUserService {
+	return &MockUserService{users: known}
+}
+
+func (service *MockUserService) GetUsers() ([]users.User, error) {
+	return service.users, nil
+}
+
+func (service *MockUserService) GetUser(userId uuid.UUID) (users.User, error) {
+	panic("implement me")
+}
+
+func (service *MockUserService) GetUsersByName(names []string) ([]users.User, error) {
+	found := make([]users.User, 0)
+	for _, user := range service.users {
+		for _, name := range names {
+			if strings.EqualFold(user.Name, name) {
+				found = append(found, user)
+				break
+			}
+		}
+	}
+	return found, nil
+}
+
+func (service *MockUserService) CreateUser(user users.User) (users.User, error) {
+	panic("implement me")
+}
+
+func (service *MockUserService) DeleteUser(userId uuid.UUID) (users.User, error) {
+	panic("implement me")
+}
+
+func (service *MockUserService) GetPreferences(userId uuid.UUID) (users.Preferences, error) {
+	panic("implement me")
+}
+
+func (service *MockUserService) UpdatePreferences(userId uuid.UUID, preferences users.Preferences) (users.Preferences, error) {
+	panic("implement me")
+}
+
+// MockFollowerService knows who follows whom, followees maps a user to the users they follow
+type MockFollowerService struct {
+	followees map[uuid.UUID][]uuid.UUID
+}
+
+func NewMockFollowerService() *MockFollowerService {
+	return &MockFollowerService{followees: map[uuid.UUID][]uuid.UUID{}}
+}
+
+func (service *MockFollowerService) GetFollowers(ctx context.Context, userId uuid.UUID) ([]users.User, error) {
+	panic("implement me")
+}
+
+func (service *MockFollowerService) GetFollowees(ctx context.Context, userId uuid.UUID) ([]users.User, error) {
+	followees := make([]users.User, 0)
+	for _, followeeId := range service.followees[userId] {
+		followees = append(followees, users.User{Id: followeeId})
+	}
+	return followees, nil
+}
+
+func (service *MockFollowerService) FollowUser(ctx context.Context, userToFollowId uuid.UUID, userWhichFollowsId uuid.UUID) (users.User, error) {
+	service.followees[userWhichFollowsId] = append(service.followees[userWhichFollowsId], userToFollowId)
+	return users.User{Id: userToFollowId}, nil
+}
+
+func (service *MockFollowerService) UnfollowUser(ctx context.Context, userToFollowId uuid.UUID, userWhichFollowsId uuid.UUID) error {
+	panic("implement me")
+}
+
+type MockMediaService struct {
+	media map[uuid.UUID]media.Media
+}
+
+func NewMockMediaService(known ...media.Media) *MockMediaService {
+	service := &MockMediaService{media: map[uuid.UUID]media.Media{}}
+	for _, m := range known {
+		service.media[m.Id] = m
+	}
+	return service
+}
+
+func (service *MockMediaService) GetMedia(mediaId uuid.UUID) (media.Media, error) {
+	m, ok := service.media[mediaId]
+	if !ok {
+		return media.Media{}, internal.NotFoundError(mediaId)
+	}
+	return m, nil
+}
+
+var testStatusConfig = internal.StatusConfig{MaxContentLength: 30, MaxMediaCount: 2, DuplicateWindow: time.Hour, RestoreWindow: time.Hour, MaxPinnedStatuses: 2}
+
 func TestService_CreateStatus(t *testing.T) {
 	// GIVEN
 	repo := NewMockRepository()
 	publisher := NewMockPublisher()
-	service := NewStatusService(repo, publisher)
+	service := NewStatusService(repo, publisher, NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
 	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()}
 
 	// WHEN
@@ -58,7 +469,854 @@ func TestService_CreateStatus(t *testing.T) {
 
 	// THEN
 	assert.Nil(t, err)
-	assert.Equal(t, status, createdStatus)
+	assert.Equal(t, status.Id, createdStatus.Id)
+	assert.Equal(t, status.Content, createdStatus.Content)
+	assert.Equal(t, status.UserId, createdStatus.UserId)
+	assert.False(t, createdStatus.CreatedAt.IsZero())
+	assert.Equal(t, createdStatus.CreatedAt, createdStatus.UpdatedAt)
 	assert.True(t, repo.CreateCalled)
-	assert.True(t, publisher.PublishCalled)
+	assert.False(t, publisher.PublishCalled, "created events are published by the relay")
+
+	pending, err := repo.PendingEvents(10)
+	assert.NoError(t, err)
+	assert.Equal(t, []OutboxEntry{{Status: createdStatus, CreatedAt: createdStatus.CreatedAt}}, pending)
+}
+
+func TestService_UpdateStatus(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	publisher := NewMockPublisher()
+	service := NewStatusService(repo, publisher, NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()})
+	assert.NoError(t, err)
+
+	// WHEN
+	updatedStatus, err := service.UpdateStatus(context.Background(), status.Id, status.UserId, statuses.StatusEdit{Content: "edited status"})
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, "edited status", updatedStatus.Content)
+	assert.Equal(t, status.CreatedAt, updatedStatus.CreatedAt)
+	assert.NotNil(t, updatedStatus.EditedAt)
+	assert.Equal(t, *updatedStatus.EditedAt, updatedStatus.UpdatedAt)
+	assert.True(t, repo.UpdateCalled)
+	assert.True(t, publisher.PublishUpdatedCalled)
+}
+
+func TestService_UpdateStatus_Reposted(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	publisher := NewMockPublisher()
+	service := NewStatusService(repo, publisher, NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()})
+	assert.NoError(t, err)
+	reposter := uuid.New()
+	reposted, err := service.CreateRepost(context.Background(), status.Id, reposter)
+	assert.NoError(t, err)
+
+	// WHEN
+	updatedStatus, err := service.UpdateStatus(context.Background(), status.Id, status.UserId, statuses.StatusEdit{Content: "edited status"})
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Len(t, publisher.Updated, 2)
+	assert.Equal(t, updatedStatus, publisher.Updated[0])
+	assert.Equal(t, "edited status", publisher.Updated[1].Content)
+	assert.Equal(t, reposted.Repost, publisher.Updated[1].Repost)
+}
+
+func TestService_UpdateStatus_NotAuthor(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	publisher := NewMockPublisher()
+	service := NewStatusService(repo, publisher, NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()})
+	assert.NoError(t, err)
+
+	// WHEN
+	_, err = service.UpdateStatus(context.Background(), status.Id, uuid.New(), statuses.StatusEdit{Content: "edited status"})
+
+	// THEN
+	assert.ErrorIs(t, err, NotAuthorError)
+	assert.False(t, repo.UpdateCalled)
+	assert.False(t, publisher.PublishUpdatedCalled)
+}
+
+func TestService_CreateStatus_ReplyToNonExistentStatus(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	publisher := NewMockPublisher()
+	service := NewStatusService(repo, publisher, NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	status := statuses.Status{Id: uuid.New(), Content: "reply", UserId: uuid.New(), InReplyToId: internal.Ptr(uuid.New())}
+
+	// WHEN
+	_, err := service.CreateStatus(context.Background(), status)
+
+	// THEN
+	assert.ErrorIs(t, err, ParentNotFoundError)
+	assert.False(t, repo.CreateCalled)
+	assert.False(t, publisher.PublishCalled)
+}
+
+func TestService_GetStatusContext(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	create := func(content string, inReplyToId *uuid.UUID) statuses.Status {
+		status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: content, UserId: uuid.New(), InReplyToId: inReplyToId})
+		assert.NoError(t, err)
+		return status
+	}
+	root := create("root", nil)
+	status := create("status", &root.Id)
+	firstReply := create("first reply", &status.Id)
+	secondReply := create("second reply", &status.Id)
+	nestedReply := create("nested reply", &firstReply.Id)
+	create("sibling", &root.Id)
+
+	// WHEN
+	statusContext, err := service.GetStatusContext(status.Id, uuid.Nil)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Status{root}, statusContext.Ancestors)
+	assert.Equal(t, []statuses.Thread{
+		{Status: firstReply, Replies: []statuses.Thread{{Status: nestedReply, Replies: []statuses.Thread{}}}},
+		{Status: secondReply, Replies: []statuses.Thread{}},
+	}, statusContext.Descendants)
+}
+
+func TestService_GetStatusContext_DeletedAncestor(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	root, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "root", UserId: uuid.New()})
+	assert.NoError(t, err)
+	status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "status", UserId: uuid.New(), InReplyToId: &root.Id})
+	assert.NoError(t, err)
+	delete(repo.statuses, root.Id)
+
+	// WHEN
+	statusContext, err := service.GetStatusContext(status.Id, uuid.Nil)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Empty(t, statusContext.Ancestors)
+	assert.Empty(t, statusContext.Descendants)
+}
+
+func TestService_Repost(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	publisher := NewMockPublisher()
+	service := NewStatusService(repo, publisher, NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()})
+	assert.NoError(t, err)
+	reposter := uuid.New()
+
+	// WHEN
+	reposted, err := service.CreateRepost(context.Background(), status.Id, reposter)
+	assert.NoError(t, err)
+	unreposted, err := service.DeleteRepost(context.Background(), status.Id, reposter)
+	assert.NoError(t, err)
+
+	// THEN
+	assert.Equal(t, 1, reposted.RepostCount)
+	assert.Equal(t, reposter, reposted.Repost.UserId)
+	assert.Equal(t, status.UserId, reposted.UserId)
+	assert.Equal(t, 0, unreposted.RepostCount)
+	assert.Nil(t, unreposted.Repost)
+
+	assert.Equal(t, []statuses.Status{reposted}, publisher.Reposted)
+	assert.Equal(t, 1, len(publisher.Unreposted))
+	assert.Equal(t, reposted.Repost, publisher.Unreposted[0].Repost)
+}
+
+func TestService_GetLikes_NonExistentStatus(t *testing.T) {
+	// GIVEN
+	service := NewStatusService(NewMockRepository(), NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	statusId := uuid.New()
+
+	// WHEN
+	_, err := service.GetLikes(statusId, uuid.Nil, statuses.PageQuery{Limit: statuses.DefaultPageLimit})
+
+	// THEN
+	assert.ErrorIs(t, err, internal.NotFoundError(statusId))
+}
+
+func TestService_CreateStatus_Tags(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	status := statuses.Status{Id: uuid.New(), Content: "hello #Dapr and #golang", UserId: uuid.New()}
+
+	// WHEN
+	createdStatus, err := service.CreateStatus(context.Background(), status)
+	assert.NoError(t, err)
+	updatedStatus, err := service.UpdateStatus(context.Background(), status.Id, status.UserId, statuses.StatusEdit{Content: "only #golang"})
+	assert.NoError(t, err)
+
+	// THEN
+	assert.Equal(t, []string{"dapr", "golang"}, createdStatus.Tags)
+	assert.Equal(t, []string{"golang"}, updatedStatus.Tags)
+}
+
+func TestService_CreateStatus_Mentions(t *testing.T) {
+	// GIVEN
+	hans := users.User{Id: uuid.New(), Name: "Hans"}
+	peter := users.User{Id: uuid.New(), Name: "Peter"}
+	publisher := NewMockPublisher()
+	service := NewStatusService(NewMockRepository(), publisher, NewMockUserService(hans, peter), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	status := statuses.Status{Id: uuid.New(), Content: "hi @hans, @nobody and @Hans", UserId: uuid.New()}
+
+	// WHEN
+	createdStatus, err := service.CreateStatus(context.Background(), status)
+	assert.NoError(t, err)
+	updatedStatus, err := service.UpdateStatus(context.Background(), status.Id, status.UserId, statuses.StatusEdit{Content: "hi @hans and @peter"})
+	assert.NoError(t, err)
+
+	// THEN
+	assert.Equal(t, []statuses.Mention{
+		{UserId: hans.Id, Username: "Hans", Start: 3, End: 8},
+		{UserId: hans.Id, Username: "Hans", Start: 22, End: 27},
+	}, createdStatus.Mentions)
+	assert.Equal(t, []statuses.Mention{
+		{UserId: hans.Id, Username: "Hans", Start: 3, End: 8},
+		{UserId: peter.Id, Username: "Peter", Start: 13, End: 19},
+	}, updatedStatus.Mentions)
+	// Mentions of created statuses are published by the relay, edits only tell newly mentioned users
+	assert.Equal(t, []statuses.MentionEvent{{UserId: peter.Id, Status: updatedStatus}}, publisher.Mentioned)
+}
+
+func TestService_DeleteStatus(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	publisher := NewMockPublisher()
+	service := NewStatusService(repo, publisher, NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()})
+	assert.NoError(t, err)
+	reposter := uuid.New()
+	_, err = service.CreateRepost(context.Background(), status.Id, reposter)
+	assert.NoError(t, err)
+
+	// WHEN
+	_, notAuthorErr := service.DeleteStatus(context.Background(), status.Id, reposter)
+	deletedStatus, err := service.DeleteStatus(context.Background(), status.Id, status.UserId)
+
+	// THEN
+	assert.ErrorIs(t, notAuthorErr, NotAuthorError)
+	assert.NoError(t, err)
+	assert.Equal(t, status.Id, deletedStatus.Id)
+	assert.Equal(t, []statuses.Status{deletedStatus}, publisher.Deleted)
+	assert.Equal(t, 1, len(publisher.Unreposted))
+	assert.Equal(t, reposter, publisher.Unreposted[0].Repost.UserId)
+	assert.NotNil(t, deletedStatus.DeletedAt)
+
+	_, err = service.GetStatus(status.Id, status.UserId)
+	assert.ErrorIs(t, err, internal.NotFoundError(status.Id))
+	_, err = service.DeleteStatus(context.Background(), status.Id, status.UserId)
+	assert.ErrorIs(t, err, internal.NotFoundError(status.Id))
+}
+
+func TestService_PinStatus(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	userId := uuid.New()
+	pinned := make([]statuses.Status, 3)
+	for i := range pinned {
+		status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: fmt.Sprintf("status %d", i), UserId: userId})
+		assert.NoError(t, err)
+		pinned[i] = status
+	}
+	other, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "other status", UserId: uuid.New()})
+	assert.NoError(t, err)
+
+	// WHEN
+	first, firstErr := service.PinStatus(context.Background(), pinned[0].Id, userId, userId)
+	_, secondErr := service.PinStatus(context.Background(), pinned[1].Id, userId, userId)
+	_, tooManyErr := service.PinStatus(context.Background(), pinned[2].Id, userId, userId)
+	_, alreadyPinnedErr := service.PinStatus(context.Background(), pinned[0].Id, userId, userId)
+	_, notOwnErr := service.PinStatus(context.Background(), pinned[2].Id, userId, uuid.New())
+	_, notAuthorErr := service.PinStatus(context.Background(), other.Id, userId, userId)
+
+	// THEN
+	assert.NoError(t, firstErr)
+	assert.NotNil(t, first.PinnedAt)
+	assert.NoError(t, secondErr)
+	assert.ErrorIs(t, tooManyErr, TooManyPinsError)
+	assert.ErrorIs(t, alreadyPinnedErr, AlreadyPinnedError)
+	assert.ErrorIs(t, notOwnErr, NotOwnPinsError)
+	assert.ErrorIs(t, notAuthorErr, NotAuthorError)
+
+	_, err = service.DeleteStatus(context.Background(), pinned[0].Id, userId)
+	assert.NoError(t, err)
+	_, err = service.PinStatus(context.Background(), pinned[2].Id, userId, userId)
+	assert.NoError(t, err)
+
+	_, err = service.UnpinStatus(context.Background(), pinned[1].Id, userId, userId)
+	assert.NoError(t, err)
+	_, err = service.UnpinStatus(context.Background(), pinned[1].Id, userId, userId)
+	assert.ErrorIs(t, err, NotPinnedError)
+
+	pinnedStatuses, err := service.GetPinnedStatuses(userId, uuid.Nil)
+	assert.NoError(t, err)
+	assert.Len(t, pinnedStatuses, 1)
+	assert.Equal(t, pinned[2].Id, pinnedStatuses[0].Id)
+}
+
+func TestService_RestoreStatus(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	publisher := NewMockPublisher()
+	service := NewStatusService(repo, publisher, NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()})
+	assert.NoError(t, err)
+	reposter := uuid.New()
+	reposted, err := service.CreateRepost(context.Background(), status.Id, reposter)
+	assert.NoError(t, err)
+	_, err = service.DeleteStatus(context.Background(), status.Id, status.UserId)
+	assert.NoError(t, err)
+
+	// WHEN
+	_, notAuthorErr := service.RestoreStatus(context.Background(), status.Id, reposter)
+	restoredStatus, err := service.RestoreStatus(context.Background(), status.Id, status.UserId)
+	_, notDeletedErr := service.RestoreStatus(context.Background(), status.Id, status.UserId)
+
+	// THEN
+	assert.ErrorIs(t, notAuthorErr, NotAuthorError)
+	assert.NoError(t, err)
+	assert.Nil(t, restoredStatus.DeletedAt)
+	assert.ErrorIs(t, notDeletedErr, NotDeletedError)
+	assert.False(t, publisher.PublishCalled, "created events are published by the relay")
+	assert.Equal(t, 2, len(publisher.Reposted))
+	assert.Equal(t, reposted.Repost, publisher.Reposted[1].Repost)
+	assert.Equal(t, reposted.Repost, publisher.Unreposted[0].Repost)
+
+	gotStatus, err := service.GetStatus(status.Id, status.UserId)
+	assert.NoError(t, err)
+	assert.Equal(t, restoredStatus, gotStatus)
+
+	pending, err := repo.PendingEvents(10)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 1)
+	assert.Equal(t, restoredStatus, pending[0].Status)
+}
+
+func TestService_RestoreStatus_WindowExpired(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()})
+	assert.NoError(t, err)
+	_, err = repo.Tombstone(status.Id, time.Now().UTC().Add(-2*time.Hour))
+	assert.NoError(t, err)
+
+	// WHEN
+	_, err = service.RestoreStatus(context.Background(), status.Id, status.UserId)
+
+	// THEN
+	assert.ErrorIs(t, err, RestoreWindowExpiredError)
+}
+
+func TestService_CreateStatus_Invalid(t *testing.T) {
+	userId := uuid.New()
+	converted := media.Media{Id: uuid.New(), UserId: userId, Converted: true}
+	otherConverted := media.Media{Id: uuid.New(), UserId: userId, Converted: true}
+	converting := media.Media{Id: uuid.New(), UserId: userId}
+	foreign := media.Media{Id: uuid.New(), UserId: uuid.New(), Converted: true}
+	unknownId := uuid.New()
+
+	for _, test := range []struct {
+		name     string
+		content  string
+		mediaIds []uuid.UUID
+		expected internal.ValidationError
+	}{
+		{"empty content", " ", nil, internal.ValidationError{{Field: "content", Message: "must not be empty without media"}}},
+		{"too long content", "more than thirty characters long", nil, internal.ValidationError{{Field: "content", Message: "must be at most 30 characters long, is 32"}}},
+		{"too many media", "", []uuid.UUID{converted.Id, otherConverted.Id, converting.Id}, internal.ValidationError{
+			{Field: "mediaIds", Message: "must contain at most 2 media, contains 3"},
+			{Field: "mediaIds[2]", Message: "is still being converted"},
+		}},
+		{"invalid media", "media", []uuid.UUID{converted.Id, converted.Id, foreign.Id, unknownId}, internal.ValidationError{
+			{Field: "mediaIds", Message: "must contain at most 2 media, contains 4"},
+			{Field: "mediaIds[1]", Message: "is attached more than once"},
+			{Field: "mediaIds[2]", Message: "belongs to another user"},
+			{Field: "mediaIds[3]", Message: "does not exist"},
+		}},
+	} {
+		// GIVEN
+		repo := NewMockRepository()
+		service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(converted, otherConverted, converting, foreign), testStatusConfig)
+		status := statuses.Status{Id: uuid.New(), Content: test.content, UserId: userId, MediaIds: test.mediaIds}
+
+		// WHEN
+		_, err := service.CreateStatus(context.Background(), status)
+
+		// THEN
+		var validation internal.ValidationError
+		assert.ErrorAs(t, err, &validation, test.name)
+		assert.Equal(t, test.expected, validation, test.name)
+		assert.False(t, repo.CreateCalled, test.name)
+	}
+}
+
+func TestService_CreateStatus_Media(t *testing.T) {
+	// GIVEN
+	userId := uuid.New()
+	attachment := media.Media{Id: uuid.New(), UserId: userId, Converted: true}
+	service := NewStatusService(NewMockRepository(), NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(attachment), testStatusConfig)
+
+	// WHEN
+	createdStatus, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), UserId: userId, MediaIds: []uuid.UUID{attachment.Id}})
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{attachment.Id}, createdStatus.MediaIds)
+}
+
+func TestService_CreateStatus_Duplicate(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	userId := uuid.New()
+	original, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "hello", UserId: userId})
+	assert.NoError(t, err)
+
+	// WHEN
+	_, duplicateErr := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "hello", UserId: userId})
+	_, otherUserErr := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "hello", UserId: uuid.New()})
+
+	// THEN
+	var validation internal.ValidationError
+	assert.ErrorAs(t, duplicateErr, &validation)
+	assert.Equal(t, "content", validation[0].Field)
+	assert.Contains(t, validation[0].Message, original.Id.String())
+	assert.NoError(t, otherUserErr)
+}
+
+func TestService_UpdateStatus_Invalid(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New()})
+	assert.NoError(t, err)
+
+	// WHEN
+	_, err = service.UpdateStatus(context.Background(), status.Id, status.UserId, statuses.StatusEdit{Content: ""})
+
+	// THEN
+	var validation internal.ValidationError
+	assert.ErrorAs(t, err, &validation)
+	assert.False(t, repo.UpdateCalled)
+}
+
+func TestService_CreateStatus_TooLongSpoilerText(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	status := statuses.Status{Id: uuid.New(), Content: "spoiler", UserId: uuid.New(), SpoilerText: strings.Repeat("a", maxSpoilerTextLength+1)}
+
+	// WHEN
+	_, err := service.CreateStatus(context.Background(), status)
+
+	// THEN
+	var validation internal.ValidationError
+	assert.ErrorAs(t, err, &validation)
+	assert.Equal(t, "spoilerText", validation[0].Field)
+	assert.False(t, repo.CreateCalled)
+}
+
+func TestService_UpdateStatus_ContentWarning(t *testing.T) {
+	// GIVEN
+	service := NewStatusService(NewMockRepository(), NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "the butler did it", UserId: uuid.New(), SpoilerText: "crime novel spoilers"})
+	assert.NoError(t, err)
+
+	// WHEN
+	keptStatus, keptErr := service.UpdateStatus(context.Background(), status.Id, status.UserId, statuses.StatusEdit{Content: "the gardener did it", Sensitive: internal.Ptr(true)})
+	removedStatus, removedErr := service.UpdateStatus(context.Background(), status.Id, status.UserId, statuses.StatusEdit{Content: "the gardener did it", SpoilerText: internal.Ptr("")})
+
+	// THEN
+	assert.NoError(t, keptErr)
+	assert.Equal(t, "crime novel spoilers", keptStatus.SpoilerText)
+	assert.True(t, keptStatus.Sensitive)
+	assert.NoError(t, removedErr)
+	assert.Equal(t, "", removedStatus.SpoilerText)
+	assert.True(t, removedStatus.Sensitive)
+}
+
+func TestService_CreateStatus_Scheduled(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	publishAt := time.Now().Add(time.Hour).UTC().Truncate(time.Microsecond)
+	status := statuses.Status{Id: uuid.New(), Content: "later", UserId: uuid.New(), PublishAt: &publishAt}
+
+	// WHEN
+	createdStatus, err := service.CreateStatus(context.Background(), status)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, &publishAt, createdStatus.PublishAt)
+
+	pending, err := repo.PendingEvents(10)
+	assert.NoError(t, err)
+	assert.Empty(t, pending, "scheduled statuses have no created event until they are published")
+
+	_, err = service.GetStatus(status.Id, status.UserId)
+	assert.ErrorIs(t, err, internal.NotFoundError(status.Id))
+
+	_, err = service.CreateLike(context.Background(), status.Id, uuid.New())
+	assert.ErrorIs(t, err, internal.NotFoundError(status.Id))
+
+	scheduled, err := service.GetScheduledStatuses(status.UserId, status.UserId)
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Status{createdStatus}, scheduled)
+
+	_, err = service.GetScheduledStatuses(status.UserId, uuid.New())
+	assert.ErrorIs(t, err, NotOwnScheduleError)
+}
+
+func TestService_CreateStatus_ScheduledInPast(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	publishAt := time.Now().Add(-time.Minute)
+
+	// WHEN
+	_, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "earlier", UserId: uuid.New(), PublishAt: &publishAt})
+
+	// THEN
+	var validation internal.ValidationError
+	assert.ErrorAs(t, err, &validation)
+	assert.Equal(t, "publishAt", validation[0].Field)
+	assert.False(t, repo.CreateCalled)
+}
+
+func TestService_RescheduleStatus(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	publishAt := time.Now().Add(time.Hour)
+	status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "later", UserId: uuid.New(), PublishAt: &publishAt})
+	assert.NoError(t, err)
+	published, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "now", UserId: status.UserId})
+	assert.NoError(t, err)
+	rescheduleAt := time.Now().Add(2 * time.Hour).UTC().Truncate(time.Microsecond)
+
+	// WHEN
+	rescheduled, err := service.RescheduleStatus(context.Background(), status.Id, status.UserId, rescheduleAt)
+	_, notAuthorErr := service.RescheduleStatus(context.Background(), status.Id, uuid.New(), rescheduleAt)
+	_, pastErr := service.RescheduleStatus(context.Background(), status.Id, status.UserId, time.Now().Add(-time.Minute))
+	_, publishedErr := service.RescheduleStatus(context.Background(), published.Id, status.UserId, rescheduleAt)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, &rescheduleAt, rescheduled.PublishAt)
+	assert.ErrorIs(t, notAuthorErr, NotAuthorError)
+	var validation internal.ValidationError
+	assert.ErrorAs(t, pastErr, &validation)
+	assert.ErrorIs(t, publishedErr, NotScheduledError)
+}
+
+func TestService_CancelScheduledStatus(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	publisher := NewMockPublisher()
+	service := NewStatusService(repo, publisher, NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	publishAt := time.Now().Add(time.Hour)
+	status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "later", UserId: uuid.New(), PublishAt: &publishAt})
+	assert.NoError(t, err)
+
+	// WHEN
+	_, notAuthorErr := service.CancelScheduledStatus(context.Background(), status.Id, uuid.New())
+	cancelled, err := service.CancelScheduledStatus(context.Background(), status.Id, status.UserId)
+
+	// THEN
+	assert.ErrorIs(t, notAuthorErr, NotAuthorError)
+	assert.NoError(t, err)
+	assert.Equal(t, status.Id, cancelled.Id)
+	assert.Empty(t, publisher.Deleted, "nobody saw the status, so nobody has to be told it is gone")
+
+	_, err = repo.Get(status.Id)
+	assert.ErrorIs(t, err, internal.NotFoundError(status.Id))
+}
+
+func TestService_GetStatus_Visibility(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	followerService := NewMockFollowerService()
+	author, follower, mentioned, stranger := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	_, err := followerService.FollowUser(context.Background(), author, follower)
+	assert.NoError(t, err)
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(users.User{Id: mentioned, Name: "mentioned"}), followerService, NewMockMediaService(), testStatusConfig)
+
+	create := func(visibility statuses.Visibility) statuses.Status {
+		status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "hi @mentioned " + string(visibility), UserId: author, Visibility: visibility})
+		assert.NoError(t, err)
+		return status
+	}
+	public, followersOnly, mentionedOnly := create(statuses.Public), create(statuses.Followers), create(statuses.Mentioned)
+	defaulted := create("")
+	assert.Equal(t, statuses.Public, defaulted.Visibility)
+
+	for _, test := range []struct {
+		caller  uuid.UUID
+		status  statuses.Status
+		visible bool
+	}{
+		{uuid.Nil, public, true},
+		{uuid.Nil, followersOnly, false},
+		{uuid.Nil, mentionedOnly, false},
+		{stranger, followersOnly, false},
+		{follower, followersOnly, true},
+		{follower, mentionedOnly, false},
+		{mentioned, followersOnly, true},
+		{mentioned, mentionedOnly, true},
+		{author, mentionedOnly, true},
+	} {
+		// WHEN
+		_, err := service.GetStatus(test.status.Id, test.caller)
+
+		// THEN
+		if test.visible {
+			assert.NoError(t, err, "%s status for %s", test.status.Visibility, test.caller)
+		} else {
+			assert.ErrorIs(t, err, internal.NotFoundError(test.status.Id), "%s status for %s", test.status.Visibility, test.caller)
+		}
+	}
+
+	page, err := service.GetStatuses(author, follower, statuses.PageQuery{Limit: 10})
+	assert.NoError(t, err)
+	assert.Len(t, page.Statuses, 3)
+
+	page, err = service.GetStatuses(author, uuid.Nil, statuses.PageQuery{Limit: 10})
+	assert.NoError(t, err)
+	assert.Len(t, page.Statuses, 2)
+}
+
+func TestService_FollowersOnly_NonFollower(t *testing.T) {
+	// GIVEN
+	followerService := NewMockFollowerService()
+	author, follower, stranger := uuid.New(), uuid.New(), uuid.New()
+	_, err := followerService.FollowUser(context.Background(), author, follower)
+	assert.NoError(t, err)
+	service := NewStatusService(NewMockRepository(), NewMockPublisher(), NewMockUserService(), followerService, NewMockMediaService(), testStatusConfig)
+	status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "test status", UserId: author, Visibility: statuses.Followers})
+	assert.NoError(t, err)
+
+	// WHEN
+	_, historyErr := service.GetStatusHistory(status.Id, stranger)
+	_, contextErr := service.GetStatusContext(status.Id, stranger)
+	_, likesErr := service.GetLikes(status.Id, stranger, statuses.PageQuery{Limit: statuses.DefaultPageLimit})
+
+	// THEN
+	assert.ErrorIs(t, historyErr, internal.NotFoundError(status.Id))
+	assert.ErrorIs(t, contextErr, internal.NotFoundError(status.Id))
+	assert.ErrorIs(t, likesErr, internal.NotFoundError(status.Id))
+
+	_, err = service.GetStatusHistory(status.Id, follower)
+	assert.NoError(t, err)
+	_, err = service.GetStatusContext(status.Id, follower)
+	assert.NoError(t, err)
+	_, err = service.GetLikes(status.Id, follower, statuses.PageQuery{Limit: statuses.DefaultPageLimit})
+	assert.NoError(t, err)
+}
+
+func TestService_GetStatusContext_Visibility(t *testing.T) {
+	// GIVEN
+	followerService := NewMockFollowerService()
+	author, follower, stranger := uuid.New(), uuid.New(), uuid.New()
+	_, err := followerService.FollowUser(context.Background(), author, follower)
+	assert.NoError(t, err)
+	service := NewStatusService(NewMockRepository(), NewMockPublisher(), NewMockUserService(), followerService, NewMockMediaService(), testStatusConfig)
+	create := func(content string, visibility statuses.Visibility, inReplyToId *uuid.UUID) statuses.Status {
+		status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: content, UserId: author, Visibility: visibility, InReplyToId: inReplyToId})
+		assert.NoError(t, err)
+		return status
+	}
+	root := create("root", statuses.Public, nil)
+	followersOnly := create("followers only reply", statuses.Followers, &root.Id)
+	nestedReply := create("reply to followers only reply", statuses.Public, &followersOnly.Id)
+	publicReply := create("public reply", statuses.Public, &root.Id)
+
+	// WHEN
+	strangerContext, err := service.GetStatusContext(root.Id, stranger)
+	assert.NoError(t, err)
+	followerContext, err := service.GetStatusContext(root.Id, follower)
+	assert.NoError(t, err)
+	nestedContext, err := service.GetStatusContext(nestedReply.Id, stranger)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Thread{{Status: publicReply, Replies: []statuses.Thread{}}}, strangerContext.Descendants)
+	assert.ElementsMatch(t, []statuses.Thread{
+		{Status: followersOnly, Replies: []statuses.Thread{{Status: nestedReply, Replies: []statuses.Thread{}}}},
+		{Status: publicReply, Replies: []statuses.Thread{}},
+	}, followerContext.Descendants)
+	assert.Empty(t, nestedContext.Ancestors)
+}
+
+func TestService_CreateStatus_InvalidVisibility(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+
+	// WHEN
+	_, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "secret", UserId: uuid.New(), Visibility: "friends"})
+
+	// THEN
+	var validation internal.ValidationError
+	assert.ErrorAs(t, err, &validation)
+	assert.Equal(t, "visibility", validation[0].Field)
+	assert.False(t, repo.CreateCalled)
+}
+
+func TestService_CreateRepost_Restricted(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	followerService := NewMockFollowerService()
+	author, follower := uuid.New(), uuid.New()
+	_, err := followerService.FollowUser(context.Background(), author, follower)
+	assert.NoError(t, err)
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), followerService, NewMockMediaService(), testStatusConfig)
+	status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "followers only", UserId: author, Visibility: statuses.Followers})
+	assert.NoError(t, err)
+
+	// WHEN
+	_, followerErr := service.CreateRepost(context.Background(), status.Id, follower)
+	_, strangerErr := service.CreateRepost(context.Background(), status.Id, uuid.New())
+
+	// THEN
+	assert.ErrorIs(t, followerErr, NotRepostableError)
+	assert.ErrorIs(t, strangerErr, internal.NotFoundError(status.Id))
+}
+
+func TestService_CreateStatus_ReplyToInvisible(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	parent, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "followers only", UserId: uuid.New(), Visibility: statuses.Followers})
+	assert.NoError(t, err)
+
+	// WHEN
+	_, err = service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "reply", UserId: uuid.New(), InReplyToId: &parent.Id})
+
+	// THEN
+	assert.ErrorIs(t, err, ParentNotFoundError)
+}
+
+func TestService_CreateStatus_Poll(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	expiresAt := time.Now().Add(time.Hour)
+
+	// WHEN
+	status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "tabs or spaces?", UserId: uuid.New(),
+		Poll: &statuses.Poll{Options: []string{"tabs", "spaces"}, ExpiresAt: expiresAt}})
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tabs", "spaces"}, status.Poll.Options)
+	assert.Equal(t, []int{0, 0}, status.Poll.Votes)
+	assert.Equal(t, expiresAt.UTC().Truncate(time.Microsecond), status.Poll.ExpiresAt)
+}
+
+func TestService_CreateStatus_InvalidPoll(t *testing.T) {
+	publishAt := time.Now().Add(2 * time.Hour)
+	for _, test := range []struct {
+		name      string
+		poll      statuses.Poll
+		publishAt *time.Time
+		field     string
+	}{
+		{"one option", statuses.Poll{Options: []string{"yes"}, ExpiresAt: time.Now().Add(time.Hour)}, nil, "poll.options"},
+		{"five options", statuses.Poll{Options: []string{"a", "b", "c", "d", "e"}, ExpiresAt: time.Now().Add(time.Hour)}, nil, "poll.options"},
+		{"empty option", statuses.Poll{Options: []string{"yes", " "}, ExpiresAt: time.Now().Add(time.Hour)}, nil, "poll.options[1]"},
+		{"same option twice", statuses.Poll{Options: []string{"yes", "Yes"}, ExpiresAt: time.Now().Add(time.Hour)}, nil, "poll.options[1]"},
+		{"option too long", statuses.Poll{Options: []string{"yes", strings.Repeat("n", 51)}, ExpiresAt: time.Now().Add(time.Hour)}, nil, "poll.options[1]"},
+		{"expired", statuses.Poll{Options: []string{"yes", "no"}, ExpiresAt: time.Now().Add(-time.Minute)}, nil, "poll.expiresAt"},
+		{"expires before published", statuses.Poll{Options: []string{"yes", "no"}, ExpiresAt: time.Now().Add(time.Hour)}, &publishAt, "poll.expiresAt"},
+	} {
+		// GIVEN
+		repo := NewMockRepository()
+		service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+		poll := test.poll
+
+		// WHEN
+		_, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "poll", UserId: uuid.New(), Poll: &poll, PublishAt: test.publishAt})
+
+		// THEN
+		var validation internal.ValidationError
+		if assert.ErrorAs(t, err, &validation, test.name) {
+			assert.Equal(t, test.field, validation[0].Field, test.name)
+		}
+		assert.False(t, repo.CreateCalled, test.name)
+	}
+}
+
+func TestService_VotePoll(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	create := func(multiple bool) statuses.Status {
+		status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: fmt.Sprintf("poll %t", multiple), UserId: uuid.New(),
+			Poll: &statuses.Poll{Options: []string{"red", "green", "blue"}, Multiple: multiple, ExpiresAt: time.Now().Add(time.Hour)}})
+		assert.NoError(t, err)
+		return status
+	}
+	single, multiple := create(false), create(true)
+	withoutPoll, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "no poll", UserId: uuid.New()})
+	assert.NoError(t, err)
+	voter := uuid.New()
+
+	// WHEN
+	singlePoll, singleErr := service.VotePoll(context.Background(), single.Id, voter, []int{1})
+	_, againErr := service.VotePoll(context.Background(), single.Id, voter, []int{2})
+	multiplePoll, multipleErr := service.VotePoll(context.Background(), multiple.Id, voter, []int{0, 2})
+	_, noPollErr := service.VotePoll(context.Background(), withoutPoll.Id, voter, []int{0})
+
+	// THEN
+	assert.NoError(t, singleErr)
+	assert.Equal(t, []int{0, 1, 0}, singlePoll.Votes)
+	assert.Equal(t, 1, singlePoll.VoterCount)
+	assert.ErrorIs(t, againErr, AlreadyVotedError)
+	assert.NoError(t, multipleErr)
+	assert.Equal(t, []int{1, 0, 1}, multiplePoll.Votes)
+	assert.Equal(t, 1, multiplePoll.VoterCount)
+	assert.ErrorIs(t, noPollErr, NoPollError)
+
+	fetched, err := service.GetStatus(single.Id, uuid.Nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 0}, fetched.Poll.Votes)
+
+	for _, choices := range [][]int{{}, {0, 1}, {3}, {-1}} {
+		_, err = service.VotePoll(context.Background(), single.Id, uuid.New(), choices)
+		var validation internal.ValidationError
+		assert.ErrorAs(t, err, &validation, "choices %v", choices)
+	}
+	_, err = service.VotePoll(context.Background(), multiple.Id, uuid.New(), []int{1, 1})
+	var validation internal.ValidationError
+	assert.ErrorAs(t, err, &validation)
+}
+
+func TestService_VotePoll_Expired(t *testing.T) {
+	// GIVEN
+	repo := NewMockRepository()
+	service := NewStatusService(repo, NewMockPublisher(), NewMockUserService(), NewMockFollowerService(), NewMockMediaService(), testStatusConfig)
+	status, err := service.CreateStatus(context.Background(), statuses.Status{Id: uuid.New(), Content: "poll", UserId: uuid.New(),
+		Poll: &statuses.Poll{Options: []string{"yes", "no"}, ExpiresAt: time.Now().Add(time.Hour)}})
+	assert.NoError(t, err)
+	expired := repo.statuses[status.Id]
+	expired.Poll.ExpiresAt = time.Now().Add(-time.Second)
+	repo.statuses[status.Id] = expired
+
+	// WHEN
+	_, err = service.VotePoll(context.Background(), status.Id, uuid.New(), []int{0})
+
+	// THEN
+	assert.ErrorIs(t, err, PollExpiredError)
 }
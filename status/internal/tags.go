@@ -0,0 +1,38 @@
+package statuses
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// hashtagPattern matches a # that does not follow a letter, digit or another # (e.g. in urls or "C#"), up to the end of the word
+var hashtagPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_#&/])#([\p{L}\p{N}_]+)`)
+
+// ParseTags returns the normalized hashtags of content in order of first appearance, nil if there are none.
+// Hashtags consisting only of digits, like "#1", are not tags.
+func ParseTags(content string) []string {
+	var tags []string
+	for _, match := range hashtagPattern.FindAllStringSubmatch(content, -1) {
+		tag := NormalizeTag(match[1])
+		if isNumeric(tag) || containsTag(tags, tag) {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// NormalizeTag lower cases a tag and strips a leading #, so tags match regardless of how they were written
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimPrefix(tag, "#"))
+}
+
+func isNumeric(tag string) bool {
+	for _, r := range tag {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,24 @@
+package statuses
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseTags(t *testing.T) {
+	for _, test := range []struct {
+		content  string
+		expected []string
+	}{
+		{"no tags here", nil},
+		{"#hello world", []string{"hello"}},
+		{"Hello #World and #world again", []string{"world"}},
+		{"#Go, #golang! (#dapr)", []string{"go", "golang", "dapr"}},
+		{"snake #case_tag and #Ünïcode", []string{"case_tag", "ünïcode"}},
+		{"issue #1 is not a tag but #v2 is", []string{"v2"}},
+		{"mail me@example.com#anchor, C# or https://example.com/#section", nil},
+		{"##double", nil},
+	} {
+		assert.Equal(t, test.expected, ParseTags(test.content), test.content)
+	}
+}
@@ -0,0 +1,87 @@
+package statuses
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"time"
+	"yatc/internal"
+	statuses "yatc/status/pkg"
+)
+
+var NotDeletedError = errors.New("status is not deleted")
+var RestoreWindowExpiredError = errors.New("status was deleted too long ago to be restored")
+
+// purgerBatchSize bounds how many expired tombstones are purged per round
+const purgerBatchSize = 100
+
+// Tombstones holds deleted statuses until they are purged. A tombstoned status keeps its reposts, likes and
+// index entries, so it can be restored as it was. It stays in listings, the service hides it from readers.
+type Tombstones interface {
+	// Tombstone marks a published status as deleted at deletedAt, a status that already is a tombstone is not found
+	Tombstone(statusId uuid.UUID, deletedAt time.Time) (statuses.Status, error)
+	// Restore removes the tombstone of a status and stores its created event in the outbox again, as of restoredAt.
+	// It fails with NotDeletedError if there is none.
+	Restore(statusId uuid.UUID, restoredAt time.Time) (statuses.Status, error)
+	// ListExpired returns statuses deleted before deletedBefore, deleted first
+	ListExpired(deletedBefore time.Time, limit int) ([]statuses.Status, error)
+	// Purge deletes a status for good if it was deleted before deletedBefore. A status restored in the meantime
+	// fails with NotDeletedError.
+	Purge(statusId uuid.UUID, deletedBefore time.Time) (statuses.Status, error)
+}
+
+// Purger deletes tombstones for good once they can't be restored anymore. Several replicas can run a Purger,
+// a tombstone purged by one of them is skipped by the others.
+type Purger struct {
+	tombstones Tombstones
+	logger     *zap.Logger
+	interval   time.Duration
+	window     time.Duration
+}
+
+func NewPurger(tombstones Tombstones, logger *zap.Logger, interval time.Duration, window time.Duration) *Purger {
+	return &Purger{tombstones, logger, interval, window}
+}
+
+// Run purges expired tombstones every interval until ctx is done
+func (purger *Purger) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(purger.interval):
+		}
+
+		err := purger.PurgeExpired(time.Now().UTC().Truncate(time.Microsecond))
+		if err != nil {
+			purger.logger.Error("purging deleted statuses", zap.Error(err))
+		}
+	}
+}
+
+// PurgeExpired purges all statuses whose restore window ended at now
+func (purger *Purger) PurgeExpired(now time.Time) error {
+	deletedBefore := now.Add(-purger.window)
+	for {
+		expired, err := purger.tombstones.ListExpired(deletedBefore, purgerBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, status := range expired {
+			_, err = purger.tombstones.Purge(status.Id, deletedBefore)
+			// Another replica purged or the author restored it in the meantime
+			if errors.Is(err, NotDeletedError) || errors.Is(err, internal.NotFoundError(status.Id)) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(expired) < purgerBatchSize {
+			return nil
+		}
+	}
+}
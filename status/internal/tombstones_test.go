@@ -0,0 +1,84 @@
+package statuses
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+	"yatc/internal"
+	statuses "yatc/status/pkg"
+)
+
+func TestPurger_PurgeExpired(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	purger := NewPurger(repo, zap.NewNop(), time.Second, time.Hour)
+
+	now := time.Now().UTC()
+	userId := uuid.New()
+	expired := statuses.Status{Id: uuid.New(), Content: "expired", UserId: userId, CreatedAt: now.Add(-3 * time.Hour)}
+	restorable := statuses.Status{Id: uuid.New(), Content: "restorable", UserId: userId, CreatedAt: now.Add(-2 * time.Hour)}
+	kept := statuses.Status{Id: uuid.New(), Content: "kept", UserId: userId, CreatedAt: now.Add(-time.Hour)}
+	for _, status := range []statuses.Status{expired, restorable, kept} {
+		_, err := repo.Create(status)
+		assert.NoError(t, err)
+	}
+	_, err := repo.Tombstone(expired.Id, now.Add(-2*time.Hour))
+	assert.NoError(t, err)
+	_, err = repo.Tombstone(restorable.Id, now.Add(-time.Minute))
+	assert.NoError(t, err)
+
+	// WHEN
+	err = purger.PurgeExpired(now)
+
+	// THEN
+	assert.NoError(t, err)
+
+	_, err = repo.Get(expired.Id)
+	assert.ErrorIs(t, err, internal.NotFoundError(expired.Id))
+
+	tombstone, err := repo.Get(restorable.Id)
+	assert.NoError(t, err)
+	assert.NotNil(t, tombstone.DeletedAt)
+
+	page, err := repo.ListByUser(userId, statuses.PageQuery{Limit: 10})
+	assert.NoError(t, err)
+	assert.Len(t, page.Statuses, 2)
+}
+
+func TestInMemoryRepo_Tombstones(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	now := time.Now().UTC()
+	status := statuses.Status{Id: uuid.New(), Content: "test status", UserId: uuid.New(), CreatedAt: now}
+	_, err := repo.Create(status)
+	assert.NoError(t, err)
+
+	// WHEN
+	tombstone, tombstoneErr := repo.Tombstone(status.Id, now)
+	_, twiceErr := repo.Tombstone(status.Id, now)
+	expired, listErr := repo.ListExpired(now.Add(time.Second), 10)
+	_, purgeErr := repo.Purge(status.Id, now)
+	restored, restoreErr := repo.Restore(status.Id, now.Add(time.Second))
+	_, notDeletedErr := repo.Restore(status.Id, now.Add(time.Second))
+
+	// THEN
+	assert.NoError(t, tombstoneErr)
+	assert.Equal(t, &now, tombstone.DeletedAt)
+	assert.ErrorIs(t, twiceErr, internal.NotFoundError(status.Id))
+	assert.NoError(t, listErr)
+	assert.Equal(t, []statuses.Status{tombstone}, expired)
+	assert.ErrorIs(t, purgeErr, NotDeletedError)
+	assert.NoError(t, restoreErr)
+	assert.Equal(t, status, restored)
+	assert.ErrorIs(t, notDeletedErr, NotDeletedError)
+
+	expired, err = repo.ListExpired(now.Add(time.Second), 10)
+	assert.NoError(t, err)
+	assert.Empty(t, expired)
+
+	pending, err := repo.PendingEvents(10)
+	assert.NoError(t, err)
+	assert.Equal(t, []OutboxEntry{{Status: restored, CreatedAt: now.Add(time.Second)}}, pending)
+}
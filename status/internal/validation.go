@@ -0,0 +1,117 @@
+package statuses
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"strings"
+	"unicode/utf8"
+	"yatc/internal"
+	"yatc/status/pkg"
+)
+
+const maxSpoilerTextLength = 100
+
+// validate checks the content, content warning and attachments of a status of userId against the configured limits.
+// All violations are returned together as an internal.ValidationError.
+func (statusService *Service) validate(userId uuid.UUID, content string, spoilerText string, mediaIds []uuid.UUID) error {
+	var validation internal.ValidationError
+
+	length := utf8.RuneCountInString(content)
+	if strings.TrimSpace(content) == "" && len(mediaIds) == 0 {
+		validation = append(validation, internal.FieldError{Field: "content", Message: "must not be empty without media"})
+	} else if length > statusService.config.MaxContentLength {
+		validation = append(validation, internal.FieldError{Field: "content", Message: fmt.Sprintf("must be at most %d characters long, is %d", statusService.config.MaxContentLength, length)})
+	}
+
+	spoilerLength := utf8.RuneCountInString(spoilerText)
+	if spoilerLength > maxSpoilerTextLength {
+		validation = append(validation, internal.FieldError{Field: "spoilerText", Message: fmt.Sprintf("must be at most %d characters long, is %d", maxSpoilerTextLength, spoilerLength)})
+	}
+
+	if len(mediaIds) > statusService.config.MaxMediaCount {
+		validation = append(validation, internal.FieldError{Field: "mediaIds", Message: fmt.Sprintf("must contain at most %d media, contains %d", statusService.config.MaxMediaCount, len(mediaIds))})
+	}
+
+	attached := internal.NewSet[uuid.UUID]()
+	for i, mediaId := range mediaIds {
+		field := fmt.Sprintf("mediaIds[%d]", i)
+		if attached.Has(mediaId) {
+			validation = append(validation, internal.FieldError{Field: field, Message: "is attached more than once"})
+			continue
+		}
+		attached.Add(mediaId)
+
+		message, err := statusService.checkMedia(userId, mediaId)
+		if err != nil {
+			return err
+		}
+		if message != "" {
+			validation = append(validation, internal.FieldError{Field: field, Message: message})
+		}
+	}
+
+	if len(validation) > 0 {
+		return validation
+	}
+	return nil
+}
+
+// checkMedia returns why the media can't be attached to a status of userId, or an empty message if it can
+func (statusService *Service) checkMedia(userId uuid.UUID, mediaId uuid.UUID) (string, error) {
+	attachment, err := statusService.mediaService.GetMedia(mediaId)
+	if err != nil {
+		if errors.Is(err, internal.NotFoundError(mediaId)) {
+			return "does not exist", nil
+		}
+		return "", err
+	}
+
+	if attachment.UserId != userId {
+		return "belongs to another user", nil
+	}
+	if !attachment.Converted {
+		return "is still being converted", nil
+	}
+	return "", nil
+}
+
+// checkDuplicate rejects content the user already posted within the configured window
+func (statusService *Service) checkDuplicate(status statuses.Status) error {
+	if statusService.config.DuplicateWindow <= 0 {
+		return nil
+	}
+
+	since := status.CreatedAt.Add(-statusService.config.DuplicateWindow)
+	query := statuses.PageQuery{Limit: statuses.MaxPageLimit, Since: &since}
+	for {
+		page, err := statusService.repo.ListByUser(status.UserId, query)
+		if err != nil {
+			return err
+		}
+
+		// Deleted statuses don't count, their content can be posted again right away
+		for _, recent := range page.Statuses {
+			if recent.DeletedAt == nil && recent.Content == status.Content && equalMedia(recent.MediaIds, status.MediaIds) {
+				return internal.ValidationError{{Field: "content", Message: fmt.Sprintf("duplicates status %s posted within the last %s", recent.Id, statusService.config.DuplicateWindow)}}
+			}
+		}
+
+		if page.Next == nil {
+			return nil
+		}
+		query.Cursor = page.Next
+	}
+}
+
+func equalMedia(a []uuid.UUID, b []uuid.UUID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,79 @@
+package statuses
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"yatc/internal"
+	"yatc/status/pkg"
+)
+
+var NotRepostableError = errors.New("only public statuses can be reposted")
+var invalidVisibilityError = internal.ValidationError{{Field: "visibility", Message: "must be one of public, followers, mentioned"}}
+
+func validVisibility(visibility statuses.Visibility) bool {
+	switch visibility {
+	case statuses.Public, statuses.Followers, statuses.Mentioned:
+		return true
+	}
+	return false
+}
+
+// getVisible returns a published status if callerId can see it. Statuses the caller can't see are not found,
+// so their existence isn't revealed.
+func (statusService *Service) getVisible(statusId uuid.UUID, callerId uuid.UUID) (statuses.Status, error) {
+	status, err := statusService.getPublished(statusId)
+	if err != nil {
+		return statuses.Status{}, err
+	}
+
+	visible, err := statusService.visibleTo(callerId, []statuses.Status{status})
+	if err != nil {
+		return statuses.Status{}, err
+	}
+	if len(visible) == 0 {
+		return statuses.Status{}, internal.NotFoundError(statusId)
+	}
+	return status, nil
+}
+
+// visibleTo keeps the statuses callerId can see. Who the caller follows is only looked up for followers-only statuses.
+func (statusService *Service) visibleTo(callerId uuid.UUID, all []statuses.Status) ([]statuses.Status, error) {
+	var followees *internal.Set[uuid.UUID]
+	visible := make([]statuses.Status, 0, len(all))
+	for _, status := range all {
+		following := false
+		if status.Visibility == statuses.Followers && callerId != uuid.Nil && callerId != status.UserId {
+			if followees == nil {
+				followed, err := statusService.followeesOf(callerId)
+				if err != nil {
+					return nil, err
+				}
+				followees = &followed
+			}
+			following = followees.Has(status.UserId)
+		}
+
+		if status.VisibleTo(callerId, following) {
+			visible = append(visible, status)
+		}
+	}
+	return visible, nil
+}
+
+// followeesOf returns the users userId follows, unknown users follow nobody
+func (statusService *Service) followeesOf(userId uuid.UUID) (internal.Set[uuid.UUID], error) {
+	followees := internal.NewSet[uuid.UUID]()
+	users, err := statusService.followerService.GetFollowees(context.Background(), userId)
+	if err != nil {
+		if errors.Is(err, internal.NotFoundError(userId)) {
+			return followees, nil
+		}
+		return followees, err
+	}
+
+	for _, user := range users {
+		followees.Add(user.Id)
+	}
+	return followees, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/go-chi/render"
 	"github.com/google/uuid"
 	"net/http"
+	"time"
 	"yatc/internal"
 )
 
@@ -32,17 +33,63 @@ func NewStatusClient(config internal.DaprConfig) *StatusClient {
 	return &StatusClient{httpClient}
 }
 
-func (client *StatusClient) GetStatuses(userId uuid.UUID) ([]Status, error) {
-	panic("implement me")
+func (client *StatusClient) GetStatuses(userId uuid.UUID, callerId uuid.UUID, query PageQuery) (StatusPage, error) {
+	params := &GetStatusesParams{Limit: &query.Limit, Since: query.Since, Until: query.Until}
+	if query.Cursor != nil {
+		cursor := query.Cursor.String()
+		params.Cursor = &cursor
+	}
+	if callerId != uuid.Nil {
+		params.XUser = &callerId
+	}
+
+	response, err := client.httpClient.GetStatuses(context.Background(), userId, params)
+	clientError := internal.ToClientError(response, err)
+	if clientError != nil {
+		return StatusPage{}, clientError
+	}
+
+	var statusesResponse StatusesResponse
+	err = render.DecodeJSON(response.Body, &statusesResponse)
+	if err != nil {
+		return StatusPage{}, err
+	}
+
+	page := StatusPage{Statuses: make([]Status, len(statusesResponse.Statuses))}
+	for i, statusResponse := range statusesResponse.Statuses {
+		page.Statuses[i] = StatusFromStatusResponse(statusResponse)
+	}
+	if statusesResponse.Next != nil {
+		next, err := ParseCursor(*statusesResponse.Next)
+		if err != nil {
+			return StatusPage{}, err
+		}
+		page.Next = &next
+	}
+	return page, nil
 }
 
-func (client *StatusClient) GetStatus(statusId uuid.UUID) (Status, error) {
-	panic("implement me")
+func (client *StatusClient) GetPinnedStatuses(userId uuid.UUID, callerId uuid.UUID) ([]Status, error) {
+	return nil, internal.NotImplementedError
+}
+
+func (client *StatusClient) GetStatus(statusId uuid.UUID, callerId uuid.UUID) (Status, error) {
+	return Status{}, internal.NotImplementedError
 }
 
 func (client *StatusClient) CreateStatus(ctx context.Context, status Status) (Status, error) {
 	body := CreateStatusRequest{
-		Content: status.Content,
+		Content:     status.Content,
+		InReplyToId: status.InReplyToId,
+		PublishAt:   status.PublishAt,
+		SpoilerText: &status.SpoilerText,
+		Sensitive:   &status.Sensitive,
+	}
+	if status.Visibility != "" {
+		body.Visibility = &status.Visibility
+	}
+	if status.Poll != nil {
+		body.Poll = &CreatePollRequest{Options: status.Poll.Options, Multiple: &status.Poll.Multiple, ExpiresAt: status.Poll.ExpiresAt}
 	}
 
 	response, err := client.httpClient.CreateStatus(ctx, &CreateStatusParams{XUser: status.UserId}, body)
@@ -57,9 +104,77 @@ func (client *StatusClient) CreateStatus(ctx context.Context, status Status) (St
 		return Status{}, err
 	}
 
-	return Status{Id: statusResponse.Id, Content: statusResponse.Content}, nil
+	return StatusFromStatusResponse(statusResponse), nil
+}
+
+func (client *StatusClient) DeleteStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (Status, error) {
+	return Status{}, internal.NotImplementedError
+}
+
+func (client *StatusClient) GetStatusHistory(statusId uuid.UUID, callerId uuid.UUID) ([]Revision, error) {
+	return nil, internal.NotImplementedError
+}
+
+func (client *StatusClient) UpdateStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, edit StatusEdit) (Status, error) {
+	return Status{}, internal.NotImplementedError
+}
+
+func (client *StatusClient) GetStatusContext(statusId uuid.UUID, callerId uuid.UUID) (StatusContext, error) {
+	return StatusContext{}, internal.NotImplementedError
+}
+
+func (client *StatusClient) CreateRepost(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (Status, error) {
+	return Status{}, internal.NotImplementedError
+}
+
+func (client *StatusClient) DeleteRepost(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (Status, error) {
+	return Status{}, internal.NotImplementedError
+}
+
+func (client *StatusClient) GetLikes(statusId uuid.UUID, callerId uuid.UUID, query PageQuery) (LikePage, error) {
+	return LikePage{}, internal.NotImplementedError
+}
+
+func (client *StatusClient) GetLikedStatuses(userId uuid.UUID, query PageQuery) (StatusPage, error) {
+	return StatusPage{}, internal.NotImplementedError
+}
+
+func (client *StatusClient) CreateLike(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (Status, error) {
+	return Status{}, internal.NotImplementedError
+}
+
+func (client *StatusClient) DeleteLike(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (Status, error) {
+	return Status{}, internal.NotImplementedError
+}
+
+func (client *StatusClient) GetScheduledStatuses(userId uuid.UUID, callerId uuid.UUID) ([]Status, error) {
+	return nil, internal.NotImplementedError
+}
+
+func (client *StatusClient) RescheduleStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, publishAt time.Time) (Status, error) {
+	return Status{}, internal.NotImplementedError
+}
+
+func (client *StatusClient) CancelScheduledStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (Status, error) {
+	return Status{}, internal.NotImplementedError
+}
+
+func (client *StatusClient) RestoreStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (Status, error) {
+	return Status{}, internal.NotImplementedError
+}
+
+func (client *StatusClient) PinStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, callerId uuid.UUID) (Status, error) {
+	return Status{}, internal.NotImplementedError
+}
+
+func (client *StatusClient) UnpinStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, callerId uuid.UUID) (Status, error) {
+	return Status{}, internal.NotImplementedError
+}
+
+func (client *StatusClient) GetTaggedStatuses(tag string, query PageQuery) (StatusPage, error) {
+	return StatusPage{}, internal.NotImplementedError
 }
 
-func (client *StatusClient) DeleteStatus(statusId uuid.UUID) (Status, error) {
-	panic("implement me")
+func (client *StatusClient) VotePoll(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, choices []int) (Poll, error) {
+	return Poll{}, internal.NotImplementedError
 }
@@ -0,0 +1,115 @@
+package statuses
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+var InvalidCursorError = errors.New("invalid cursor")
+
+// Cursor points at the last entry of a page. Pages are ordered newest first, ties are broken by id.
+type Cursor struct {
+	Time time.Time
+	Id   uuid.UUID
+}
+
+func (cursor Cursor) String() string {
+	raw := fmt.Sprintf("%d_%s", cursor.Time.UnixNano(), cursor.Id.String())
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func ParseCursor(value string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return Cursor{}, InvalidCursorError
+	}
+
+	nanos, id, found := strings.Cut(string(raw), "_")
+	if !found {
+		return Cursor{}, InvalidCursorError
+	}
+
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return Cursor{}, InvalidCursorError
+	}
+
+	statusId, err := uuid.Parse(id)
+	if err != nil {
+		return Cursor{}, InvalidCursorError
+	}
+
+	return Cursor{Time: time.Unix(0, unixNano).UTC(), Id: statusId}, nil
+}
+
+// Before reports whether a status at the given time and id comes after the cursor in newest first order.
+func (cursor Cursor) Before(t time.Time, id uuid.UUID) bool {
+	if t.Equal(cursor.Time) {
+		return bytes.Compare(id[:], cursor.Id[:]) < 0
+	}
+	return t.Before(cursor.Time)
+}
+
+// SortNewestFirst sorts statuses by creation time, newest first, in the same order pages are returned in.
+// Reposted statuses are sorted by the time of the repost.
+func SortNewestFirst(all []Status) {
+	sort.Slice(all, func(i, j int) bool {
+		return CursorOf(all[i]).Before(postedAt(all[j]), all[j].Id)
+	})
+}
+
+// CursorOf returns the cursor pointing at a status, reposted statuses are pointed at by the time of the repost
+func CursorOf(status Status) Cursor {
+	return Cursor{Time: postedAt(status), Id: status.Id}
+}
+
+func postedAt(status Status) time.Time {
+	if status.Repost != nil {
+		return status.Repost.CreatedAt
+	}
+	return status.CreatedAt
+}
+
+type PageQuery struct {
+	Limit  int
+	Cursor *Cursor
+	Since  *time.Time
+	Until  *time.Time
+}
+
+// Matches reports whether a status at the given time and id belongs to the page described by the query, ignoring the limit.
+func (query PageQuery) Matches(t time.Time, id uuid.UUID) bool {
+	if query.Cursor != nil && !query.Cursor.Before(t, id) {
+		return false
+	}
+	if query.Since != nil && t.Before(*query.Since) {
+		return false
+	}
+	if query.Until != nil && !t.Before(*query.Until) {
+		return false
+	}
+	return true
+}
+
+type StatusPage struct {
+	Statuses []Status
+	Next     *Cursor
+}
+
+// LikePage is a page of likes of a status, the cursor points at the user of the last like
+type LikePage struct {
+	Likes []Like
+	Next  *Cursor
+}
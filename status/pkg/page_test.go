@@ -0,0 +1,56 @@
+package statuses
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	// Given
+	cursor := Cursor{Time: time.Now().UTC(), Id: uuid.New()}
+
+	// When
+	parsed, err := ParseCursor(cursor.String())
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, cursor.Id, parsed.Id)
+	assert.True(t, cursor.Time.Equal(parsed.Time))
+}
+
+func TestParseCursor_Invalid(t *testing.T) {
+	for _, value := range []string{"", "not a cursor", Cursor{}.String()[:4]} {
+		_, err := ParseCursor(value)
+		assert.ErrorIs(t, err, InvalidCursorError, value)
+	}
+}
+
+func TestPageQuery_Matches(t *testing.T) {
+	// Given
+	now := time.Now().UTC()
+	cursor := Cursor{Time: now, Id: uuid.New()}
+	query := PageQuery{Cursor: &cursor}
+
+	// Then
+	assert.True(t, query.Matches(now.Add(-time.Second), uuid.New()))
+	assert.False(t, query.Matches(now.Add(time.Second), uuid.New()))
+	assert.False(t, query.Matches(now, cursor.Id))
+}
+
+func TestSortNewestFirst_Reposts(t *testing.T) {
+	// Given
+	now := time.Now().UTC()
+	older := Status{Id: uuid.New(), CreatedAt: now.Add(-2 * time.Hour)}
+	newer := Status{Id: uuid.New(), CreatedAt: now.Add(-time.Hour)}
+	reposted := older
+	reposted.Repost = &Repost{StatusId: older.Id, UserId: uuid.New(), CreatedAt: now}
+	all := []Status{older, newer, reposted}
+
+	// When
+	SortNewestFirst(all)
+
+	// Then
+	assert.Equal(t, []Status{reposted, newer, older}, all)
+}
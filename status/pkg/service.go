@@ -3,18 +3,163 @@ package statuses
 import (
 	"context"
 	"github.com/google/uuid"
+	"time"
 )
 
 type Status struct {
-	Id       uuid.UUID `db:"id"`
-	Content  string    `db:"content"`
-	UserId   uuid.UUID `db:"user_id"`
-	MediaIds []uuid.UUID
+	Id        uuid.UUID   `db:"id"`
+	Content   string      `db:"content"`
+	UserId    uuid.UUID   `db:"user_id"`
+	MediaIds  []uuid.UUID `db:"-"`
+	CreatedAt time.Time   `db:"created_at"`
+	UpdatedAt time.Time   `db:"updated_at"`
+	EditedAt  *time.Time  `db:"edited_at"`
+	// InReplyToId is nil for statuses starting a conversation
+	InReplyToId *uuid.UUID `db:"in_reply_to_id"`
+	RepostCount int        `db:"repost_count"`
+	LikeCount   int        `db:"like_count"`
+	// Tags are the normalized hashtags of the content, without the leading #
+	Tags []string `db:"-"`
+	// Mentions are the @username mentions of the content that belong to a user
+	Mentions []Mention `db:"-"`
+	// Repost is only set on copies of the status that show up because they were reposted
+	Repost *Repost `db:"-"`
+	// PublishAt is only set while the status is scheduled, it is published once that time passed
+	PublishAt *time.Time `db:"publish_at"`
+	// Visibility is empty for statuses created before it existed, those are public
+	Visibility Visibility `db:"visibility"`
+	Poll       *Poll      `db:"-"`
+	// SpoilerText is a content warning shown instead of the content until the reader expands the status
+	SpoilerText string `db:"spoiler_text"`
+	// Sensitive marks the attached media as not safe to show without the reader asking for it
+	Sensitive bool `db:"sensitive"`
+	// DeletedAt is set while a deleted status can still be restored, such a tombstone is hidden from everyone
+	DeletedAt *time.Time `db:"deleted_at"`
+	// PinnedAt is set while the author has the status pinned to their profile
+	PinnedAt *time.Time `db:"pinned_at"`
+}
+
+// Poll is attached to a status when it is created and can't be changed afterwards.
+// Votes holds the number of votes of each option.
+type Poll struct {
+	Options    []string
+	Multiple   bool
+	ExpiresAt  time.Time
+	Votes      []int
+	VoterCount int
+}
+
+// Expired reports whether the poll stopped taking votes at now, its tallies are final then
+func (poll Poll) Expired(now time.Time) bool {
+	return !now.Before(poll.ExpiresAt)
+}
+
+// PollVote is the vote of a user in the poll of a status, Choices are indexes of the options
+type PollVote struct {
+	StatusId  uuid.UUID
+	UserId    uuid.UUID
+	Choices   []int
+	CreatedAt time.Time
+}
+
+// VisibleTo reports whether a user can see the status, following tells whether the user follows its author.
+// The author and mentioned users can always see it, uuid.Nil stands for anonymous callers. Deleted statuses are seen by nobody.
+func (status Status) VisibleTo(userId uuid.UUID, following bool) bool {
+	if status.DeletedAt != nil {
+		return false
+	}
+	if status.Visibility == Public || status.Visibility == "" {
+		return true
+	}
+	if userId == uuid.Nil {
+		return false
+	}
+	if userId == status.UserId {
+		return true
+	}
+	for _, mention := range status.Mentions {
+		if mention.UserId == userId {
+			return true
+		}
+	}
+	return status.Visibility == Followers && following
+}
+
+type Repost struct {
+	StatusId  uuid.UUID `db:"status_id"`
+	UserId    uuid.UUID `db:"user_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// Mention is a mentioned user, Start and End are offsets in characters of the mention including the @
+type Mention struct {
+	UserId   uuid.UUID
+	Username string
+	Start    int
+	End      int
+}
+
+// MentionEvent is published once for every user mentioned in a status
+type MentionEvent struct {
+	UserId uuid.UUID
+	Status Status
+}
+
+type Like struct {
+	StatusId  uuid.UUID `db:"status_id"`
+	UserId    uuid.UUID `db:"user_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// Revision is a previous version of an edited status
+type Revision struct {
+	StatusId  uuid.UUID   `db:"status_id"`
+	Content   string      `db:"content"`
+	MediaIds  []uuid.UUID `db:"-"`
+	CreatedAt time.Time   `db:"created_at"`
+}
+
+// StatusEdit holds the changes of an edit, MediaIds, SpoilerText and Sensitive are left untouched if nil
+type StatusEdit struct {
+	Content     string
+	MediaIds    *[]uuid.UUID
+	SpoilerText *string
+	Sensitive   *bool
+}
+
+// Thread is a status with the tree of its replies
+type Thread struct {
+	Status  Status
+	Replies []Thread
+}
+
+// StatusContext is the conversation around a status, ancestors start with the root of the conversation
+type StatusContext struct {
+	Ancestors   []Status
+	Descendants []Thread
 }
 
 type Service interface {
-	GetStatuses(userId uuid.UUID) ([]Status, error)
-	GetStatus(statusId uuid.UUID) (Status, error)
+	GetStatuses(userId uuid.UUID, callerId uuid.UUID, query PageQuery) (StatusPage, error)
+	GetPinnedStatuses(userId uuid.UUID, callerId uuid.UUID) ([]Status, error)
+	GetTaggedStatuses(tag string, query PageQuery) (StatusPage, error)
+	GetStatus(statusId uuid.UUID, callerId uuid.UUID) (Status, error)
+	GetStatusHistory(statusId uuid.UUID, callerId uuid.UUID) ([]Revision, error)
+	GetStatusContext(statusId uuid.UUID, callerId uuid.UUID) (StatusContext, error)
 	CreateStatus(ctx context.Context, status Status) (Status, error)
-	DeleteStatus(statusId uuid.UUID) (Status, error)
+	UpdateStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, edit StatusEdit) (Status, error)
+	CreateRepost(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (Status, error)
+	DeleteRepost(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (Status, error)
+	GetLikes(statusId uuid.UUID, callerId uuid.UUID, query PageQuery) (LikePage, error)
+	GetLikedStatuses(userId uuid.UUID, query PageQuery) (StatusPage, error)
+	CreateLike(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (Status, error)
+	DeleteLike(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (Status, error)
+	DeleteStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (Status, error)
+	GetScheduledStatuses(userId uuid.UUID, callerId uuid.UUID) ([]Status, error)
+	RescheduleStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, publishAt time.Time) (Status, error)
+	CancelScheduledStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (Status, error)
+	VotePoll(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, choices []int) (Poll, error)
+	RestoreStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID) (Status, error)
+	PinStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, callerId uuid.UUID) (Status, error)
+	UnpinStatus(ctx context.Context, statusId uuid.UUID, userId uuid.UUID, callerId uuid.UUID) (Status, error)
 }
@@ -1,10 +1,171 @@
 package statuses
 
+import (
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	"time"
+)
+
 func StatusResponseFromStatus(status Status) StatusResponse {
+	tags := make([]string, 0)
+	if status.Tags != nil {
+		tags = status.Tags
+	}
+
+	mentions := make([]MentionResponse, len(status.Mentions))
+	for i, mention := range status.Mentions {
+		mentions[i] = MentionResponse{UserId: mention.UserId, Username: mention.Username, Start: mention.Start, End: mention.End}
+	}
+
+	visibility := status.Visibility
+	if visibility == "" {
+		visibility = Public
+	}
+
 	return StatusResponse{
-		Content:  status.Content,
-		Id:       status.Id,
-		UserId:   status.UserId,
-		MediaIds: &status.MediaIds,
+		Content:     status.Content,
+		Id:          status.Id,
+		UserId:      status.UserId,
+		MediaIds:    &status.MediaIds,
+		CreatedAt:   status.CreatedAt,
+		UpdatedAt:   status.UpdatedAt,
+		EditedAt:    status.EditedAt,
+		InReplyToId: status.InReplyToId,
+		RepostCount: status.RepostCount,
+		LikeCount:   status.LikeCount,
+		Tags:        tags,
+		Mentions:    mentions,
+		Repost:      statusRepostResponseFromRepost(status.Repost),
+		PublishAt:   status.PublishAt,
+		Visibility:  visibility,
+		Poll:        pollResponseFromPoll(status.Poll),
+		SpoilerText: status.SpoilerText,
+		Sensitive:   status.Sensitive,
+		DeletedAt:   status.DeletedAt,
+		Pinned:      status.PinnedAt != nil,
+	}
+}
+
+func pollResponseFromPoll(poll *Poll) *PollResponse {
+	if poll == nil {
+		return nil
+	}
+	response := PollResponseFromPoll(*poll)
+	return &response
+}
+
+func PollResponseFromPoll(poll Poll) PollResponse {
+	options := make([]PollOptionResponse, len(poll.Options))
+	for i, option := range poll.Options {
+		options[i] = PollOptionResponse{Title: option}
+		if i < len(poll.Votes) {
+			options[i].Votes = poll.Votes[i]
+		}
+	}
+
+	return PollResponse{
+		Options:     options,
+		Multiple:    poll.Multiple,
+		ExpiresAt:   poll.ExpiresAt,
+		Expired:     poll.Expired(time.Now()),
+		VotersCount: poll.VoterCount,
+	}
+}
+
+func statusRepostResponseFromRepost(repost *Repost) *StatusRepostResponse {
+	if repost == nil {
+		return nil
+	}
+	return &StatusRepostResponse{UserId: repost.UserId, CreatedAt: repost.CreatedAt}
+}
+
+func StatusFromStatusResponse(response StatusResponse) Status {
+	mediaIds := make([]openapi_types.UUID, 0)
+	if response.MediaIds != nil {
+		mediaIds = *response.MediaIds
+	}
+
+	var repost *Repost
+	if response.Repost != nil {
+		repost = &Repost{StatusId: response.Id, UserId: response.Repost.UserId, CreatedAt: response.Repost.CreatedAt}
+	}
+
+	var mentions []Mention
+	for _, mention := range response.Mentions {
+		mentions = append(mentions, Mention{UserId: mention.UserId, Username: mention.Username, Start: mention.Start, End: mention.End})
+	}
+
+	var poll *Poll
+	if response.Poll != nil {
+		poll = &Poll{Multiple: response.Poll.Multiple, ExpiresAt: response.Poll.ExpiresAt, VoterCount: response.Poll.VotersCount}
+		for _, option := range response.Poll.Options {
+			poll.Options = append(poll.Options, option.Title)
+			poll.Votes = append(poll.Votes, option.Votes)
+		}
+	}
+
+	return Status{
+		Id:          response.Id,
+		Content:     response.Content,
+		UserId:      response.UserId,
+		MediaIds:    mediaIds,
+		CreatedAt:   response.CreatedAt,
+		UpdatedAt:   response.UpdatedAt,
+		EditedAt:    response.EditedAt,
+		InReplyToId: response.InReplyToId,
+		RepostCount: response.RepostCount,
+		LikeCount:   response.LikeCount,
+		Tags:        response.Tags,
+		Mentions:    mentions,
+		Repost:      repost,
+		PublishAt:   response.PublishAt,
+		Visibility:  response.Visibility,
+		Poll:        poll,
+		SpoilerText: response.SpoilerText,
+		Sensitive:   response.Sensitive,
+		DeletedAt:   response.DeletedAt,
+	}
+}
+
+func StatusThreadResponseFromThread(thread Thread) StatusThreadResponse {
+	replies := make([]StatusThreadResponse, len(thread.Replies))
+	for i, reply := range thread.Replies {
+		replies[i] = StatusThreadResponseFromThread(reply)
+	}
+
+	return StatusThreadResponse{
+		Status:  StatusResponseFromStatus(thread.Status),
+		Replies: replies,
+	}
+}
+
+func StatusContextResponseFromStatusContext(statusContext StatusContext) StatusContextResponse {
+	ancestors := make([]StatusResponse, len(statusContext.Ancestors))
+	for i, ancestor := range statusContext.Ancestors {
+		ancestors[i] = StatusResponseFromStatus(ancestor)
+	}
+
+	descendants := make([]StatusThreadResponse, len(statusContext.Descendants))
+	for i, thread := range statusContext.Descendants {
+		descendants[i] = StatusThreadResponseFromThread(thread)
+	}
+
+	return StatusContextResponse{
+		Ancestors:   ancestors,
+		Descendants: descendants,
+	}
+}
+
+func LikeResponseFromLike(like Like) LikeResponse {
+	return LikeResponse{
+		UserId:    like.UserId,
+		CreatedAt: like.CreatedAt,
+	}
+}
+
+func StatusRevisionResponseFromRevision(revision Revision) StatusRevisionResponse {
+	return StatusRevisionResponse{
+		Content:   revision.Content,
+		MediaIds:  &revision.MediaIds,
+		CreatedAt: revision.CreatedAt,
 	}
 }
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
@@ -19,67 +20,1400 @@ import (
 	"github.com/go-chi/chi/v5"
 )
 
+// Defines values for Visibility.
+const (
+	Followers Visibility = "followers"
+	Mentioned Visibility = "mentioned"
+	Public    Visibility = "public"
+)
+
+// CreatePollRequest defines model for CreatePollRequest.
+type CreatePollRequest struct {
+	// ExpiresAt time the poll stops taking votes, has to be after the status is published
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Multiple allows voters to choose more than one option
+	Multiple *bool `json:"multiple,omitempty"`
+
+	// Options 2 to 4 distinct options
+	Options []string `json:"options"`
+}
+
 // CreateStatusRequest defines model for CreateStatusRequest.
 type CreateStatusRequest struct {
-	Content  string                `json:"content"`
+	Content string `json:"content"`
+
+	// InReplyToId uuid of the status to reply to
+	InReplyToId *openapi_types.UUID   `json:"inReplyToId,omitempty"`
+	MediaIds    *[]openapi_types.UUID `json:"mediaIds,omitempty"`
+	Poll        *CreatePollRequest    `json:"poll,omitempty"`
+
+	// PublishAt schedules the status to be published at this future time instead of now
+	PublishAt *time.Time `json:"publishAt,omitempty"`
+
+	// Sensitive hides the attached media until the reader asks for it
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// SpoilerText content warning to show instead of the content
+	SpoilerText *string `json:"spoilerText,omitempty"`
+
+	// Visibility who can see the status, followers are the followers of the author. The author and mentioned users always can
+	Visibility *Visibility `json:"visibility,omitempty"`
+}
+
+// LikeResponse defines model for LikeResponse.
+type LikeResponse struct {
+	// CreatedAt time of the like
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UserId user who liked the status
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// LikesResponse defines model for LikesResponse.
+type LikesResponse struct {
+	Likes []LikeResponse `json:"likes"`
+
+	// Next cursor for the next page, absent on the last page
+	Next *string `json:"next,omitempty"`
+}
+
+// MentionResponse a mentioned user and where the mention is in the content
+type MentionResponse struct {
+	// End offset in characters right after the mention
+	End int `json:"end"`
+
+	// Start offset in characters of the @ of the mention
+	Start    int                `json:"start"`
+	UserId   openapi_types.UUID `json:"userId"`
+	Username string             `json:"username"`
+}
+
+// PollOptionResponse defines model for PollOptionResponse.
+type PollOptionResponse struct {
+	Title string `json:"title"`
+	Votes int    `json:"votes"`
+}
+
+// PollResponse defines model for PollResponse.
+type PollResponse struct {
+	// Expired the poll takes no more votes, its tallies are final
+	Expired   bool      `json:"expired"`
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Multiple whether voters can choose more than one option
+	Multiple bool                 `json:"multiple"`
+	Options  []PollOptionResponse `json:"options"`
+
+	// VotersCount number of users who voted, can be less than the sum of the votes of multiple choice polls
+	VotersCount int `json:"votersCount"`
+}
+
+// PollVoteRequest defines model for PollVoteRequest.
+type PollVoteRequest struct {
+	// Choices indexes of the chosen options, exactly one for single choice polls
+	Choices []int `json:"choices"`
+}
+
+// RescheduleStatusRequest defines model for RescheduleStatusRequest.
+type RescheduleStatusRequest struct {
+	// PublishAt future time to publish the status at
+	PublishAt time.Time `json:"publishAt"`
+}
+
+// StatusContextResponse defines model for StatusContextResponse.
+type StatusContextResponse struct {
+	// Ancestors statuses the status replies to, starting with the root of the conversation
+	Ancestors []StatusResponse `json:"ancestors"`
+
+	// Descendants direct replies to the status, each with its own replies, oldest first
+	Descendants []StatusThreadResponse `json:"descendants"`
+}
+
+// StatusHistoryResponse defines model for StatusHistoryResponse.
+type StatusHistoryResponse struct {
+	Revisions []StatusRevisionResponse `json:"revisions"`
+}
+
+// StatusRepostResponse attribution of a status that shows up because it was reposted
+type StatusRepostResponse struct {
+	// CreatedAt time of the repost
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UserId user who reposted the status
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// StatusResponse defines model for StatusResponse.
+type StatusResponse struct {
+	Content string `json:"content"`
+
+	// CreatedAt assigned by the server when the status is created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DeletedAt time the status was deleted, only present on the response to deleting it
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+
+	// EditedAt time of the last edit, absent if the status was never edited
+	EditedAt *time.Time         `json:"editedAt,omitempty"`
+	Id       openapi_types.UUID `json:"id"`
+
+	// InReplyToId uuid of the status this status replies to, absent for statuses starting a conversation
+	InReplyToId *openapi_types.UUID   `json:"inReplyToId,omitempty"`
+	LikeCount   int                   `json:"likeCount"`
+	MediaIds    *[]openapi_types.UUID `json:"mediaIds,omitempty"`
+
+	// Mentions @username mentions of the content that belong to a user
+	Mentions []MentionResponse `json:"mentions"`
+
+	// Pinned the author pinned the status to their profile
+	Pinned bool          `json:"pinned"`
+	Poll   *PollResponse `json:"poll,omitempty"`
+
+	// PublishAt time the status gets published, only present while it is scheduled
+	PublishAt *time.Time `json:"publishAt,omitempty"`
+
+	// Repost attribution of a status that shows up because it was reposted
+	Repost      *StatusRepostResponse `json:"repost,omitempty"`
+	RepostCount int                   `json:"repostCount"`
+
+	// Sensitive the attached media should be hidden until the reader asks for it
+	Sensitive bool `json:"sensitive"`
+
+	// SpoilerText content warning to show instead of the content until the reader expands it, empty if there is none
+	SpoilerText string `json:"spoilerText"`
+
+	// Tags normalized hashtags of the content, without the leading #
+	Tags []string `json:"tags"`
+
+	// UpdatedAt assigned by the server whenever the status changes
+	UpdatedAt time.Time          `json:"updatedAt"`
+	UserId    openapi_types.UUID `json:"userId"`
+
+	// Visibility who can see the status, followers are the followers of the author. The author and mentioned users always can
+	Visibility Visibility `json:"visibility"`
+}
+
+// StatusRevisionResponse defines model for StatusRevisionResponse.
+type StatusRevisionResponse struct {
+	Content string `json:"content"`
+
+	// CreatedAt time this revision was written
+	CreatedAt time.Time             `json:"createdAt"`
+	MediaIds  *[]openapi_types.UUID `json:"mediaIds,omitempty"`
+}
+
+// StatusThreadResponse defines model for StatusThreadResponse.
+type StatusThreadResponse struct {
+	// Replies replies to the status, oldest first
+	Replies []StatusThreadResponse `json:"replies"`
+	Status  StatusResponse         `json:"status"`
+}
+
+// StatusesResponse defines model for StatusesResponse.
+type StatusesResponse struct {
+	// Next cursor for the next page, absent on the last page
+	Next     *string          `json:"next,omitempty"`
+	Statuses []StatusResponse `json:"statuses"`
+}
+
+// UpdateStatusRequest defines model for UpdateStatusRequest.
+type UpdateStatusRequest struct {
+	Content string `json:"content"`
+
+	// MediaIds replaces the attached media, the attached media is kept if absent
 	MediaIds *[]openapi_types.UUID `json:"mediaIds,omitempty"`
+
+	// Sensitive marks the attached media as sensitive or not, kept if absent
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// SpoilerText replaces the content warning, an empty one removes it. The content warning is kept if absent
+	SpoilerText *string `json:"spoilerText,omitempty"`
+}
+
+// Visibility who can see the status, followers are the followers of the author. The author and mentioned users always can
+type Visibility string
+
+// CreateStatusParams defines parameters for CreateStatus.
+type CreateStatusParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally.
+	XUser openapi_types.UUID `json:"X-user"`
+
+	// IdempotencyKey repeats of a request with the same key by the same user get the first response replayed, for 24 hours by default
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// DeleteStatusParams defines parameters for DeleteStatus.
+type DeleteStatusParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be the author of the status
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// GetStatusParams defines parameters for GetStatus.
+type GetStatusParams struct {
+	// XUser supplied from api gateway if authenticated. Without it only public statuses can be fetched
+	XUser *openapi_types.UUID `json:"X-user,omitempty"`
+}
+
+// UpdateStatusParams defines parameters for UpdateStatus.
+type UpdateStatusParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be the author of the status
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// GetStatusContextParams defines parameters for GetStatusContext.
+type GetStatusContextParams struct {
+	// XUser supplied from api gateway if authenticated. Without it only public statuses are returned
+	XUser *openapi_types.UUID `json:"X-user,omitempty"`
+}
+
+// GetStatusHistoryParams defines parameters for GetStatusHistory.
+type GetStatusHistoryParams struct {
+	// XUser supplied from api gateway if authenticated. Without it only the history of public statuses can be fetched
+	XUser *openapi_types.UUID `json:"X-user,omitempty"`
+}
+
+// DeleteLikeParams defines parameters for DeleteLike.
+type DeleteLikeParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally.
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// GetLikesParams defines parameters for GetLikes.
+type GetLikesParams struct {
+	// Limit maximum number of likes to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor opaque cursor from the next field of a previous response
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// XUser supplied from api gateway if authenticated. Without it only the likes of public statuses can be fetched
+	XUser *openapi_types.UUID `json:"X-user,omitempty"`
+}
+
+// CreateLikeParams defines parameters for CreateLike.
+type CreateLikeParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally.
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// VotePollParams defines parameters for VotePoll.
+type VotePollParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally.
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// DeleteRepostParams defines parameters for DeleteRepost.
+type DeleteRepostParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally.
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// CreateRepostParams defines parameters for CreateRepost.
+type CreateRepostParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally.
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// RestoreStatusParams defines parameters for RestoreStatus.
+type RestoreStatusParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be the author of the status
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// CancelScheduledStatusParams defines parameters for CancelScheduledStatus.
+type CancelScheduledStatusParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be the author of the status
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// RescheduleStatusParams defines parameters for RescheduleStatus.
+type RescheduleStatusParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be the author of the status
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// GetTaggedStatusesParams defines parameters for GetTaggedStatuses.
+type GetTaggedStatusesParams struct {
+	// Limit maximum number of statuses to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor opaque cursor from the next field of a previous response
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetLikedStatusesParams defines parameters for GetLikedStatuses.
+type GetLikedStatusesParams struct {
+	// Limit maximum number of statuses to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor opaque cursor from the next field of a previous response
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// UnpinStatusParams defines parameters for UnpinStatus.
+type UnpinStatusParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be the user
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// PinStatusParams defines parameters for PinStatus.
+type PinStatusParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be the user
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// GetScheduledStatusesParams defines parameters for GetScheduledStatuses.
+type GetScheduledStatusesParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be the user
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// GetStatusesParams defines parameters for GetStatuses.
+type GetStatusesParams struct {
+	// Limit maximum number of statuses to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor opaque cursor from the next field of a previous response
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Since only return statuses created at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until only return statuses created before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Pinned put the statuses the user pinned in front of the first page, marked as pinned
+	Pinned *bool `form:"pinned,omitempty" json:"pinned,omitempty"`
+
+	// XUser supplied from api gateway if authenticated. Without it only public statuses are returned
+	XUser *openapi_types.UUID `json:"X-user,omitempty"`
+}
+
+// CreateStatusJSONRequestBody defines body for CreateStatus for application/json ContentType.
+type CreateStatusJSONRequestBody = CreateStatusRequest
+
+// UpdateStatusJSONRequestBody defines body for UpdateStatus for application/json ContentType.
+type UpdateStatusJSONRequestBody = UpdateStatusRequest
+
+// VotePollJSONRequestBody defines body for VotePoll for application/json ContentType.
+type VotePollJSONRequestBody = PollVoteRequest
+
+// RescheduleStatusJSONRequestBody defines body for RescheduleStatus for application/json ContentType.
+type RescheduleStatusJSONRequestBody = RescheduleStatusRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// create a status
+	// (POST /statuses)
+	CreateStatus(w http.ResponseWriter, r *http.Request, params CreateStatusParams)
+	// delete a status by id, only allowed for its author
+	// (DELETE /statuses/{statusId})
+	DeleteStatus(w http.ResponseWriter, r *http.Request, statusId openapi_types.UUID, params DeleteStatusParams)
+	// get a status by id
+	// (GET /statuses/{statusId})
+	GetStatus(w http.ResponseWriter, r *http.Request, statusId openapi_types.UUID, params GetStatusParams)
+	// edit a status, only allowed for its author
+	// (PATCH /statuses/{statusId})
+	UpdateStatus(w http.ResponseWriter, r *http.Request, statusId openapi_types.UUID, params UpdateStatusParams)
+	// get the conversation around a status
+	// (GET /statuses/{statusId}/context)
+	GetStatusContext(w http.ResponseWriter, r *http.Request, statusId openapi_types.UUID, params GetStatusContextParams)
+	// get the previous revisions of a status, oldest first
+	// (GET /statuses/{statusId}/history)
+	GetStatusHistory(w http.ResponseWriter, r *http.Request, statusId openapi_types.UUID, params GetStatusHistoryParams)
+	// unlike a status
+	// (DELETE /statuses/{statusId}/likes)
+	DeleteLike(w http.ResponseWriter, r *http.Request, statusId openapi_types.UUID, params DeleteLikeParams)
+	// get the users who liked a status, most recent like first
+	// (GET /statuses/{statusId}/likes)
+	GetLikes(w http.ResponseWriter, r *http.Request, statusId openapi_types.UUID, params GetLikesParams)
+	// like a status
+	// (POST /statuses/{statusId}/likes)
+	CreateLike(w http.ResponseWriter, r *http.Request, statusId openapi_types.UUID, params CreateLikeParams)
+	// vote in the poll of a status, every user votes once
+	// (POST /statuses/{statusId}/poll/votes)
+	VotePoll(w http.ResponseWriter, r *http.Request, statusId openapi_types.UUID, params VotePollParams)
+	// undo a repost of the caller
+	// (DELETE /statuses/{statusId}/reposts)
+	DeleteRepost(w http.ResponseWriter, r *http.Request, statusId openapi_types.UUID, params DeleteRepostParams)
+	// repost a status to the followers of the caller
+	// (POST /statuses/{statusId}/reposts)
+	CreateRepost(w http.ResponseWriter, r *http.Request, statusId openapi_types.UUID, params CreateRepostParams)
+	// restore a deleted status, only allowed for its author within the restore window, 24 hours by default
+	// (POST /statuses/{statusId}/restore)
+	RestoreStatus(w http.ResponseWriter, r *http.Request, statusId openapi_types.UUID, params RestoreStatusParams)
+	// cancel a scheduled status, only allowed for its author
+	// (DELETE /statuses/{statusId}/schedule)
+	CancelScheduledStatus(w http.ResponseWriter, r *http.Request, statusId openapi_types.UUID, params CancelScheduledStatusParams)
+	// move the publish time of a scheduled status, only allowed for its author
+	// (PUT /statuses/{statusId}/schedule)
+	RescheduleStatus(w http.ResponseWriter, r *http.Request, statusId openapi_types.UUID, params RescheduleStatusParams)
+	// get the public statuses tagged with a hashtag, newest first
+	// (GET /tags/{tag}/statuses)
+	GetTaggedStatuses(w http.ResponseWriter, r *http.Request, tag string, params GetTaggedStatusesParams)
+	// get the statuses a user liked, most recently liked first
+	// (GET /users/{userId}/likes)
+	GetLikedStatuses(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params GetLikedStatusesParams)
+	// unpin a status from the profile of its author, only allowed for the author
+	// (DELETE /users/{userId}/pinned-statuses/{statusId})
+	UnpinStatus(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, statusId openapi_types.UUID, params UnpinStatusParams)
+	// pin a status to the profile of its author, only allowed for the author
+	// (POST /users/{userId}/pinned-statuses/{statusId})
+	PinStatus(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, statusId openapi_types.UUID, params PinStatusParams)
+	// get the statuses of a user that wait to be published, due first. Only allowed for the user
+	// (GET /users/{userId}/scheduled-statuses)
+	GetScheduledStatuses(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params GetScheduledStatusesParams)
+	// get all statuses of a user
+	// (GET /users/{userId}/statuses)
+	GetStatuses(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params GetStatusesParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// CreateStatus operation middleware
+func (siw *ServerInterfaceWrapper) CreateStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateStatusParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateStatus(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// StatusResponse defines model for StatusResponse.
-type StatusResponse struct {
-	Content  string                `json:"content"`
-	Id       openapi_types.UUID    `json:"id"`
-	MediaIds *[]openapi_types.UUID `json:"mediaIds,omitempty"`
-	UserId   openapi_types.UUID    `json:"userId"`
-}
+// DeleteStatus operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "statusId" -------------
+	var statusId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "statusId", runtime.ParamLocationPath, chi.URLParam(r, "statusId"), &statusId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statusId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteStatusParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteStatus(w, r, statusId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "statusId" -------------
+	var statusId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "statusId", runtime.ParamLocationPath, chi.URLParam(r, "statusId"), &statusId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statusId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatusParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = &XUser
+
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatus(w, r, statusId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "statusId" -------------
+	var statusId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "statusId", runtime.ParamLocationPath, chi.URLParam(r, "statusId"), &statusId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statusId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateStatusParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateStatus(w, r, statusId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetStatusContext operation middleware
+func (siw *ServerInterfaceWrapper) GetStatusContext(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "statusId" -------------
+	var statusId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "statusId", runtime.ParamLocationPath, chi.URLParam(r, "statusId"), &statusId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statusId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatusContextParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = &XUser
+
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatusContext(w, r, statusId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetStatusHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetStatusHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "statusId" -------------
+	var statusId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "statusId", runtime.ParamLocationPath, chi.URLParam(r, "statusId"), &statusId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statusId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatusHistoryParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = &XUser
+
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatusHistory(w, r, statusId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteLike operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLike(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "statusId" -------------
+	var statusId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "statusId", runtime.ParamLocationPath, chi.URLParam(r, "statusId"), &statusId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statusId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteLikeParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteLike(w, r, statusId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLikes operation middleware
+func (siw *ServerInterfaceWrapper) GetLikes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "statusId" -------------
+	var statusId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "statusId", runtime.ParamLocationPath, chi.URLParam(r, "statusId"), &statusId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statusId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLikesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = &XUser
+
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLikes(w, r, statusId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateLike operation middleware
+func (siw *ServerInterfaceWrapper) CreateLike(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "statusId" -------------
+	var statusId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "statusId", runtime.ParamLocationPath, chi.URLParam(r, "statusId"), &statusId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statusId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateLikeParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateLike(w, r, statusId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// VotePoll operation middleware
+func (siw *ServerInterfaceWrapper) VotePoll(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "statusId" -------------
+	var statusId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "statusId", runtime.ParamLocationPath, chi.URLParam(r, "statusId"), &statusId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statusId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params VotePollParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VotePoll(w, r, statusId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteRepost operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRepost(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "statusId" -------------
+	var statusId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "statusId", runtime.ParamLocationPath, chi.URLParam(r, "statusId"), &statusId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statusId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteRepostParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRepost(w, r, statusId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateRepost operation middleware
+func (siw *ServerInterfaceWrapper) CreateRepost(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "statusId" -------------
+	var statusId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "statusId", runtime.ParamLocationPath, chi.URLParam(r, "statusId"), &statusId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statusId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateRepostParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRepost(w, r, statusId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RestoreStatus operation middleware
+func (siw *ServerInterfaceWrapper) RestoreStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "statusId" -------------
+	var statusId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "statusId", runtime.ParamLocationPath, chi.URLParam(r, "statusId"), &statusId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statusId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RestoreStatusParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreStatus(w, r, statusId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CancelScheduledStatus operation middleware
+func (siw *ServerInterfaceWrapper) CancelScheduledStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "statusId" -------------
+	var statusId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "statusId", runtime.ParamLocationPath, chi.URLParam(r, "statusId"), &statusId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statusId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CancelScheduledStatusParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelScheduledStatus(w, r, statusId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RescheduleStatus operation middleware
+func (siw *ServerInterfaceWrapper) RescheduleStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "statusId" -------------
+	var statusId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "statusId", runtime.ParamLocationPath, chi.URLParam(r, "statusId"), &statusId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statusId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RescheduleStatusParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RescheduleStatus(w, r, statusId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetTaggedStatuses operation middleware
+func (siw *ServerInterfaceWrapper) GetTaggedStatuses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "tag" -------------
+	var tag string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tag", runtime.ParamLocationPath, chi.URLParam(r, "tag"), &tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaggedStatusesParams
+
+	// ------------- Optional query parameter "limit" -------------
 
-// StatusesResponse defines model for StatusesResponse.
-type StatusesResponse struct {
-	Statuses []StatusResponse `json:"statuses"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-// CreateStatusParams defines parameters for CreateStatus.
-type CreateStatusParams struct {
-	// XUser supplied from api gateway if authenticated, can be set manually locally.
-	XUser openapi_types.UUID `json:"X-user"`
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-// CreateStatusJSONRequestBody defines body for CreateStatus for application/json ContentType.
-type CreateStatusJSONRequestBody = CreateStatusRequest
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// create a status
-	// (POST /statuses)
-	CreateStatus(w http.ResponseWriter, r *http.Request, params CreateStatusParams)
-	// delete a status by id
-	// (DELETE /statuses/{statusId})
-	DeleteStatus(w http.ResponseWriter, r *http.Request, statusId openapi_types.UUID)
-	// get a status by id
-	// (GET /statuses/{statusId})
-	GetStatus(w http.ResponseWriter, r *http.Request, statusId openapi_types.UUID)
-	// get all statuses of a user
-	// (GET /users/{userId}/statuses)
-	GetStatuses(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID)
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTaggedStatuses(w, r, tag, params)
+	})
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// GetLikedStatuses operation middleware
+func (siw *ServerInterfaceWrapper) GetLikedStatuses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// CreateStatus operation middleware
-func (siw *ServerInterfaceWrapper) CreateStatus(w http.ResponseWriter, r *http.Request) {
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, chi.URLParam(r, "userId"), &userId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLikedStatusesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLikedStatuses(w, r, userId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UnpinStatus operation middleware
+func (siw *ServerInterfaceWrapper) UnpinStatus(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, chi.URLParam(r, "userId"), &userId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "statusId" -------------
+	var statusId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "statusId", runtime.ParamLocationPath, chi.URLParam(r, "statusId"), &statusId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statusId", Err: err})
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateStatusParams
+	var params UnpinStatusParams
 
 	headers := r.Header
 
@@ -107,7 +1441,7 @@ func (siw *ServerInterfaceWrapper) CreateStatus(w http.ResponseWriter, r *http.R
 	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateStatus(w, r, params)
+		siw.Handler.UnpinStatus(w, r, userId, statusId, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -117,12 +1451,21 @@ func (siw *ServerInterfaceWrapper) CreateStatus(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteStatus operation middleware
-func (siw *ServerInterfaceWrapper) DeleteStatus(w http.ResponseWriter, r *http.Request) {
+// PinStatus operation middleware
+func (siw *ServerInterfaceWrapper) PinStatus(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, chi.URLParam(r, "userId"), &userId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
 	// ------------- Path parameter "statusId" -------------
 	var statusId openapi_types.UUID
 
@@ -132,8 +1475,36 @@ func (siw *ServerInterfaceWrapper) DeleteStatus(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PinStatusParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteStatus(w, r, statusId)
+		siw.Handler.PinStatus(w, r, userId, statusId, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -143,23 +1514,51 @@ func (siw *ServerInterfaceWrapper) DeleteStatus(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetStatus(w http.ResponseWriter, r *http.Request) {
+// GetScheduledStatuses operation middleware
+func (siw *ServerInterfaceWrapper) GetScheduledStatuses(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "statusId" -------------
-	var statusId openapi_types.UUID
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "statusId", runtime.ParamLocationPath, chi.URLParam(r, "statusId"), &statusId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, chi.URLParam(r, "userId"), &userId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statusId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScheduledStatusesParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
 		return
 	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStatus(w, r, statusId)
+		siw.Handler.GetScheduledStatuses(w, r, userId, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -184,8 +1583,72 @@ func (siw *ServerInterfaceWrapper) GetStatuses(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatusesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pinned" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pinned", r.URL.Query(), &params.Pinned)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pinned", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = &XUser
+
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStatuses(w, r, userId)
+		siw.Handler.GetStatuses(w, r, userId, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -317,6 +1780,57 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/statuses/{statusId}", wrapper.GetStatus)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/statuses/{statusId}", wrapper.UpdateStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/statuses/{statusId}/context", wrapper.GetStatusContext)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/statuses/{statusId}/history", wrapper.GetStatusHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/statuses/{statusId}/likes", wrapper.DeleteLike)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/statuses/{statusId}/likes", wrapper.GetLikes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/statuses/{statusId}/likes", wrapper.CreateLike)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/statuses/{statusId}/poll/votes", wrapper.VotePoll)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/statuses/{statusId}/reposts", wrapper.DeleteRepost)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/statuses/{statusId}/reposts", wrapper.CreateRepost)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/statuses/{statusId}/restore", wrapper.RestoreStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/statuses/{statusId}/schedule", wrapper.CancelScheduledStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/statuses/{statusId}/schedule", wrapper.RescheduleStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tags/{tag}/statuses", wrapper.GetTaggedStatuses)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{userId}/likes", wrapper.GetLikedStatuses)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/{userId}/pinned-statuses/{statusId}", wrapper.UnpinStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{userId}/pinned-statuses/{statusId}", wrapper.PinStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{userId}/scheduled-statuses", wrapper.GetScheduledStatuses)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/{userId}/statuses", wrapper.GetStatuses)
 	})
@@ -327,18 +1841,68 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RVT2/bOgz/KgLfOzp1kr4+ZL7tDzDkNmyXAUUPtEzHKmxLleh2RuDvPkh2ktpJ2wAL",
-	"hu0UgxFF/v6Q2oLUldE11ewg2YKTBVUYPj9aQqZvjNy4r/TQkGMfNlYbsqwoHJK6ZqrDH/QDK1MSJOBC",
-	"jtj9FwG3po9bVW+gi6CiTOE6C1copip85NpWyJBA06jsVNYQQGuxha6LwNJDoyxlkNzuO7nbn9PpPUn2",
-	"iTsQzuja0cVQqGycsZz/n2fpYjWjNF3O/pMpzt6tiGYLlBnmq3S5urmB6G2cl2AngsaRXU869DHxVChZ",
-	"CBnkdYILEj3Ut1ubUB6OHPgZCr4sAL0igRtOjDD/aymHBP6JDyaNB4fGE03fssf+/uP2/FFV59pXzMhJ",
-	"qwwrXUMytO15UBz46wPi/Zc1RPBI1vXnFr68NlSjUZDA9dX86hoiMMhFwBE/R2d0P0gePfpCXqTRtIVU",
-	"ixUxWQfJ7bQt1xhTKspEbnUl0CixQaYnbIXKBTZcUM1KIlMWCYm1SEk4YlFh3WBZtqLU0v9egccNCRSE",
-	"GVmIoMbKo/w+81LCcwLZNhQN2+EMN3Z3fTI5/qCzdjJj6NuXAXt87zyk7bOrXxP91E7qxlL7TkOgN0ag",
-	"fDlfXKyFqe989ak8UpJzeeO57scsC350TVWhbSGBPirwMHmMGzf2qc/YGyfe9l/rrOttWhLTsYs+hfh5",
-	"LvLKCZ0fWghe8J49OGFX9Ne9MFJjfjxqB9LEHtKEtB70njSRtqKvd0xdBBs6MWSfif8Obn67U18kfUN8",
-	"FuPerH5ruHjbvwPdaOm9LgedLciwmE7IMbw+f7YYdBE5ylLsuPWc4I6VU7L4bLKPO1YbW/qFz2ySOA7v",
-	"QKEdJ6v5agke/3DFdmxyctDddT8DAAD//0/QJ/osCgAA",
+	"H4sIAAAAAAAC/+xdW5PbtpL+KyhmHzlXO1lnnpL11m5cyamkHCfnVKX8ABEtERkSoAHQGh3X/PdTjQtv",
+	"AilKlsfjsZ4ypgCw0ejL1xcwH5JMlpUUIIxObj4kOsuhpPbPlwqogd9kUbyGdzVogw8rJStQhoMdAncV",
+	"V6B/tD8x0JnileFSJDeJ4SUQkwOpZFEQbWSliaG3XKzIe2lApySnmhhJFkDo0oCyg7WhptaEa1LVi4Lr",
+	"HFiSJkupSmqSm4RRA2e4cpImZlNBcpNoo7hYJfdpUtaF4VUBjpYlrQuT3CxpoSEd0EaLQq61pUNZGrJc",
+	"Sg2klApppoJIAUS64c2bFlIWQAW+yv2kt3d9jas9J4xrw0VmSBiYJnBHS0vcX8kG8IGQyds04QZKu87W",
+	"dvwDqhTdJPf3aaLgXc0VMFyiu244grfNHLn4GzKDi7gz/N1ydfQUMykMCOMO1FOZ+JMIv0X4zcVrqIrN",
+	"G/mKbTOirjkjctk9VCOJwgnEyO6Z4sjocQLj9BWzFDZc2jmrz7U0QenDif+lYJncJN9ctOJ+4WX9YlvQ",
+	"caKTv5ho4zxWF6AHu1tAK7WEGmJyrsmyNjVKFaoDF9oAtXwRcj1bsDUIzQ1/P0Oyc848WdQYinQSy0dS",
+	"C8ML+4MCykARqm81WUpFuImKuK4kL0C9gbsIB7xUkDVVAjXaSKJzue5uEF/VCk9Hrty67t04aMkFLaL7",
+	"fs81X/CCm82uE/yzHTnUlEBDTDt+4bfwGnQlhYaIWlixYKPGzW+y4Lcw+yhrDSqqLhoUWefSrsY6crVb",
+	"Uwb79W9IO+SPbV2P7x3J6GveFPt7fIwooYgLUa20VI0c4CBS0RWkhC40SpcUjsFUux927t1RHdvuP0Dg",
+	"W7sbHrgEUrohwIg9DCoYWeegnBPzPxKuCRcD2R64RBE5XrlcajA4Ncupopn1O4qvctPxff4dXW25+u9m",
+	"L1wYWIGyqmmoMjNf4oX0h/BH5CXfxd7RCupOk4tDBS2h7z9+1PnPYLK8LueLbLNS2GNq2Rk7ULTWv1bD",
+	"M+0fheGmGFDlXO+2pZEGdG9khPEDqt3qYe4YjePUOccdEZYGNBl6C5oI6XCJR03caGJoUXDQhCpvPqMW",
+	"vAfODoFQXaLWOZgcVMBMGRUfA5pmWZXIEUdsiyPopaxFRCVEXS5AoeyjaGlrYXECS+0OFkAK0NpRb21u",
+	"XQZFsezGfwSe4H555o6mB+iurnfKSgvXGg53zydthKG/nzGh+lMaGEdzlswINuWCwR00JiHLpQYREGpK",
+	"4I5mptjYU0SbrLlYTez6r8sIeu0YkEn4GmiMbfA1BHy1A7ZOILQu6DIyoLIuXKNmps8eUN6+NEa7o/gl",
+	"uoY7M676VGSgjVSRQ3Lk9aElomZUdyNTYu0iQq41N25DSkrTAVzvQWnqdXCWmgUuj6sYUgiCUR8i9glm",
+	"XEFmOjR2KE8J0Cx3pHKjiVyLMDAlsmCgDVlypc1+tL7JFVA2TvHgyFpu97cyfoA/cRy/GT9ABYhN9zFm",
+	"gctu3mza2xeNU/saKqnNBLQxRvFFjf9COaFNxJJTY2G7JnVFFpDRWgPhiOqtzEltrEU6CBW7+cfDxYGe",
+	"B4DGA4U4Vrg8wTiqNV8h8lxs3P5Avbf7BjHIivhFZvOVQQFTp9VZHU/dD0+JFMWGVAq6OFx5jqCO24Fo",
+	"hvj8MwbG54RTVBuCQ5swgC+HZApA9rj1Zr+es/6JXV9+t2SLqxdnsFhcnz3PFvTs+xcAZ1c0Y3T5YnH9",
+	"4ttv5+Qp9k6D5FzHLLvfrXW9wQk01p4OTftOsjAWalDRFKw9VqbFRxYRF/FDwPUh+tCDBIEzRQsopMsl",
+	"UAvX5jqFYWwXoa3iQoxBbVqbXCrihgwyOiYHrkil5JIXEAW1c/JLvThgOrU0VMoVmE4qdKCX65wX1mCj",
+	"QHnMNF8fvIWe6bd6LqaZvS1gz6IBaz+BtXUC/VSVzmVdMATnOWcMxGfNXW2/HO4qKpgmaKGgrMzGGygF",
+	"eA5CCjg032XoKqI9Ak+z4P8GRnKqcxw0oDG1CEvWxj4sgDLc1zf9pDOjlUrSZCULKlb7ZJ7TpK7YAZ7L",
+	"2uiOKGc5FSvQB0CClpkeDvAs955Q74UHjpRStEu3nj4CK7o86+tK1zD7E++Yzh6BfTnualFj0KbgywBp",
+	"HgJjyAKW0mfA0NvuCWu8MeOaBBhrvfdacWNAzK/rHME9jSSF50HBQbwRiQaqwv/ZZ8BIUPTpIp/UH+C+",
+	"Md+APY0+hZ2N82YqifzJ0r5pEybvHX/NjLua9WM7/8Pq9iFFNQdZye5goSvz2zJFs2iRJ408I1yTW6gs",
+	"inb8TdKDtWjSk5dU3caoIlSTZhqRighp0m2a9vTiPTYMXHpKqPCuWQogCkr5HjTh5py82R4d5dB0IDlV",
+	"U/qz52GGSVRp844aoGcQlhIL0qBcRhd/aZ94V+9QqtuA+9tWKPpFC01osaYbm561ufO6bHJWmbW3ftXW",
+	"5/ScSLNdG9Ys5fYOfg+WwefW/QPy42+v0HmB0m7clUv4gqAVT26SZ+eX58+SNKmoya3gXXQVOOBQVB8b",
+	"36Db75Wu7VRFSzBI/M1fQ7J0XaGhYmSpZEloxcmKGlhTi82QXbjZjHYTvxoMKamoaVFsSCEz/O95gvtO",
+	"bpLcQr0kTVxdI/nXmQ9IWikwqobUt0vMUKX7NCLBQI12KRnlzEib1dMYLt3CpgFW+G8kAkMCjyOVNm1Q",
+	"bhVigxtEy3r9nOSyVhqnh4rxyN5eMSgraUBkm7OfYZN0NzXcxFvHAdDmfyTbDMwcxTPI7AFe/K2laNtJ",
+	"5hXg+wb1/v5+yG77wG3Xys315dXRSNjyiPfploxlGWi9rFFgQh7mPk2eX34fKyfGT3TAbBu5GV4UhAsM",
+	"M1cKtLaLXl/HY6Ve4oDZVIy0RSJD4I5rkxLvV4OVkyqYYQWEi/e04KwZNCQH4VmtUY/QvhDGl0tQuIrf",
+	"zTl55VYgSw4Fc/aq4DYxxwX5P/fQmyxQSrpSiK7LkqpNcuPhVpODDBC453FxRmMfLj64v16xe8eQAgxs",
+	"G4v/tc/nGYuQk2lIsGqBpqlVivDS46r8scwU+alpnepkMHpppk9oyd5uqeHl51JDn688JwpMrYRDA/5h",
+	"4119vOj4qWwpAFgvrrePyJoLJtcEBHMqePksAl5pUYBygb4ZZ76d/nysumPnLmUt2EA7HOVthn6xITwk",
+	"fWzPmtdMbrR/b1yB0mQFEY/6/2Cejoack3/6pAc3Pi1mYU6bPPUnvgSTuTbCaYX48hSANMc7W+A8/iY2",
+	"zVBAiEydWA+kEYFGXxTHpK2iJsu35a0bJ52M8gMY5eMjs1ioOwuZfTaX4KtCD2bAJ7DaNATjH4ekcJ+N",
+	"eh7gI0ZA1kXmmhdwR9NOxHc5fB2+BE/HYYwvzJMMe1E+t0MZdqkQquwyB0UEF7lr1NgtrL6j42kKK/LU",
+	"cwKJf0I4aNiI8xikt8JihqzboobudtVsZff3kOam6Xo6yv3FNZw/cTj1dQSwtcBDZ3sLrJ0VcoNRcXUr",
+	"77Cr44HiL1YWH62UlfSOl3VJ2gZfqzzujhG66UDMuxrUpqWm4KUtY7avbu7TXF+mYdnk5uoS/8WF/1es",
+	"wXdIkqzouxpIKG+h9Df1LYvxnJnomA8vI3FK3TqT2dijOxHHw6fiQvpXXB6D62ib0J3+tk6jlDaXn4Fw",
+	"uj3lPtLJgsnJOxzdO3y2KsN+vmG0GNHpnKSFAso2M/zHDO8xhmOwJ+6iuVITF1a8woB9cSdRfXxJn+EV",
+	"kwdO+PTbJbdVBCWLZLIWlvXNbanmloGAdbghNVt30nFbjoY+9NdR+6pRRfMTgpIhoSyU2iyNOdUkXPWZ",
+	"zBy5+zHdhNFHl94s27hoielFLditt3EFZn/zSWSwn9q7NrcZAczrcDfgpPlPIIRx7TWse+tjXwzVXO6Y",
+	"DmiYJNSPbTpg3cgDcNJJCJ8SUgoSNJr3n6oQ9id/PPafD8NmCb4b1Lm3JeMtYlO6MG6zbe17HKe9dgNO",
+	"RbyvrLMi9Ek8VCEtJbTQ0qKOcAWxqtUKdkY1ngDf9GFHX13GUdWg0aNHKAHBgG1pnptAt3pKJsptFixy",
+	"EWktSUfa8vbQ13DNZwpkvaQig+J3P5KdVPcrU93Mnn8x3Rb1oAXymd6w/eTXoGnQbojQ9pLb4WXvNKnq",
+	"uJvrfXTgpC5fZLvK2LcjHnfLimqoZo9cLUdSFs2F1kCfd3/uMxzpXmkKDCbtj35REi6HH0X90a/i44sP",
+	"hq7ue7cQxiphb+hqFbzo7pKYv6LpslGdzFHvZiZqprafg+tc6YtYEENXk1p2QJms/b7I11Qp+/Te+ZBK",
+	"U7y9YBCjGit+Tp5ouAGcYpJzRpeBLThdfHA3VDsNBlNV39miHnxguKu/Lb/NzdhPWvk9ifQjF+nmgNx3",
+	"HVzxqVfzDLWuPeXZ3YI+2/OuyB+i4mI/mPewIv7kwGWXfU+9n8fJ5B44zqqEVO3fD4XxcGCgdpBpr7ho",
+	"842NNfPfQUFyWpwVAWEt5fsl5n87qeVJLT+JWn45StkEXS3FY0ViS5cbR6jG0950XK0OKpmSb7vpxr6q",
+	"9xTdyKOqecRhNxHc2ZzIa5DCfKyI9KSdx8OPeynoLrhpITcOdN8XW1Nuhp9pTwmrfbPdOfk1JuGe0TPl",
+	"e45Un8KrL7G31po/t/+WJf4zAITaS17hU95cE/8to9jLNXedNZFDmvwM7V4ENR9umqbFXoI+Ai1VPVC9",
+	"oX/iAk9NNH0bVuP8Z3/wwzHOhbnBI7Q2P0bky/+/EIbfkDndCTuSYU77J+vNcUk31h5rANeNBktDkGNa",
+	"ojLSFTT+LpfK6Yb/0LazFdv2mxZF+6rGfo/YX5xtvzfnzGetCjwBY6qbiwvrVHOpzc2LyxfXyf3bZokP",
+	"fawOOrl/e/+fAQA/7o3hB2cAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
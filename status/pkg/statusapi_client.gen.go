@@ -96,13 +96,70 @@ type ClientInterface interface {
 	CreateStatus(ctx context.Context, params *CreateStatusParams, body CreateStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteStatus request
-	DeleteStatus(ctx context.Context, statusId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteStatus(ctx context.Context, statusId openapi_types.UUID, params *DeleteStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetStatus request
-	GetStatus(ctx context.Context, statusId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetStatus(ctx context.Context, statusId openapi_types.UUID, params *GetStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateStatus request with any body
+	UpdateStatusWithBody(ctx context.Context, statusId openapi_types.UUID, params *UpdateStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateStatus(ctx context.Context, statusId openapi_types.UUID, params *UpdateStatusParams, body UpdateStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatusContext request
+	GetStatusContext(ctx context.Context, statusId openapi_types.UUID, params *GetStatusContextParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatusHistory request
+	GetStatusHistory(ctx context.Context, statusId openapi_types.UUID, params *GetStatusHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteLike request
+	DeleteLike(ctx context.Context, statusId openapi_types.UUID, params *DeleteLikeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLikes request
+	GetLikes(ctx context.Context, statusId openapi_types.UUID, params *GetLikesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateLike request
+	CreateLike(ctx context.Context, statusId openapi_types.UUID, params *CreateLikeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VotePoll request with any body
+	VotePollWithBody(ctx context.Context, statusId openapi_types.UUID, params *VotePollParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VotePoll(ctx context.Context, statusId openapi_types.UUID, params *VotePollParams, body VotePollJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteRepost request
+	DeleteRepost(ctx context.Context, statusId openapi_types.UUID, params *DeleteRepostParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateRepost request
+	CreateRepost(ctx context.Context, statusId openapi_types.UUID, params *CreateRepostParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreStatus request
+	RestoreStatus(ctx context.Context, statusId openapi_types.UUID, params *RestoreStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelScheduledStatus request
+	CancelScheduledStatus(ctx context.Context, statusId openapi_types.UUID, params *CancelScheduledStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RescheduleStatus request with any body
+	RescheduleStatusWithBody(ctx context.Context, statusId openapi_types.UUID, params *RescheduleStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RescheduleStatus(ctx context.Context, statusId openapi_types.UUID, params *RescheduleStatusParams, body RescheduleStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaggedStatuses request
+	GetTaggedStatuses(ctx context.Context, tag string, params *GetTaggedStatusesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLikedStatuses request
+	GetLikedStatuses(ctx context.Context, userId openapi_types.UUID, params *GetLikedStatusesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnpinStatus request
+	UnpinStatus(ctx context.Context, userId openapi_types.UUID, statusId openapi_types.UUID, params *UnpinStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PinStatus request
+	PinStatus(ctx context.Context, userId openapi_types.UUID, statusId openapi_types.UUID, params *PinStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScheduledStatuses request
+	GetScheduledStatuses(ctx context.Context, userId openapi_types.UUID, params *GetScheduledStatusesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetStatuses request
-	GetStatuses(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetStatuses(ctx context.Context, userId openapi_types.UUID, params *GetStatusesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) CreateStatusWithBody(ctx context.Context, params *CreateStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -129,8 +186,248 @@ func (c *Client) CreateStatus(ctx context.Context, params *CreateStatusParams, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteStatus(ctx context.Context, statusId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteStatusRequest(c.Server, statusId)
+func (c *Client) DeleteStatus(ctx context.Context, statusId openapi_types.UUID, params *DeleteStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteStatusRequest(c.Server, statusId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatus(ctx context.Context, statusId openapi_types.UUID, params *GetStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatusRequest(c.Server, statusId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateStatusWithBody(ctx context.Context, statusId openapi_types.UUID, params *UpdateStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateStatusRequestWithBody(c.Server, statusId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateStatus(ctx context.Context, statusId openapi_types.UUID, params *UpdateStatusParams, body UpdateStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateStatusRequest(c.Server, statusId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatusContext(ctx context.Context, statusId openapi_types.UUID, params *GetStatusContextParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatusContextRequest(c.Server, statusId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatusHistory(ctx context.Context, statusId openapi_types.UUID, params *GetStatusHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatusHistoryRequest(c.Server, statusId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteLike(ctx context.Context, statusId openapi_types.UUID, params *DeleteLikeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteLikeRequest(c.Server, statusId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLikes(ctx context.Context, statusId openapi_types.UUID, params *GetLikesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLikesRequest(c.Server, statusId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateLike(ctx context.Context, statusId openapi_types.UUID, params *CreateLikeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateLikeRequest(c.Server, statusId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VotePollWithBody(ctx context.Context, statusId openapi_types.UUID, params *VotePollParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVotePollRequestWithBody(c.Server, statusId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VotePoll(ctx context.Context, statusId openapi_types.UUID, params *VotePollParams, body VotePollJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVotePollRequest(c.Server, statusId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteRepost(ctx context.Context, statusId openapi_types.UUID, params *DeleteRepostParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRepostRequest(c.Server, statusId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRepost(ctx context.Context, statusId openapi_types.UUID, params *CreateRepostParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRepostRequest(c.Server, statusId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreStatus(ctx context.Context, statusId openapi_types.UUID, params *RestoreStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreStatusRequest(c.Server, statusId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelScheduledStatus(ctx context.Context, statusId openapi_types.UUID, params *CancelScheduledStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelScheduledStatusRequest(c.Server, statusId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RescheduleStatusWithBody(ctx context.Context, statusId openapi_types.UUID, params *RescheduleStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRescheduleStatusRequestWithBody(c.Server, statusId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RescheduleStatus(ctx context.Context, statusId openapi_types.UUID, params *RescheduleStatusParams, body RescheduleStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRescheduleStatusRequest(c.Server, statusId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaggedStatuses(ctx context.Context, tag string, params *GetTaggedStatusesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaggedStatusesRequest(c.Server, tag, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLikedStatuses(ctx context.Context, userId openapi_types.UUID, params *GetLikedStatusesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLikedStatusesRequest(c.Server, userId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnpinStatus(ctx context.Context, userId openapi_types.UUID, statusId openapi_types.UUID, params *UnpinStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnpinStatusRequest(c.Server, userId, statusId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PinStatus(ctx context.Context, userId openapi_types.UUID, statusId openapi_types.UUID, params *PinStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPinStatusRequest(c.Server, userId, statusId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -141,8 +438,8 @@ func (c *Client) DeleteStatus(ctx context.Context, statusId openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetStatus(ctx context.Context, statusId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetStatusRequest(c.Server, statusId)
+func (c *Client) GetScheduledStatuses(ctx context.Context, userId openapi_types.UUID, params *GetScheduledStatusesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScheduledStatusesRequest(c.Server, userId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -153,8 +450,8 @@ func (c *Client) GetStatus(ctx context.Context, statusId openapi_types.UUID, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetStatuses(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetStatusesRequest(c.Server, userId)
+func (c *Client) GetStatuses(ctx context.Context, userId openapi_types.UUID, params *GetStatusesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatusesRequest(c.Server, userId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -211,11 +508,22 @@ func NewCreateStatusRequestWithBody(server string, params *CreateStatusParams, c
 
 	req.Header.Set("X-user", headerParam0)
 
+	if params.IdempotencyKey != nil {
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Idempotency-Key", headerParam1)
+	}
+
 	return req, nil
 }
 
 // NewDeleteStatusRequest generates requests for DeleteStatus
-func NewDeleteStatusRequest(server string, statusId openapi_types.UUID) (*http.Request, error) {
+func NewDeleteStatusRequest(server string, statusId openapi_types.UUID, params *DeleteStatusParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -245,11 +553,20 @@ func NewDeleteStatusRequest(server string, statusId openapi_types.UUID) (*http.R
 		return nil, err
 	}
 
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam0)
+
 	return req, nil
 }
 
 // NewGetStatusRequest generates requests for GetStatus
-func NewGetStatusRequest(server string, statusId openapi_types.UUID) (*http.Request, error) {
+func NewGetStatusRequest(server string, statusId openapi_types.UUID, params *GetStatusParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -279,16 +596,38 @@ func NewGetStatusRequest(server string, statusId openapi_types.UUID) (*http.Requ
 		return nil, err
 	}
 
+	if params.XUser != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, *params.XUser)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-user", headerParam0)
+	}
+
 	return req, nil
 }
 
-// NewGetStatusesRequest generates requests for GetStatuses
-func NewGetStatusesRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
+// NewUpdateStatusRequest calls the generic UpdateStatus builder with application/json body
+func NewUpdateStatusRequest(server string, statusId openapi_types.UUID, params *UpdateStatusParams, body UpdateStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateStatusRequestWithBody(server, statusId, params, "application/json", bodyReader)
+}
+
+// NewUpdateStatusRequestWithBody generates requests for UpdateStatus with any type of body
+func NewUpdateStatusRequestWithBody(server string, statusId openapi_types.UUID, params *UpdateStatusParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "statusId", runtime.ParamLocationPath, statusId)
 	if err != nil {
 		return nil, err
 	}
@@ -298,7 +637,7 @@ func NewGetStatusesRequest(server string, userId openapi_types.UUID) (*http.Requ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/%s/statuses", pathParam0)
+	operationPath := fmt.Sprintf("/statuses/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -308,261 +647,2286 @@ func NewGetStatusesRequest(server string, userId openapi_types.UUID) (*http.Requ
 		return nil, err
 	}
 
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam0)
+
+	return req, nil
+}
+
+// NewGetStatusContextRequest generates requests for GetStatusContext
+func NewGetStatusContextRequest(server string, statusId openapi_types.UUID, params *GetStatusContextParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "statusId", runtime.ParamLocationPath, statusId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statuses/%s/context", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.XUser != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, *params.XUser)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-user", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewGetStatusHistoryRequest generates requests for GetStatusHistory
+func NewGetStatusHistoryRequest(server string, statusId openapi_types.UUID, params *GetStatusHistoryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "statusId", runtime.ParamLocationPath, statusId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statuses/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.XUser != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, *params.XUser)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-user", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewDeleteLikeRequest generates requests for DeleteLike
+func NewDeleteLikeRequest(server string, statusId openapi_types.UUID, params *DeleteLikeParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "statusId", runtime.ParamLocationPath, statusId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statuses/%s/likes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam0)
+
+	return req, nil
+}
+
+// NewGetLikesRequest generates requests for GetLikes
+func NewGetLikesRequest(server string, statusId openapi_types.UUID, params *GetLikesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "statusId", runtime.ParamLocationPath, statusId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statuses/%s/likes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Cursor != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	if params.XUser != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, *params.XUser)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-user", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewCreateLikeRequest generates requests for CreateLike
+func NewCreateLikeRequest(server string, statusId openapi_types.UUID, params *CreateLikeParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "statusId", runtime.ParamLocationPath, statusId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statuses/%s/likes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam0)
+
+	return req, nil
+}
+
+// NewVotePollRequest calls the generic VotePoll builder with application/json body
+func NewVotePollRequest(server string, statusId openapi_types.UUID, params *VotePollParams, body VotePollJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVotePollRequestWithBody(server, statusId, params, "application/json", bodyReader)
+}
+
+// NewVotePollRequestWithBody generates requests for VotePoll with any type of body
+func NewVotePollRequestWithBody(server string, statusId openapi_types.UUID, params *VotePollParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "statusId", runtime.ParamLocationPath, statusId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statuses/%s/poll/votes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam0)
+
+	return req, nil
+}
+
+// NewDeleteRepostRequest generates requests for DeleteRepost
+func NewDeleteRepostRequest(server string, statusId openapi_types.UUID, params *DeleteRepostParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "statusId", runtime.ParamLocationPath, statusId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statuses/%s/reposts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam0)
+
+	return req, nil
+}
+
+// NewCreateRepostRequest generates requests for CreateRepost
+func NewCreateRepostRequest(server string, statusId openapi_types.UUID, params *CreateRepostParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "statusId", runtime.ParamLocationPath, statusId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statuses/%s/reposts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam0)
+
+	return req, nil
+}
+
+// NewRestoreStatusRequest generates requests for RestoreStatus
+func NewRestoreStatusRequest(server string, statusId openapi_types.UUID, params *RestoreStatusParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "statusId", runtime.ParamLocationPath, statusId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statuses/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam0)
+
+	return req, nil
+}
+
+// NewCancelScheduledStatusRequest generates requests for CancelScheduledStatus
+func NewCancelScheduledStatusRequest(server string, statusId openapi_types.UUID, params *CancelScheduledStatusParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "statusId", runtime.ParamLocationPath, statusId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statuses/%s/schedule", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam0)
+
+	return req, nil
+}
+
+// NewRescheduleStatusRequest calls the generic RescheduleStatus builder with application/json body
+func NewRescheduleStatusRequest(server string, statusId openapi_types.UUID, params *RescheduleStatusParams, body RescheduleStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRescheduleStatusRequestWithBody(server, statusId, params, "application/json", bodyReader)
+}
+
+// NewRescheduleStatusRequestWithBody generates requests for RescheduleStatus with any type of body
+func NewRescheduleStatusRequestWithBody(server string, statusId openapi_types.UUID, params *RescheduleStatusParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "statusId", runtime.ParamLocationPath, statusId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statuses/%s/schedule", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam0)
+
+	return req, nil
+}
+
+// NewGetTaggedStatusesRequest generates requests for GetTaggedStatuses
+func NewGetTaggedStatusesRequest(server string, tag string, params *GetTaggedStatusesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tag", runtime.ParamLocationPath, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tags/%s/statuses", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Cursor != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLikedStatusesRequest generates requests for GetLikedStatuses
+func NewGetLikedStatusesRequest(server string, userId openapi_types.UUID, params *GetLikedStatusesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/likes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Cursor != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnpinStatusRequest generates requests for UnpinStatus
+func NewUnpinStatusRequest(server string, userId openapi_types.UUID, statusId openapi_types.UUID, params *UnpinStatusParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "statusId", runtime.ParamLocationPath, statusId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/pinned-statuses/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam0)
+
+	return req, nil
+}
+
+// NewPinStatusRequest generates requests for PinStatus
+func NewPinStatusRequest(server string, userId openapi_types.UUID, statusId openapi_types.UUID, params *PinStatusParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "statusId", runtime.ParamLocationPath, statusId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/pinned-statuses/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam0)
+
+	return req, nil
+}
+
+// NewGetScheduledStatusesRequest generates requests for GetScheduledStatuses
+func NewGetScheduledStatusesRequest(server string, userId openapi_types.UUID, params *GetScheduledStatusesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/scheduled-statuses", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam0)
+
+	return req, nil
+}
+
+// NewGetStatusesRequest generates requests for GetStatuses
+func NewGetStatusesRequest(server string, userId openapi_types.UUID, params *GetStatusesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/statuses", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Cursor != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Since != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Until != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Pinned != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pinned", runtime.ParamLocationQuery, *params.Pinned); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.XUser != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, *params.XUser)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-user", headerParam0)
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// CreateStatus request with any body
+	CreateStatusWithBodyWithResponse(ctx context.Context, params *CreateStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateStatusResponse, error)
+
+	CreateStatusWithResponse(ctx context.Context, params *CreateStatusParams, body CreateStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateStatusResponse, error)
+
+	// DeleteStatus request
+	DeleteStatusWithResponse(ctx context.Context, statusId openapi_types.UUID, params *DeleteStatusParams, reqEditors ...RequestEditorFn) (*DeleteStatusResponse, error)
+
+	// GetStatus request
+	GetStatusWithResponse(ctx context.Context, statusId openapi_types.UUID, params *GetStatusParams, reqEditors ...RequestEditorFn) (*GetStatusResponse, error)
+
+	// UpdateStatus request with any body
+	UpdateStatusWithBodyWithResponse(ctx context.Context, statusId openapi_types.UUID, params *UpdateStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateStatusResponse, error)
+
+	UpdateStatusWithResponse(ctx context.Context, statusId openapi_types.UUID, params *UpdateStatusParams, body UpdateStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateStatusResponse, error)
+
+	// GetStatusContext request
+	GetStatusContextWithResponse(ctx context.Context, statusId openapi_types.UUID, params *GetStatusContextParams, reqEditors ...RequestEditorFn) (*GetStatusContextResponse, error)
+
+	// GetStatusHistory request
+	GetStatusHistoryWithResponse(ctx context.Context, statusId openapi_types.UUID, params *GetStatusHistoryParams, reqEditors ...RequestEditorFn) (*GetStatusHistoryResponse, error)
+
+	// DeleteLike request
+	DeleteLikeWithResponse(ctx context.Context, statusId openapi_types.UUID, params *DeleteLikeParams, reqEditors ...RequestEditorFn) (*DeleteLikeResponse, error)
+
+	// GetLikes request
+	GetLikesWithResponse(ctx context.Context, statusId openapi_types.UUID, params *GetLikesParams, reqEditors ...RequestEditorFn) (*GetLikesResponse, error)
+
+	// CreateLike request
+	CreateLikeWithResponse(ctx context.Context, statusId openapi_types.UUID, params *CreateLikeParams, reqEditors ...RequestEditorFn) (*CreateLikeResponse, error)
+
+	// VotePoll request with any body
+	VotePollWithBodyWithResponse(ctx context.Context, statusId openapi_types.UUID, params *VotePollParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VotePollResponse, error)
+
+	VotePollWithResponse(ctx context.Context, statusId openapi_types.UUID, params *VotePollParams, body VotePollJSONRequestBody, reqEditors ...RequestEditorFn) (*VotePollResponse, error)
+
+	// DeleteRepost request
+	DeleteRepostWithResponse(ctx context.Context, statusId openapi_types.UUID, params *DeleteRepostParams, reqEditors ...RequestEditorFn) (*DeleteRepostResponse, error)
+
+	// CreateRepost request
+	CreateRepostWithResponse(ctx context.Context, statusId openapi_types.UUID, params *CreateRepostParams, reqEditors ...RequestEditorFn) (*CreateRepostResponse, error)
+
+	// RestoreStatus request
+	RestoreStatusWithResponse(ctx context.Context, statusId openapi_types.UUID, params *RestoreStatusParams, reqEditors ...RequestEditorFn) (*RestoreStatusResponse, error)
+
+	// CancelScheduledStatus request
+	CancelScheduledStatusWithResponse(ctx context.Context, statusId openapi_types.UUID, params *CancelScheduledStatusParams, reqEditors ...RequestEditorFn) (*CancelScheduledStatusResponse, error)
+
+	// RescheduleStatus request with any body
+	RescheduleStatusWithBodyWithResponse(ctx context.Context, statusId openapi_types.UUID, params *RescheduleStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RescheduleStatusResponse, error)
+
+	RescheduleStatusWithResponse(ctx context.Context, statusId openapi_types.UUID, params *RescheduleStatusParams, body RescheduleStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*RescheduleStatusResponse, error)
+
+	// GetTaggedStatuses request
+	GetTaggedStatusesWithResponse(ctx context.Context, tag string, params *GetTaggedStatusesParams, reqEditors ...RequestEditorFn) (*GetTaggedStatusesResponse, error)
+
+	// GetLikedStatuses request
+	GetLikedStatusesWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetLikedStatusesParams, reqEditors ...RequestEditorFn) (*GetLikedStatusesResponse, error)
+
+	// UnpinStatus request
+	UnpinStatusWithResponse(ctx context.Context, userId openapi_types.UUID, statusId openapi_types.UUID, params *UnpinStatusParams, reqEditors ...RequestEditorFn) (*UnpinStatusResponse, error)
+
+	// PinStatus request
+	PinStatusWithResponse(ctx context.Context, userId openapi_types.UUID, statusId openapi_types.UUID, params *PinStatusParams, reqEditors ...RequestEditorFn) (*PinStatusResponse, error)
+
+	// GetScheduledStatuses request
+	GetScheduledStatusesWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetScheduledStatusesParams, reqEditors ...RequestEditorFn) (*GetScheduledStatusesResponse, error)
+
+	// GetStatuses request
+	GetStatusesWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetStatusesParams, reqEditors ...RequestEditorFn) (*GetStatusesResponse, error)
+}
+
+type CreateStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *StatusResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatusContextResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusContextResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatusContextResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatusContextResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatusHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusHistoryResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatusHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatusHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteLikeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteLikeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteLikeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLikesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LikesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLikesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLikesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateLikeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *StatusResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateLikeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateLikeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VotePollResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PollResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r VotePollResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VotePollResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteRepostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRepostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRepostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRepostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *StatusResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRepostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRepostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelScheduledStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelScheduledStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelScheduledStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RescheduleStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RescheduleStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RescheduleStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaggedStatusesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaggedStatusesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaggedStatusesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLikedStatusesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLikedStatusesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLikedStatusesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnpinStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UnpinStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnpinStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PinStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PinStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PinStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScheduledStatusesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScheduledStatusesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScheduledStatusesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatusesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatusesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatusesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CreateStatusWithBodyWithResponse request with arbitrary body returning *CreateStatusResponse
+func (c *ClientWithResponses) CreateStatusWithBodyWithResponse(ctx context.Context, params *CreateStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateStatusResponse, error) {
+	rsp, err := c.CreateStatusWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateStatusResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateStatusWithResponse(ctx context.Context, params *CreateStatusParams, body CreateStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateStatusResponse, error) {
+	rsp, err := c.CreateStatus(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateStatusResponse(rsp)
+}
+
+// DeleteStatusWithResponse request returning *DeleteStatusResponse
+func (c *ClientWithResponses) DeleteStatusWithResponse(ctx context.Context, statusId openapi_types.UUID, params *DeleteStatusParams, reqEditors ...RequestEditorFn) (*DeleteStatusResponse, error) {
+	rsp, err := c.DeleteStatus(ctx, statusId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteStatusResponse(rsp)
+}
+
+// GetStatusWithResponse request returning *GetStatusResponse
+func (c *ClientWithResponses) GetStatusWithResponse(ctx context.Context, statusId openapi_types.UUID, params *GetStatusParams, reqEditors ...RequestEditorFn) (*GetStatusResponse, error) {
+	rsp, err := c.GetStatus(ctx, statusId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatusResponse(rsp)
+}
+
+// UpdateStatusWithBodyWithResponse request with arbitrary body returning *UpdateStatusResponse
+func (c *ClientWithResponses) UpdateStatusWithBodyWithResponse(ctx context.Context, statusId openapi_types.UUID, params *UpdateStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateStatusResponse, error) {
+	rsp, err := c.UpdateStatusWithBody(ctx, statusId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateStatusResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateStatusWithResponse(ctx context.Context, statusId openapi_types.UUID, params *UpdateStatusParams, body UpdateStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateStatusResponse, error) {
+	rsp, err := c.UpdateStatus(ctx, statusId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateStatusResponse(rsp)
+}
+
+// GetStatusContextWithResponse request returning *GetStatusContextResponse
+func (c *ClientWithResponses) GetStatusContextWithResponse(ctx context.Context, statusId openapi_types.UUID, params *GetStatusContextParams, reqEditors ...RequestEditorFn) (*GetStatusContextResponse, error) {
+	rsp, err := c.GetStatusContext(ctx, statusId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatusContextResponse(rsp)
+}
+
+// GetStatusHistoryWithResponse request returning *GetStatusHistoryResponse
+func (c *ClientWithResponses) GetStatusHistoryWithResponse(ctx context.Context, statusId openapi_types.UUID, params *GetStatusHistoryParams, reqEditors ...RequestEditorFn) (*GetStatusHistoryResponse, error) {
+	rsp, err := c.GetStatusHistory(ctx, statusId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatusHistoryResponse(rsp)
+}
+
+// DeleteLikeWithResponse request returning *DeleteLikeResponse
+func (c *ClientWithResponses) DeleteLikeWithResponse(ctx context.Context, statusId openapi_types.UUID, params *DeleteLikeParams, reqEditors ...RequestEditorFn) (*DeleteLikeResponse, error) {
+	rsp, err := c.DeleteLike(ctx, statusId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteLikeResponse(rsp)
+}
+
+// GetLikesWithResponse request returning *GetLikesResponse
+func (c *ClientWithResponses) GetLikesWithResponse(ctx context.Context, statusId openapi_types.UUID, params *GetLikesParams, reqEditors ...RequestEditorFn) (*GetLikesResponse, error) {
+	rsp, err := c.GetLikes(ctx, statusId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLikesResponse(rsp)
+}
+
+// CreateLikeWithResponse request returning *CreateLikeResponse
+func (c *ClientWithResponses) CreateLikeWithResponse(ctx context.Context, statusId openapi_types.UUID, params *CreateLikeParams, reqEditors ...RequestEditorFn) (*CreateLikeResponse, error) {
+	rsp, err := c.CreateLike(ctx, statusId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateLikeResponse(rsp)
+}
+
+// VotePollWithBodyWithResponse request with arbitrary body returning *VotePollResponse
+func (c *ClientWithResponses) VotePollWithBodyWithResponse(ctx context.Context, statusId openapi_types.UUID, params *VotePollParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VotePollResponse, error) {
+	rsp, err := c.VotePollWithBody(ctx, statusId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVotePollResponse(rsp)
+}
+
+func (c *ClientWithResponses) VotePollWithResponse(ctx context.Context, statusId openapi_types.UUID, params *VotePollParams, body VotePollJSONRequestBody, reqEditors ...RequestEditorFn) (*VotePollResponse, error) {
+	rsp, err := c.VotePoll(ctx, statusId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVotePollResponse(rsp)
+}
+
+// DeleteRepostWithResponse request returning *DeleteRepostResponse
+func (c *ClientWithResponses) DeleteRepostWithResponse(ctx context.Context, statusId openapi_types.UUID, params *DeleteRepostParams, reqEditors ...RequestEditorFn) (*DeleteRepostResponse, error) {
+	rsp, err := c.DeleteRepost(ctx, statusId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRepostResponse(rsp)
+}
+
+// CreateRepostWithResponse request returning *CreateRepostResponse
+func (c *ClientWithResponses) CreateRepostWithResponse(ctx context.Context, statusId openapi_types.UUID, params *CreateRepostParams, reqEditors ...RequestEditorFn) (*CreateRepostResponse, error) {
+	rsp, err := c.CreateRepost(ctx, statusId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRepostResponse(rsp)
+}
+
+// RestoreStatusWithResponse request returning *RestoreStatusResponse
+func (c *ClientWithResponses) RestoreStatusWithResponse(ctx context.Context, statusId openapi_types.UUID, params *RestoreStatusParams, reqEditors ...RequestEditorFn) (*RestoreStatusResponse, error) {
+	rsp, err := c.RestoreStatus(ctx, statusId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreStatusResponse(rsp)
+}
+
+// CancelScheduledStatusWithResponse request returning *CancelScheduledStatusResponse
+func (c *ClientWithResponses) CancelScheduledStatusWithResponse(ctx context.Context, statusId openapi_types.UUID, params *CancelScheduledStatusParams, reqEditors ...RequestEditorFn) (*CancelScheduledStatusResponse, error) {
+	rsp, err := c.CancelScheduledStatus(ctx, statusId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelScheduledStatusResponse(rsp)
+}
+
+// RescheduleStatusWithBodyWithResponse request with arbitrary body returning *RescheduleStatusResponse
+func (c *ClientWithResponses) RescheduleStatusWithBodyWithResponse(ctx context.Context, statusId openapi_types.UUID, params *RescheduleStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RescheduleStatusResponse, error) {
+	rsp, err := c.RescheduleStatusWithBody(ctx, statusId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRescheduleStatusResponse(rsp)
+}
+
+func (c *ClientWithResponses) RescheduleStatusWithResponse(ctx context.Context, statusId openapi_types.UUID, params *RescheduleStatusParams, body RescheduleStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*RescheduleStatusResponse, error) {
+	rsp, err := c.RescheduleStatus(ctx, statusId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRescheduleStatusResponse(rsp)
+}
+
+// GetTaggedStatusesWithResponse request returning *GetTaggedStatusesResponse
+func (c *ClientWithResponses) GetTaggedStatusesWithResponse(ctx context.Context, tag string, params *GetTaggedStatusesParams, reqEditors ...RequestEditorFn) (*GetTaggedStatusesResponse, error) {
+	rsp, err := c.GetTaggedStatuses(ctx, tag, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaggedStatusesResponse(rsp)
+}
+
+// GetLikedStatusesWithResponse request returning *GetLikedStatusesResponse
+func (c *ClientWithResponses) GetLikedStatusesWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetLikedStatusesParams, reqEditors ...RequestEditorFn) (*GetLikedStatusesResponse, error) {
+	rsp, err := c.GetLikedStatuses(ctx, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLikedStatusesResponse(rsp)
+}
+
+// UnpinStatusWithResponse request returning *UnpinStatusResponse
+func (c *ClientWithResponses) UnpinStatusWithResponse(ctx context.Context, userId openapi_types.UUID, statusId openapi_types.UUID, params *UnpinStatusParams, reqEditors ...RequestEditorFn) (*UnpinStatusResponse, error) {
+	rsp, err := c.UnpinStatus(ctx, userId, statusId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnpinStatusResponse(rsp)
+}
+
+// PinStatusWithResponse request returning *PinStatusResponse
+func (c *ClientWithResponses) PinStatusWithResponse(ctx context.Context, userId openapi_types.UUID, statusId openapi_types.UUID, params *PinStatusParams, reqEditors ...RequestEditorFn) (*PinStatusResponse, error) {
+	rsp, err := c.PinStatus(ctx, userId, statusId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePinStatusResponse(rsp)
+}
+
+// GetScheduledStatusesWithResponse request returning *GetScheduledStatusesResponse
+func (c *ClientWithResponses) GetScheduledStatusesWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetScheduledStatusesParams, reqEditors ...RequestEditorFn) (*GetScheduledStatusesResponse, error) {
+	rsp, err := c.GetScheduledStatuses(ctx, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScheduledStatusesResponse(rsp)
+}
+
+// GetStatusesWithResponse request returning *GetStatusesResponse
+func (c *ClientWithResponses) GetStatusesWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetStatusesParams, reqEditors ...RequestEditorFn) (*GetStatusesResponse, error) {
+	rsp, err := c.GetStatuses(ctx, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatusesResponse(rsp)
+}
+
+// ParseCreateStatusResponse parses an HTTP response from a CreateStatusWithResponse call
+func ParseCreateStatusResponse(rsp *http.Response) (*CreateStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest StatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteStatusResponse parses an HTTP response from a DeleteStatusWithResponse call
+func ParseDeleteStatusResponse(rsp *http.Response) (*DeleteStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatusResponse parses an HTTP response from a GetStatusWithResponse call
+func ParseGetStatusResponse(rsp *http.Response) (*GetStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateStatusResponse parses an HTTP response from a UpdateStatusWithResponse call
+func ParseUpdateStatusResponse(rsp *http.Response) (*UpdateStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatusContextResponse parses an HTTP response from a GetStatusContextWithResponse call
+func ParseGetStatusContextResponse(rsp *http.Response) (*GetStatusContextResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatusContextResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusContextResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatusHistoryResponse parses an HTTP response from a GetStatusHistoryWithResponse call
+func ParseGetStatusHistoryResponse(rsp *http.Response) (*GetStatusHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatusHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusHistoryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteLikeResponse parses an HTTP response from a DeleteLikeWithResponse call
+func ParseDeleteLikeResponse(rsp *http.Response) (*DeleteLikeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteLikeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLikesResponse parses an HTTP response from a GetLikesWithResponse call
+func ParseGetLikesResponse(rsp *http.Response) (*GetLikesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLikesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LikesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateLikeResponse parses an HTTP response from a CreateLikeWithResponse call
+func ParseCreateLikeResponse(rsp *http.Response) (*CreateLikeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateLikeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest StatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVotePollResponse parses an HTTP response from a VotePollWithResponse call
+func ParseVotePollResponse(rsp *http.Response) (*VotePollResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VotePollResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PollResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteRepostResponse parses an HTTP response from a DeleteRepostWithResponse call
+func ParseDeleteRepostResponse(rsp *http.Response) (*DeleteRepostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteRepostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON200 = &dest
+
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+	return response, nil
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// ParseCreateRepostResponse parses an HTTP response from a CreateRepostWithResponse call
+func ParseCreateRepostResponse(rsp *http.Response) (*CreateRepostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	response := &CreateRepostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// CreateStatus request with any body
-	CreateStatusWithBodyWithResponse(ctx context.Context, params *CreateStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateStatusResponse, error)
-
-	CreateStatusWithResponse(ctx context.Context, params *CreateStatusParams, body CreateStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateStatusResponse, error)
-
-	// DeleteStatus request
-	DeleteStatusWithResponse(ctx context.Context, statusId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteStatusResponse, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest StatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-	// GetStatus request
-	GetStatusWithResponse(ctx context.Context, statusId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetStatusResponse, error)
+	}
 
-	// GetStatuses request
-	GetStatusesWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetStatusesResponse, error)
+	return response, nil
 }
 
-type CreateStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *StatusResponse
-}
+// ParseRestoreStatusResponse parses an HTTP response from a RestoreStatusWithResponse call
+func ParseRestoreStatusResponse(rsp *http.Response) (*RestoreStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateStatusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &RestoreStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type DeleteStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteStatusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCancelScheduledStatusResponse parses an HTTP response from a CancelScheduledStatusWithResponse call
+func ParseCancelScheduledStatusResponse(rsp *http.Response) (*CancelScheduledStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CancelScheduledStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *StatusResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetStatusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type GetStatusesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *StatusesResponse
-}
+// ParseRescheduleStatusResponse parses an HTTP response from a RescheduleStatusWithResponse call
+func ParseRescheduleStatusResponse(rsp *http.Response) (*RescheduleStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetStatusesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &RescheduleStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetStatusesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
+
+	return response, nil
 }
 
-// CreateStatusWithBodyWithResponse request with arbitrary body returning *CreateStatusResponse
-func (c *ClientWithResponses) CreateStatusWithBodyWithResponse(ctx context.Context, params *CreateStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateStatusResponse, error) {
-	rsp, err := c.CreateStatusWithBody(ctx, params, contentType, body, reqEditors...)
+// ParseGetTaggedStatusesResponse parses an HTTP response from a GetTaggedStatusesWithResponse call
+func ParseGetTaggedStatusesResponse(rsp *http.Response) (*GetTaggedStatusesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateStatusResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateStatusWithResponse(ctx context.Context, params *CreateStatusParams, body CreateStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateStatusResponse, error) {
-	rsp, err := c.CreateStatus(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetTaggedStatusesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateStatusResponse(rsp)
-}
 
-// DeleteStatusWithResponse request returning *DeleteStatusResponse
-func (c *ClientWithResponses) DeleteStatusWithResponse(ctx context.Context, statusId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteStatusResponse, error) {
-	rsp, err := c.DeleteStatus(ctx, statusId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseDeleteStatusResponse(rsp)
+
+	return response, nil
 }
 
-// GetStatusWithResponse request returning *GetStatusResponse
-func (c *ClientWithResponses) GetStatusWithResponse(ctx context.Context, statusId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetStatusResponse, error) {
-	rsp, err := c.GetStatus(ctx, statusId, reqEditors...)
+// ParseGetLikedStatusesResponse parses an HTTP response from a GetLikedStatusesWithResponse call
+func ParseGetLikedStatusesResponse(rsp *http.Response) (*GetLikedStatusesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetStatusResponse(rsp)
-}
 
-// GetStatusesWithResponse request returning *GetStatusesResponse
-func (c *ClientWithResponses) GetStatusesWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetStatusesResponse, error) {
-	rsp, err := c.GetStatuses(ctx, userId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetLikedStatusesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetStatusesResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseCreateStatusResponse parses an HTTP response from a CreateStatusWithResponse call
-func ParseCreateStatusResponse(rsp *http.Response) (*CreateStatusResponse, error) {
+// ParseUnpinStatusResponse parses an HTTP response from a UnpinStatusWithResponse call
+func ParseUnpinStatusResponse(rsp *http.Response) (*UnpinStatusResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateStatusResponse{
+	response := &UnpinStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest StatusResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteStatusResponse parses an HTTP response from a DeleteStatusWithResponse call
-func ParseDeleteStatusResponse(rsp *http.Response) (*DeleteStatusResponse, error) {
+// ParsePinStatusResponse parses an HTTP response from a PinStatusWithResponse call
+func ParsePinStatusResponse(rsp *http.Response) (*PinStatusResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteStatusResponse{
+	response := &PinStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetStatusResponse parses an HTTP response from a GetStatusWithResponse call
-func ParseGetStatusResponse(rsp *http.Response) (*GetStatusResponse, error) {
+// ParseGetScheduledStatusesResponse parses an HTTP response from a GetScheduledStatusesWithResponse call
+func ParseGetScheduledStatusesResponse(rsp *http.Response) (*GetScheduledStatusesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetStatusResponse{
+	response := &GetScheduledStatusesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StatusResponse
+		var dest StatusesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -18,6 +18,11 @@ const (
 
 type Subscriber interface {
 	Subscribe(handler func(ctx context.Context, status Status))
+	SubscribeUpdated(handler func(ctx context.Context, status Status))
+	SubscribeReposted(handler func(ctx context.Context, status Status))
+	SubscribeUnreposted(handler func(ctx context.Context, status Status))
+	SubscribeDeleted(handler func(ctx context.Context, status Status))
+	SubscribeMentioned(handler func(ctx context.Context, event MentionEvent))
 }
 
 type StatusCloudEvent struct {
@@ -25,56 +30,126 @@ type StatusCloudEvent struct {
 	Status Status `json:"data"`
 }
 
+// cloudEvent leaves the data to be decoded by the handler of the topic
+type cloudEvent struct {
+	Id   string          `json:"id"`
+	Data json.RawMessage `json:"data"`
+}
+
+type subscription struct {
+	PubSubName string `json:"pubsubname"`
+	Topic      string `json:"topic"`
+	Routes     string `json:"route"`
+	// Metadata overrides the metadata of the pubsub component for this subscription
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
 type DaprStatusSubscriber struct {
-	router chi.Router
-	logger *zap.Logger
-	route  string
+	router        chi.Router
+	logger        *zap.Logger
+	config        internal.PubSubConfig
+	subscriptions *[]subscription
 }
 
-func getSubscribeHandler(config internal.PubSubConfig, route string) func(w http.ResponseWriter, r *http.Request) {
+func getSubscribeHandler(subscriptions *[]subscription) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
-		subscriptions := []struct {
-			PubSubName string `json:"pubsubname"`
-			Topic      string `json:"topic"`
-			Routes     string `json:"route"`
-		}{
-			{
-				config.Name,
-				config.Topic,
-				route,
-			},
-		}
-
 		render.Status(r, http.StatusOK)
-		render.JSON(w, r, subscriptions)
+		render.JSON(w, r, *subscriptions)
 	}
 }
 
 func NewDaprStatusSubscriber(router chi.Router, logger *zap.Logger, config internal.PubSubConfig) *DaprStatusSubscriber {
-	route := fmt.Sprintf("%s/%s", BaseRoute, config.Topic)
-	router.Get("/dapr/subscribe", getSubscribeHandler(config, route))
+	subscriptions := make([]subscription, 0)
+	router.Get("/dapr/subscribe", getSubscribeHandler(&subscriptions))
 
-	return &DaprStatusSubscriber{router, logger, route}
+	return &DaprStatusSubscriber{router, logger, config, &subscriptions}
 }
 
-// Subscribe Currently there can only be one subscribe handler
+// Subscribe Currently there can only be one subscribe handler per topic
 func (sub *DaprStatusSubscriber) Subscribe(handler func(ctx context.Context, status Status)) {
-	sub.router.Post(sub.route, func(w http.ResponseWriter, r *http.Request) {
+	sub.subscribe(sub.config.Topic, handler)
+}
+
+// SubscribeUpdated subscribes to edited statuses
+func (sub *DaprStatusSubscriber) SubscribeUpdated(handler func(ctx context.Context, status Status)) {
+	sub.subscribe(UpdatedTopic(sub.config.Topic), handler)
+}
+
+// SubscribeReposted subscribes to reposts, Status.Repost is set
+func (sub *DaprStatusSubscriber) SubscribeReposted(handler func(ctx context.Context, status Status)) {
+	sub.subscribe(RepostedTopic(sub.config.Topic), handler)
+}
+
+// SubscribeUnreposted subscribes to undone reposts, Status.Repost is set
+func (sub *DaprStatusSubscriber) SubscribeUnreposted(handler func(ctx context.Context, status Status)) {
+	sub.subscribe(UnrepostedTopic(sub.config.Topic), handler)
+}
+
+// SubscribeDeleted subscribes to deleted statuses, reposts of them are unreposted before
+func (sub *DaprStatusSubscriber) SubscribeDeleted(handler func(ctx context.Context, status Status)) {
+	sub.subscribe(DeletedTopic(sub.config.Topic), handler)
+}
+
+// SubscribeMentioned subscribes to mentions, there is one event for every mentioned user
+func (sub *DaprStatusSubscriber) SubscribeMentioned(handler func(ctx context.Context, event MentionEvent)) {
+	sub.Receive(MentionedTopic(sub.config.Topic), func(ctx context.Context, data json.RawMessage) error {
+		var event MentionEvent
+		err := json.Unmarshal(data, &event)
+		if err != nil {
+			return err
+		}
+		handler(ctx, event)
+		return nil
+	})
+}
+
+func (sub *DaprStatusSubscriber) subscribe(topic string, handler func(ctx context.Context, status Status)) {
+	sub.Receive(topic, func(ctx context.Context, data json.RawMessage) error {
+		var status Status
+		err := json.Unmarshal(data, &status)
+		if err != nil {
+			return err
+		}
+		handler(ctx, status)
+		return nil
+	})
+}
+
+// Receive subscribes handle to any topic of the pubsub, so events of other services can be received next to statuses
+func (sub *DaprStatusSubscriber) Receive(topic string, handle func(ctx context.Context, data json.RawMessage) error) {
+	sub.receive(topic, nil, handle)
+}
+
+// ReceiveEach subscribes handle to a topic like Receive, but every replica receives all events instead of sharing them.
+// consumerId has to be different for every replica, like the hostname.
+func (sub *DaprStatusSubscriber) ReceiveEach(topic string, consumerId string, handle func(ctx context.Context, data json.RawMessage) error) {
+	sub.receive(topic, map[string]string{"consumerID": consumerId}, handle)
+}
+
+func (sub *DaprStatusSubscriber) receive(topic string, metadata map[string]string, handle func(ctx context.Context, data json.RawMessage) error) {
+	route := fmt.Sprintf("%s/%s", BaseRoute, topic)
+	*sub.subscriptions = append(*sub.subscriptions, subscription{sub.config.Name, topic, route, metadata})
+
+	sub.router.Post(route, func(w http.ResponseWriter, r *http.Request) {
 		//TODO: Do this in middleware of router
 		trace := r.Header.Get("Traceparent")
 		ctx := context.Background()
 		if trace != "" {
 			ctx = context.WithValue(ctx, internal.ContextKeyTraceParent, trace)
 		}
-		cloudEvent := &StatusCloudEvent{}
+		event := &cloudEvent{}
 		var bodyBytes []byte
 		bodyBytes, _ = io.ReadAll(r.Body)
-		err := json.Unmarshal(bodyBytes, &cloudEvent)
+		err := json.Unmarshal(bodyBytes, &event)
 		if err != nil {
 			// Shouldn't normally happen when using dapr to publish and subscribe
 			sub.logger.DPanic("message not a cloudevent", zap.Error(err))
+		} else {
+			err = handle(ctx, event.Data)
+			if err != nil {
+				sub.logger.Error("failed to handle event", zap.String("topic", topic), zap.Error(err))
+			}
 		}
-		handler(ctx, cloudEvent.Status)
 		render.Status(r, http.StatusOK)
 	})
 }
@@ -4,11 +4,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -31,7 +34,7 @@ func TestDaprStatusSubscriber_SubscribeHandler(t *testing.T) {
 	route := fmt.Sprintf("%s/%s", BaseRoute, config.Topic)
 
 	r := chi.NewRouter()
-	r.Get("/dapr/subscribe", getSubscribeHandler(config, route))
+	r.Get("/dapr/subscribe", getSubscribeHandler(&[]subscription{{config.Name, config.Topic, route, nil}}))
 
 	// When
 	r.ServeHTTP(recorder, req)
@@ -104,3 +107,147 @@ func TestDaprStatusSubscriber_Subscribe(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rr.Code)
 	assert.True(t, handlerCalled, "Handler function should be called with the expected Status")
 }
+
+func TestDaprStatusSubscriber_SubscribeUpdated(t *testing.T) {
+	// Given
+	router := chi.NewRouter()
+	config := internal.PubSubConfig{
+		Name:  "pubsub",
+		Topic: "status",
+	}
+	sub := NewDaprStatusSubscriber(router, zap.NewNop(), config)
+
+	expectedStatus := Status{
+		Id:      uuid.New(),
+		Content: "Hello edited world",
+		UserId:  uuid.New(),
+	}
+	var created, updated bool
+	sub.Subscribe(func(ctx context.Context, status Status) {
+		created = true
+	})
+	sub.SubscribeUpdated(func(ctx context.Context, status Status) {
+		updated = assert.Equal(t, expectedStatus, status)
+	})
+
+	// When
+	eventBytes, _ := json.Marshal(StatusCloudEvent{Id: uuid.New().String(), Status: expectedStatus})
+	req, err := http.NewRequest("POST", "/internal/pubsub/receive/status.updated", bytes.NewBuffer(eventBytes))
+	assert.NoError(t, err)
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	subscribeReq, err := http.NewRequest("GET", "/dapr/subscribe", nil)
+	assert.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, subscribeReq)
+
+	// Then
+	assert.True(t, updated, "Updated handler should be called with the expected Status")
+	assert.False(t, created, "Created handler should not be called for an update")
+
+	var subscriptions []subscription
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &subscriptions))
+	assert.Equal(t, []subscription{
+		{"pubsub", "status", "/internal/pubsub/receive/status", nil},
+		{"pubsub", "status.updated", "/internal/pubsub/receive/status.updated", nil},
+	}, subscriptions)
+}
+
+func TestDaprStatusSubscriber_SubscribeMentioned(t *testing.T) {
+	// Given
+	router := chi.NewRouter()
+	config := internal.PubSubConfig{
+		Name:  "pubsub",
+		Topic: "status",
+	}
+	sub := NewDaprStatusSubscriber(router, zap.NewNop(), config)
+
+	mentionedId := uuid.New()
+	expectedEvent := MentionEvent{
+		UserId: mentionedId,
+		Status: Status{
+			Id:       uuid.New(),
+			Content:  "Hello @Hans",
+			UserId:   uuid.New(),
+			Mentions: []Mention{{UserId: mentionedId, Username: "Hans", Start: 6, End: 11}},
+		},
+	}
+	var mentioned bool
+	sub.SubscribeMentioned(func(ctx context.Context, event MentionEvent) {
+		mentioned = assert.Equal(t, expectedEvent, event)
+	})
+
+	// When
+	eventBytes, _ := json.Marshal(map[string]any{"id": uuid.New().String(), "data": expectedEvent})
+	req, err := http.NewRequest("POST", "/internal/pubsub/receive/status.mentioned", bytes.NewBuffer(eventBytes))
+	assert.NoError(t, err)
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	// Then
+	assert.True(t, mentioned, "Mentioned handler should be called with the expected event")
+}
+
+func TestDaprStatusSubscriber_ReceiveEach(t *testing.T) {
+	// Given
+	router := chi.NewRouter()
+	config := internal.PubSubConfig{
+		Name:  "pubsub",
+		Topic: "status",
+	}
+	sub := NewDaprStatusSubscriber(router, zap.NewNop(), config)
+
+	var received json.RawMessage
+	sub.ReceiveEach("timeline", "replica-1", func(ctx context.Context, data json.RawMessage) error {
+		received = data
+		return nil
+	})
+
+	// When
+	eventBytes, _ := json.Marshal(map[string]any{"id": uuid.New().String(), "data": map[string]string{"type": "update"}})
+	req, err := http.NewRequest("POST", "/internal/pubsub/receive/timeline", bytes.NewBuffer(eventBytes))
+	assert.NoError(t, err)
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	subscribeReq, err := http.NewRequest("GET", "/dapr/subscribe", nil)
+	assert.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, subscribeReq)
+
+	// Then
+	assert.JSONEq(t, `{"type":"update"}`, string(received))
+
+	var subscriptions []subscription
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &subscriptions))
+	assert.Equal(t, []subscription{
+		{"pubsub", "timeline", "/internal/pubsub/receive/timeline", map[string]string{"consumerID": "replica-1"}},
+	}, subscriptions)
+}
+
+func TestDaprStatusSubscriber_ReceiveHandlerFails(t *testing.T) {
+	// Given
+	router := chi.NewRouter()
+	config := internal.PubSubConfig{
+		Name:  "pubsub",
+		Topic: "status",
+	}
+	core, logs := observer.New(zapcore.DebugLevel)
+	sub := NewDaprStatusSubscriber(router, zap.New(core), config)
+
+	sub.Receive("timeline", func(ctx context.Context, data json.RawMessage) error {
+		return errors.New("handler failed")
+	})
+
+	// When
+	eventBytes, _ := json.Marshal(map[string]any{"id": uuid.New().String(), "data": map[string]string{"type": "update"}})
+	req, err := http.NewRequest("POST", "/internal/pubsub/receive/timeline", bytes.NewBuffer(eventBytes))
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, rr.Code)
+	entries := logs.AllUntimed()
+	assert.Len(t, entries, 1)
+	assert.Equal(t, zapcore.ErrorLevel, entries[0].Level)
+	assert.Equal(t, "timeline", entries[0].ContextMap()["topic"])
+}
@@ -0,0 +1,29 @@
+package statuses
+
+import "fmt"
+
+// UpdatedTopic is the topic edited statuses are published to, next to the topic of created statuses. An edited status
+// is published once more for each reposter with the repost attribution.
+func UpdatedTopic(topic string) string {
+	return fmt.Sprintf("%s.updated", topic)
+}
+
+// RepostedTopic is the topic reposts are published to, the status carries the repost attribution
+func RepostedTopic(topic string) string {
+	return fmt.Sprintf("%s.reposted", topic)
+}
+
+// DeletedTopic is the topic deleted statuses are published to
+func DeletedTopic(topic string) string {
+	return fmt.Sprintf("%s.deleted", topic)
+}
+
+// MentionedTopic is the topic mention events are published to, one per mentioned user
+func MentionedTopic(topic string) string {
+	return fmt.Sprintf("%s.mentioned", topic)
+}
+
+// UnrepostedTopic is the topic undone reposts are published to, the status carries the repost attribution
+func UnrepostedTopic(topic string) string {
+	return fmt.Sprintf("%s.unreposted", topic)
+}
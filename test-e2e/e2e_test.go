@@ -88,7 +88,7 @@ func TestCreateStatus(t *testing.T) {
 
 	// User 1 Get Timeline
 	timeline, err := timelineClient.
-		GetTimeline(context.WithValue(ctx, internal.ContextKeyAuthorization, jwtUser1), user1.Id)
+		GetTimeline(context.WithValue(ctx, internal.ContextKeyAuthorization, jwtUser1), user1.Id, timelines.Query{Limit: statuses.DefaultPageLimit})
 	assert.NoError(t, err)
 
 	// User 1 Timeline Contains Status of User 2
@@ -4,11 +4,13 @@ import (
 	"context"
 	dapr "github.com/dapr/go-sdk/client"
 	"go.uber.org/zap"
+	"os"
 	"strconv"
 	"yatc/internal"
 	"yatc/status/pkg"
 	"yatc/timeline/internal"
 	"yatc/user/pkg/followers"
+	"yatc/user/pkg/users"
 )
 
 func main() {
@@ -25,14 +27,18 @@ func main() {
 
 	repo := timelines.NewDaprRepo(client, config.Dapr.StateStore) //timelines.NewInMemoryRepo()
 	followerClient := followers.NewFollowerClient(config.Dapr)
-	service := timelines.NewTimelineService(repo, followerClient, client, config.Dapr.PubSub)
-	api := timelines.NewTimelineApi(service)
+	userClient := users.NewUserClient(config.Dapr)
+	statusClient := statuses.NewStatusClient(config.Dapr)
+	hub := timelines.NewHub()
+	service := timelines.NewTimelineService(repo, followerClient, userClient, statusClient, client, config.Dapr.PubSub, config.Timeline, hub)
+	api := timelines.NewTimelineApi(service, service)
 
 	port, err := strconv.Atoi(config.Port)
 	if err != nil {
 		logger.Fatal("port not a int", zap.String("port", config.Port))
 	}
 	server := internal.NewServer(logger, port)
+	server.OnShutdown(hub.Shutdown)
 
 	server.Router.Route("/", api.ConfigureRouter)
 
@@ -42,7 +48,56 @@ func main() {
 		if err != nil {
 			logger.Error("updating timelines", zap.Error(err), zap.Any("status", status))
 		}
+		err = service.AddToPublic(ctx, status)
+		if err != nil {
+			logger.Error("adding status to public timeline", zap.Error(err), zap.Any("status", status))
+		}
+	})
+	subscriber.SubscribeUpdated(func(ctx context.Context, status statuses.Status) {
+		err := service.ReplaceStatus(ctx, status)
+		if err != nil {
+			logger.Error("replacing edited status in timelines", zap.Error(err), zap.Any("status", status))
+		}
+	})
+	subscriber.SubscribeReposted(func(ctx context.Context, status statuses.Status) {
+		err := service.UpdateTimelines(ctx, status.Repost.UserId, status)
+		if err != nil {
+			logger.Error("updating timelines with repost", zap.Error(err), zap.Any("status", status))
+		}
+	})
+	subscriber.SubscribeUnreposted(func(ctx context.Context, status statuses.Status) {
+		err := service.RemoveRepost(ctx, status)
+		if err != nil {
+			logger.Error("removing repost from timelines", zap.Error(err), zap.Any("status", status))
+		}
+	})
+	subscriber.SubscribeDeleted(func(ctx context.Context, status statuses.Status) {
+		err := service.RemoveStatus(ctx, status)
+		if err != nil {
+			logger.Error("removing deleted status from timelines", zap.Error(err), zap.Any("status", status))
+		}
+	})
+
+	followSubscriber := followers.NewDaprFollowSubscriber(subscriber)
+	followSubscriber.SubscribeFollowed(func(ctx context.Context, event followers.FollowEvent) {
+		err := service.BackfillFollowee(ctx, event)
+		if err != nil {
+			logger.Error("backfilling timeline with followed user", zap.Error(err), zap.Any("event", event))
+		}
 	})
+	followSubscriber.SubscribeUnfollowed(func(ctx context.Context, event followers.FollowEvent) {
+		err := service.RemoveFollowee(ctx, event)
+		if err != nil {
+			logger.Error("removing unfollowed user from timeline", zap.Error(err), zap.Any("event", event))
+		}
+	})
+
+	// Streams of a user can be open on any replica, so every replica receives all timeline events
+	hostname, err := os.Hostname()
+	if err != nil {
+		logger.Fatal("no hostname to receive timeline events with", zap.Error(err))
+	}
+	hub.Subscribe(subscriber, hostname)
 
 	server.StartAndWait()
 }
@@ -2,20 +2,40 @@ package timelines
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"golang.org/x/net/websocket"
+	"io"
 	"net/http"
+	"strings"
+	"time"
 	"yatc/internal"
 	statuses "yatc/status/pkg"
 	timelines "yatc/timeline/pkg"
 )
 
+var NotOwnTimelineError = errors.New("only the user can read their timeline")
+
+// keepAliveInterval is how often an idle stream sends something, so proxies don't close it
+const keepAliveInterval = 30 * time.Second
+
+// Streams opens streams of timeline events
+type Streams interface {
+	OpenStream(ctx context.Context, userId uuid.UUID, lastEventId *statuses.Cursor) (*Stream, []statuses.Status, error)
+	CloseStream(stream *Stream)
+}
+
 type Api struct {
 	service timelines.Service
+	streams Streams
 }
 
-func NewTimelineApi(service timelines.Service) *Api {
-	return &Api{service}
+func NewTimelineApi(service timelines.Service, streams Streams) *Api {
+	return &Api{service, streams}
 }
 
 func (api *Api) ConfigureRouter(router chi.Router) {
@@ -34,14 +54,83 @@ func TimelineResponseFromTimeline(timeline timelines.Timeline) TimelineResponse
 		statusResponses[i] = statuses.StatusResponseFromStatus(status)
 	}
 
-	return TimelineResponse{
-		Id:       timeline.UserId,
-		Statuses: statusResponses,
+	response := TimelineResponse{
+		Id:                    timeline.UserId,
+		Statuses:              statusResponses,
+		ExpandContentWarnings: timeline.ExpandContentWarnings,
+	}
+	if timeline.Next != nil {
+		response.Next = internal.Ptr(timeline.Next.String())
+	}
+	if timeline.Prev != nil {
+		response.Prev = internal.Ptr(timeline.Prev.String())
+	}
+	return response
+}
+
+func PublicTimelineResponseFromTimeline(timeline timelines.Timeline) PublicTimelineResponse {
+	response := TimelineResponseFromTimeline(timeline)
+	return PublicTimelineResponse{Statuses: response.Statuses, Next: response.Next, Prev: response.Prev}
+}
+
+func queryOf(limit *int, maxId *string, sinceId *string) (timelines.Query, error) {
+	query := timelines.Query{Limit: statuses.DefaultPageLimit}
+
+	if limit != nil {
+		if *limit < 1 || *limit > statuses.MaxPageLimit {
+			return timelines.Query{}, fmt.Errorf("limit has to be between 1 and %d", statuses.MaxPageLimit)
+		}
+		query.Limit = *limit
+	}
+
+	if maxId != nil {
+		cursor, err := statuses.ParseCursor(*maxId)
+		if err != nil {
+			return timelines.Query{}, err
+		}
+		query.MaxId = &cursor
+	}
+
+	if sinceId != nil {
+		cursor, err := statuses.ParseCursor(*sinceId)
+		if err != nil {
+			return timelines.Query{}, err
+		}
+		query.SinceId = &cursor
+	}
+
+	return query, nil
+}
+
+func (api *Api) GetPublicTimeline(w http.ResponseWriter, r *http.Request, params GetPublicTimelineParams) {
+	query, err := queryOf(params.Limit, params.MaxId, params.SinceId)
+	if err != nil {
+		internal.ReplyWithError(w, r, err, http.StatusBadRequest)
+		return
+	}
+
+	timeline, err := api.service.GetPublicTimeline(context.Background(), query)
+	if err != nil {
+		internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		return
 	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, PublicTimelineResponseFromTimeline(timeline))
 }
 
-func (api *Api) GetTimeline(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
-	timeline, err := api.service.GetTimeline(context.Background(), userId)
+func (api *Api) GetTimeline(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params GetTimelineParams) {
+	if params.XUser != userId {
+		internal.ReplyWithError(w, r, NotOwnTimelineError, http.StatusForbidden)
+		return
+	}
+
+	query, err := queryOf(params.Limit, params.MaxId, params.SinceId)
+	if err != nil {
+		internal.ReplyWithError(w, r, err, http.StatusBadRequest)
+		return
+	}
+
+	timeline, err := api.service.GetTimeline(context.Background(), userId, query)
 	if err != nil {
 		internal.ReplyWithError(w, r, err, http.StatusNotFound)
 		return
@@ -50,8 +139,19 @@ func (api *Api) GetTimeline(w http.ResponseWriter, r *http.Request, userId opena
 	internal.ReplyWithStatusOkWithJSON(w, r, TimelineResponseFromTimeline(timeline))
 }
 
-func (api *Api) GetTimelineV1(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
-	timeline, err := api.service.GetTimeline(context.Background(), userId)
+func (api *Api) GetTimelineV1(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params GetTimelineV1Params) {
+	if params.XUser != userId {
+		internal.ReplyWithError(w, r, NotOwnTimelineError, http.StatusForbidden)
+		return
+	}
+
+	query, err := queryOf(params.Limit, params.MaxId, params.SinceId)
+	if err != nil {
+		internal.ReplyWithError(w, r, err, http.StatusBadRequest)
+		return
+	}
+
+	timeline, err := api.service.GetTimeline(context.Background(), userId, query)
 	if err != nil {
 		internal.ReplyWithError(w, r, err, http.StatusNotFound)
 		return
@@ -59,3 +159,150 @@ func (api *Api) GetTimelineV1(w http.ResponseWriter, r *http.Request, userId ope
 
 	internal.ReplyWithStatusOkWithJSON(w, r, TimelineResponseFromTimeline(timeline))
 }
+
+// streamMessage is an event sent to a stream, over a websocket it is sent as is
+type streamMessage struct {
+	Event timelines.EventType `json:"event"`
+	// Id is the cursor of the status of update events, it is what a client resumes from
+	Id      string                  `json:"id,omitempty"`
+	Payload statuses.StatusResponse `json:"payload"`
+}
+
+func streamMessageOf(eventType timelines.EventType, status statuses.Status) streamMessage {
+	message := streamMessage{Event: eventType, Payload: statuses.StatusResponseFromStatus(status)}
+	if eventType == timelines.UpdateEvent {
+		message.Id = statuses.CursorOf(status).String()
+	}
+	return message
+}
+
+func (api *Api) StreamTimeline(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params StreamTimelineParams) {
+	if params.XUser != userId {
+		internal.ReplyWithError(w, r, NotOwnTimelineError, http.StatusForbidden)
+		return
+	}
+
+	lastEventId := params.LastEventID
+	if lastEventId == nil {
+		lastEventId = params.LastEventId
+	}
+	var since *statuses.Cursor
+	if lastEventId != nil {
+		cursor, err := statuses.ParseCursor(*lastEventId)
+		if err != nil {
+			internal.ReplyWithError(w, r, err, http.StatusBadRequest)
+			return
+		}
+		since = &cursor
+	}
+
+	stream, missed, err := api.streams.OpenStream(r.Context(), userId, since)
+	if err != nil {
+		internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		return
+	}
+	defer api.streams.CloseStream(stream)
+
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		websocket.Server{Handler: func(conn *websocket.Conn) {
+			streamToWebSocket(conn, stream, missed)
+		}}.ServeHTTP(w, r)
+		return
+	}
+	streamServerSentEvents(w, r, stream, missed)
+}
+
+func streamServerSentEvents(w http.ResponseWriter, r *http.Request, stream *Stream, missed []statuses.Status) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		internal.ReplyWithError(w, r, errors.New("streaming not supported"), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	send := func(message streamMessage) error {
+		data, err := json.Marshal(message.Payload)
+		if err != nil {
+			return err
+		}
+		if message.Id != "" {
+			_, err = fmt.Fprintf(w, "id: %s\n", message.Id)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", message.Event, data)
+		flusher.Flush()
+		return err
+	}
+	keepAlive := func() error {
+		_, err := io.WriteString(w, ":\n\n")
+		flusher.Flush()
+		return err
+	}
+	relay(r.Context().Done(), stream, missed, send, keepAlive)
+}
+
+func streamToWebSocket(conn *websocket.Conn, stream *Stream, missed []statuses.Status) {
+	defer conn.Close()
+
+	// Nothing is expected from the client, reading only notices when it is gone
+	gone := make(chan struct{})
+	go func() {
+		_, _ = io.Copy(io.Discard, conn)
+		close(gone)
+	}()
+
+	send := func(message streamMessage) error {
+		return websocket.JSON.Send(conn, message)
+	}
+	keepAlive := func() error {
+		conn.PayloadType = websocket.PingFrame
+		defer func() { conn.PayloadType = websocket.TextFrame }()
+		_, err := conn.Write([]byte{})
+		return err
+	}
+	relay(gone, stream, missed, send, keepAlive)
+}
+
+// relay sends the missed statuses and then the events of a stream until done is closed, the stream is closed or
+// sending fails. Updates of statuses that were missed already are skipped.
+func relay(done <-chan struct{}, stream *Stream, missed []statuses.Status, send func(message streamMessage) error, keepAlive func() error) {
+	sent := internal.NewSet[string]()
+	for _, status := range missed {
+		message := streamMessageOf(timelines.UpdateEvent, status)
+		if send(message) != nil {
+			return
+		}
+		sent.Add(message.Id)
+	}
+
+	ticker := time.NewTicker(keepAliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if keepAlive() != nil {
+				return
+			}
+		case event, ok := <-stream.Events():
+			if !ok {
+				return
+			}
+			message := streamMessageOf(event.Type, event.Status)
+			if message.Id != "" && sent.Has(message.Id) {
+				continue
+			}
+			if send(message) != nil {
+				return
+			}
+		}
+	}
+}
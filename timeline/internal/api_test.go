@@ -0,0 +1,103 @@
+package timelines
+
+import (
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"yatc/internal"
+	statuses "yatc/status/pkg"
+	timelines "yatc/timeline/pkg"
+)
+
+func TestApi_GetTimeline(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	service := newTestService(repo, NewMockFollowerService(), NewMockStatusService(), &MockPubSub{}, testTimelineConfig)
+	api := NewTimelineApi(service, service)
+	userId := uuid.New()
+	status := newTestStatus(uuid.New(), time.Now().UTC())
+	_, err := repo.Save(timelines.Timeline{UserId: userId, Statuses: []statuses.Status{status}})
+	assert.NoError(t, err)
+
+	router := chi.NewRouter()
+	api.ConfigureRouter(router)
+
+	for _, path := range []string{"/timelines/", "/v1/timelines/"} {
+		req, err := http.NewRequest(http.MethodGet, path+userId.String(), nil)
+		assert.NoError(t, err)
+		req.Header.Set("X-user", userId.String())
+
+		rr := httptest.NewRecorder()
+
+		// WHEN
+		router.ServeHTTP(rr, req)
+
+		// THEN
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var timelineResponse TimelineResponse
+		err = json.NewDecoder(rr.Body).Decode(&timelineResponse)
+		assert.NoError(t, err)
+		assert.Equal(t, userId, timelineResponse.Id)
+		assert.Len(t, timelineResponse.Statuses, 1)
+		assert.Equal(t, status.Id, timelineResponse.Statuses[0].Id)
+	}
+}
+
+func TestApi_GetTimeline_NotOwnTimeline(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	service := newTestService(repo, NewMockFollowerService(), NewMockStatusService(), &MockPubSub{}, testTimelineConfig)
+	api := NewTimelineApi(service, service)
+	userId := uuid.New()
+	_, err := repo.Save(timelines.Timeline{UserId: userId, Statuses: []statuses.Status{newTestStatus(uuid.New(), time.Now().UTC())}})
+	assert.NoError(t, err)
+
+	router := chi.NewRouter()
+	api.ConfigureRouter(router)
+
+	for _, path := range []string{"/timelines/", "/v1/timelines/"} {
+		req, err := http.NewRequest(http.MethodGet, path+userId.String(), nil)
+		assert.NoError(t, err)
+		req.Header.Set("X-user", uuid.New().String())
+
+		rr := httptest.NewRecorder()
+
+		// WHEN
+		router.ServeHTTP(rr, req)
+
+		// THEN
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	}
+}
+
+func TestApi_StreamTimeline_NotOwnTimeline(t *testing.T) {
+	// GIVEN
+	hub := NewHub()
+	service := NewTimelineService(NewInMemoryRepo(), NewMockFollowerService(), MockUserService{}, NewMockStatusService(), &MockPubSub{}, internal.PubSubConfig{}, testTimelineConfig, hub)
+	api := NewTimelineApi(service, service)
+	userId := uuid.New()
+
+	router := chi.NewRouter()
+	api.ConfigureRouter(router)
+
+	req, err := http.NewRequest(http.MethodGet, "/timelines/"+userId.String()+"/stream", nil)
+	assert.NoError(t, err)
+	req.Header.Set("X-user", uuid.New().String())
+
+	rr := httptest.NewRecorder()
+
+	// WHEN
+	router.ServeHTTP(rr, req)
+
+	// THEN
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	hub.mutex.Lock()
+	defer hub.mutex.Unlock()
+	assert.Empty(t, hub.streams)
+}
@@ -3,17 +3,38 @@ package timelines
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	dapr "github.com/dapr/go-sdk/client"
 	"github.com/google/uuid"
+	"sync"
 	"yatc/internal"
+	statuses "yatc/status/pkg"
 	timelines "yatc/timeline/pkg"
 )
 
 type Repository interface {
 	Get(userId uuid.UUID) (timelines.Timeline, error)
 	Save(timeline timelines.Timeline) (timelines.Timeline, error)
+	// GetPulledAuthors returns the authors whose statuses are merged into timelines when they are read
+	GetPulledAuthors() (internal.Set[uuid.UUID], error)
+	AddPulledAuthor(userId uuid.UUID) error
+	// GetPublic returns the timeline of all public statuses, it is empty until the first one is added
+	GetPublic() (timelines.Timeline, error)
+	// ChangePublic applies change to the public timeline and saves it if change reports a change
+	ChangePublic(change func(timeline *timelines.Timeline) bool) error
 }
 
+// pulledAuthorsKey is the set of authors whose statuses are not pushed to timelines
+const pulledAuthorsKey = "pulled-authors"
+
+// publicTimelineKey is the timeline of all public statuses, shared by all users
+const publicTimelineKey = "public"
+
+const maxWriteAttempts = 5
+
+var concurrentWriteError = errors.New("state changed since it was read")
+
 type DaprStateStoreRepo struct {
 	dapr   dapr.Client
 	config internal.StateStoreConfig
@@ -54,23 +75,167 @@ func (repo *DaprStateStoreRepo) Save(timeline timelines.Timeline) (timelines.Tim
 	return timeline, nil
 }
 
+func (repo *DaprStateStoreRepo) GetPulledAuthors() (internal.Set[uuid.UUID], error) {
+	item, err := repo.dapr.GetState(context.Background(), repo.config.Name, pulledAuthorsKey, nil)
+	if err != nil {
+		return internal.Set[uuid.UUID]{}, err
+	}
+
+	authors := internal.NewSet[uuid.UUID]()
+	if item.Value == nil {
+		return authors, nil
+	}
+	err = json.Unmarshal(item.Value, &authors)
+	if err != nil {
+		return internal.Set[uuid.UUID]{}, err
+	}
+	return authors, nil
+}
+
+// AddPulledAuthor isn't guarded against concurrent adds, an author lost to one is added again with their next status
+func (repo *DaprStateStoreRepo) AddPulledAuthor(userId uuid.UUID) error {
+	authors, err := repo.GetPulledAuthors()
+	if err != nil {
+		return err
+	}
+	if authors.Has(userId) {
+		return nil
+	}
+	authors.Add(userId)
+
+	authorsJson, err := json.Marshal(&authors)
+	if err != nil {
+		return err
+	}
+	return repo.dapr.SaveState(context.Background(), repo.config.Name, pulledAuthorsKey, authorsJson, nil)
+}
+
+func (repo *DaprStateStoreRepo) GetPublic() (timelines.Timeline, error) {
+	timeline, _, err := repo.getPublicWithEtag(context.Background())
+	return timeline, err
+}
+
+// ChangePublic saves the public timeline with its etag, every replica writes it, so conflicting changes are retried
+func (repo *DaprStateStoreRepo) ChangePublic(change func(timeline *timelines.Timeline) bool) error {
+	var err error
+	for attempt := 0; attempt < maxWriteAttempts; attempt++ {
+		ctx := context.Background()
+		var timeline timelines.Timeline
+		var etag string
+		timeline, etag, err = repo.getPublicWithEtag(ctx)
+		if err != nil {
+			return err
+		}
+		if !change(&timeline) {
+			return nil
+		}
+
+		var timelineJson []byte
+		timelineJson, err = json.Marshal(timeline)
+		if err != nil {
+			return err
+		}
+
+		err = repo.dapr.SaveStateWithETag(ctx, repo.config.Name, publicTimelineKey, timelineJson, etag, nil,
+			dapr.WithConcurrency(dapr.StateConcurrencyFirstWrite))
+		if err == nil {
+			return nil
+		}
+		// Dapr doesn't tell etag mismatches apart from other failures
+		err = fmt.Errorf("%w: %v", concurrentWriteError, err)
+	}
+	return err
+}
+
+func (repo *DaprStateStoreRepo) getPublicWithEtag(ctx context.Context) (timelines.Timeline, string, error) {
+	item, err := repo.dapr.GetState(ctx, repo.config.Name, publicTimelineKey, nil)
+	if err != nil {
+		return timelines.Timeline{}, "", err
+	}
+	if item.Value == nil {
+		return timelines.Timeline{Statuses: []statuses.Status{}}, item.Etag, nil
+	}
+
+	var timeline timelines.Timeline
+	err = json.Unmarshal(item.Value, &timeline)
+	if err != nil {
+		return timelines.Timeline{}, "", err
+	}
+	return timeline, item.Etag, nil
+}
+
 type InMemoryRepo struct {
-	Timelines map[uuid.UUID]timelines.Timeline
+	mutex         sync.RWMutex
+	Timelines     map[uuid.UUID]timelines.Timeline
+	PulledAuthors internal.Set[uuid.UUID]
+	Public        *timelines.Timeline
 }
 
 func NewInMemoryRepo() *InMemoryRepo {
-	return &InMemoryRepo{Timelines: map[uuid.UUID]timelines.Timeline{}}
+	return &InMemoryRepo{
+		Timelines:     map[uuid.UUID]timelines.Timeline{},
+		PulledAuthors: internal.NewSet[uuid.UUID](),
+		Public:        &timelines.Timeline{Statuses: []statuses.Status{}},
+	}
 }
 
-func (repo InMemoryRepo) Get(userId uuid.UUID) (timelines.Timeline, error) {
-	allTimelines, ok := repo.Timelines[userId]
+// Get returns a copy, so changes to it don't reach the stored timeline before it is saved
+func (repo *InMemoryRepo) Get(userId uuid.UUID) (timelines.Timeline, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	timeline, ok := repo.Timelines[userId]
 	if !ok {
 		return timelines.Timeline{}, internal.NotFoundError(userId)
 	}
-	return allTimelines, nil
+	timeline.Statuses = append([]statuses.Status{}, timeline.Statuses...)
+	return timeline, nil
 }
 
-func (repo InMemoryRepo) Save(timeline timelines.Timeline) (timelines.Timeline, error) {
+func (repo *InMemoryRepo) Save(timeline timelines.Timeline) (timelines.Timeline, error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
 	repo.Timelines[timeline.UserId] = timeline
 	return timeline, nil
 }
+
+func (repo *InMemoryRepo) GetPulledAuthors() (internal.Set[uuid.UUID], error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	return *internal.SetOf(repo.PulledAuthors.ToArray()...), nil
+}
+
+func (repo *InMemoryRepo) AddPulledAuthor(userId uuid.UUID) error {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	repo.PulledAuthors.Add(userId)
+	return nil
+}
+
+func (repo *InMemoryRepo) GetPublic() (timelines.Timeline, error) {
+	repo.mutex.RLock()
+	defer repo.mutex.RUnlock()
+
+	return repo.getPublic(), nil
+}
+
+func (repo *InMemoryRepo) ChangePublic(change func(timeline *timelines.Timeline) bool) error {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	timeline := repo.getPublic()
+	if change(&timeline) {
+		*repo.Public = timeline
+	}
+	return nil
+}
+
+// getPublic copies the public timeline, the mutex has to be held
+func (repo *InMemoryRepo) getPublic() timelines.Timeline {
+	timeline := *repo.Public
+	timeline.Statuses = append([]statuses.Status{}, repo.Public.Statuses...)
+	return timeline
+}
@@ -5,55 +5,592 @@ import (
 	"errors"
 	dapr "github.com/dapr/go-sdk/client"
 	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+	"sync"
 	"yatc/internal"
 	statuses "yatc/status/pkg"
 	timelines "yatc/timeline/pkg"
 	"yatc/user/pkg/followers"
+	"yatc/user/pkg/users"
 )
 
 type Service struct {
 	repo            Repository
 	followerService followers.Service
+	userService     users.Service
+	statusService   statuses.Service
 	client          dapr.Client
 	config          internal.PubSubConfig
+	timelineConfig  internal.TimelineConfig
+	hub             *Hub
 }
 
-func NewTimelineService(repo Repository, followerService followers.Service, client dapr.Client, config internal.PubSubConfig) *Service {
-	return &Service{repo, followerService, client, config}
+func NewTimelineService(repo Repository, followerService followers.Service, userService users.Service, statusService statuses.Service, client dapr.Client, config internal.PubSubConfig, timelineConfig internal.TimelineConfig, hub *Hub) *Service {
+	return &Service{repo, followerService, userService, statusService, client, config, timelineConfig, hub}
 }
 
-func (timelineService *Service) GetTimeline(ctx context.Context, userId uuid.UUID) (timelines.Timeline, error) {
-	return timelineService.repo.Get(userId)
+// GetTimeline returns a page of the timeline of a user, the statuses of followed pulled authors are merged in
+func (timelineService *Service) GetTimeline(ctx context.Context, userId uuid.UUID, query timelines.Query) (timelines.Timeline, error) {
+	timeline, err := timelineService.repo.Get(userId)
+	notFound := errors.Is(err, internal.NotFoundError(userId))
+	if err != nil && !notFound {
+		return timelines.Timeline{}, err
+	}
+
+	pulled, more, err := timelineService.pulledStatuses(ctx, userId, query)
+	if err != nil {
+		return timelines.Timeline{}, err
+	}
+	if notFound && len(pulled) == 0 {
+		return timelines.Timeline{}, internal.NotFoundError(userId)
+	}
+	timeline.UserId = userId
+	timeline.Statuses = withoutDuplicates(append(timeline.Statuses, pulled...))
+
+	// Unknown users keep content warnings collapsed
+	preferences, err := timelineService.userService.GetPreferences(userId)
+	if err != nil && !errors.Is(err, internal.NotFoundError(userId)) {
+		return timelines.Timeline{}, err
+	}
+	timeline.ExpandContentWarnings = preferences.ExpandContentWarnings
+
+	statuses.SortNewestFirst(timeline.Statuses)
+	page := pageOf(timeline, query)
+	// A pulled author may have older statuses than the ones merged in
+	if page.Next == nil && more && len(page.Statuses) == query.Limit {
+		next := statuses.CursorOf(page.Statuses[len(page.Statuses)-1])
+		page.Next = &next
+	}
+	return page, nil
+}
+
+// GetPublicTimeline returns a page of the timeline of all public statuses
+func (timelineService *Service) GetPublicTimeline(ctx context.Context, query timelines.Query) (timelines.Timeline, error) {
+	timeline, err := timelineService.repo.GetPublic()
+	if err != nil {
+		return timelines.Timeline{}, err
+	}
+
+	statuses.SortNewestFirst(timeline.Statuses)
+	return pageOf(timeline, query), nil
+}
+
+// AddToPublic adds a status to the public timeline if anyone can see it, the oldest statuses are dropped like from
+// the timelines of users
+func (timelineService *Service) AddToPublic(ctx context.Context, status statuses.Status) error {
+	if !status.VisibleTo(uuid.Nil, false) {
+		return nil
+	}
+
+	return timelineService.repo.ChangePublic(func(timeline *timelines.Timeline) bool {
+		length := len(timeline.Statuses)
+		timeline.Statuses = withoutDuplicates(append(timeline.Statuses, status))
+		if len(timeline.Statuses) == length {
+			return false
+		}
+		timelineService.sortAndTrim(timeline)
+		return true
+	})
+}
+
+// replaceInPublic replaces the copy of an edited status in the public timeline, or removes it if it isn't public anymore
+func (timelineService *Service) replaceInPublic(status statuses.Status) error {
+	return timelineService.repo.ChangePublic(func(timeline *timelines.Timeline) bool {
+		for i, publicStatus := range timeline.Statuses {
+			if publicStatus.Id != status.Id {
+				continue
+			}
+			if !status.VisibleTo(uuid.Nil, false) {
+				timeline.Statuses = append(timeline.Statuses[:i], timeline.Statuses[i+1:]...)
+			} else {
+				timeline.Statuses[i] = status
+			}
+			return true
+		}
+		return false
+	})
+}
+
+func (timelineService *Service) removeFromPublic(status statuses.Status) error {
+	return timelineService.repo.ChangePublic(func(timeline *timelines.Timeline) bool {
+		for i, publicStatus := range timeline.Statuses {
+			if publicStatus.Id == status.Id {
+				timeline.Statuses = append(timeline.Statuses[:i], timeline.Statuses[i+1:]...)
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// pulledStatuses returns the latest statuses of the pulled authors userId follows up to the page described by query,
+// more reports whether any of them has older ones
+func (timelineService *Service) pulledStatuses(ctx context.Context, userId uuid.UUID, query timelines.Query) ([]statuses.Status, bool, error) {
+	pulledFollowees, err := timelineService.pulledFollowees(ctx, userId)
+	if err != nil {
+		return nil, false, err
+	}
+
+	pulled := make([]statuses.Status, 0)
+	more := false
+	for _, followeeId := range pulledFollowees.ToArray() {
+		page, err := timelineService.statusService.GetStatuses(followeeId, userId, statuses.PageQuery{Limit: query.Limit, Cursor: query.MaxId})
+		if err != nil {
+			return nil, false, err
+		}
+		pulled = append(pulled, page.Statuses...)
+		more = more || page.Next != nil
+	}
+	return pulled, more, nil
+}
+
+// pulledFollowees returns the pulled authors userId follows
+func (timelineService *Service) pulledFollowees(ctx context.Context, userId uuid.UUID) (internal.Set[uuid.UUID], error) {
+	pulledFollowees := internal.NewSet[uuid.UUID]()
+	pulledAuthors, err := timelineService.repo.GetPulledAuthors()
+	if err != nil {
+		return internal.Set[uuid.UUID]{}, err
+	}
+	if len(pulledAuthors.ToArray()) == 0 {
+		return pulledFollowees, nil
+	}
+
+	followees, err := timelineService.followerService.GetFollowees(ctx, userId)
+	if err != nil {
+		if errors.Is(err, internal.NotFoundError(userId)) {
+			return pulledFollowees, nil
+		}
+		return internal.Set[uuid.UUID]{}, err
+	}
+
+	for _, followee := range followees {
+		if pulledAuthors.Has(followee.Id) {
+			pulledFollowees.Add(followee.Id)
+		}
+	}
+	return pulledFollowees, nil
+}
+
+func (timelineService *Service) isPulled(userId uuid.UUID) (bool, error) {
+	pulledAuthors, err := timelineService.repo.GetPulledAuthors()
+	if err != nil {
+		return false, err
+	}
+	return pulledAuthors.Has(userId), nil
+}
+
+// OpenStream opens a stream of the events of the timeline of userId. Given the cursor of the last status a client
+// received, the statuses it missed since are returned as well, oldest first.
+func (timelineService *Service) OpenStream(ctx context.Context, userId uuid.UUID, lastEventId *statuses.Cursor) (*Stream, []statuses.Status, error) {
+	pulledFollowees, err := timelineService.pulledFollowees(ctx, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Opened before the missed statuses are read, so no status falls in between
+	stream := timelineService.hub.Open(userId, pulledFollowees)
+	if lastEventId == nil {
+		return stream, []statuses.Status{}, nil
+	}
+
+	missed, err := timelineService.missedSince(ctx, userId, *lastEventId)
+	if err != nil {
+		timelineService.hub.Close(stream)
+		return nil, nil, err
+	}
+	return stream, missed, nil
+}
+
+func (timelineService *Service) CloseStream(stream *Stream) {
+	timelineService.hub.Close(stream)
+}
+
+// missedSince returns the statuses of a timeline newer than since, oldest first
+func (timelineService *Service) missedSince(ctx context.Context, userId uuid.UUID, since statuses.Cursor) ([]statuses.Status, error) {
+	missed := make([]statuses.Status, 0)
+	query := timelines.Query{Limit: statuses.MaxPageLimit, SinceId: &since}
+	for {
+		page, err := timelineService.GetTimeline(ctx, userId, query)
+		if errors.Is(err, internal.NotFoundError(userId)) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		missed = append(missed, page.Statuses...)
+		if page.Next == nil {
+			break
+		}
+		query.MaxId = page.Next
+	}
+
+	for i, j := 0, len(missed)-1; i < j; i, j = i+1, j-1 {
+		missed[i], missed[j] = missed[j], missed[i]
+	}
+	return missed, nil
+}
+
+func (timelineService *Service) publish(event timelines.Event) error {
+	return timelineService.client.PublishEvent(context.Background(), timelineService.config.Name, timelines.Topic, event)
 }
 
+// withoutDuplicates drops statuses that are in a timeline twice, a status and its reposts are told apart by the time
+// they were posted at
+func withoutDuplicates(all []statuses.Status) []statuses.Status {
+	present := internal.NewSet[string]()
+	unique := make([]statuses.Status, 0, len(all))
+	for _, status := range all {
+		key := statuses.CursorOf(status).String()
+		if !present.Has(key) {
+			present.Add(key)
+			unique = append(unique, status)
+		}
+	}
+	return unique
+}
+
+// pageOf cuts the page described by query out of a timeline sorted newest first
+func pageOf(timeline timelines.Timeline, query timelines.Query) timelines.Timeline {
+	page := make([]statuses.Status, 0, query.Limit)
+	for _, status := range timeline.Statuses {
+		cursor := statuses.CursorOf(status)
+		if query.MaxId != nil && !query.MaxId.Before(cursor.Time, cursor.Id) {
+			continue
+		}
+		if query.SinceId != nil && !cursor.Before(query.SinceId.Time, query.SinceId.Id) {
+			break
+		}
+		if len(page) == query.Limit {
+			next := statuses.CursorOf(page[len(page)-1])
+			timeline.Next = &next
+			break
+		}
+		page = append(page, status)
+	}
+
+	// Polling with an empty page keeps the since_id it was polled with
+	timeline.Prev = query.SinceId
+	if len(page) > 0 {
+		prev := statuses.CursorOf(page[0])
+		timeline.Prev = &prev
+	}
+	timeline.Statuses = page
+	return timeline
+}
+
+// UpdateTimelines adds a status of userId, or reposted by userId, to the timelines of the followers of userId who can see it.
+// Once userId has more followers than the fan-out threshold their statuses are pulled by GetTimeline instead. They stay
+// pulled, so the statuses posted in the meantime don't drop out of timelines should they lose followers again.
+// Reposts aren't among the statuses GetTimeline pulls, so they are pushed even by pulled authors.
 func (timelineService *Service) UpdateTimelines(ctx context.Context, userId uuid.UUID, status statuses.Status) error {
+	reposted := status.Repost != nil
+	pulled, err := timelineService.isPulled(userId)
+	if err != nil {
+		return err
+	}
+	event := timelines.Event{Type: timelines.UpdateEvent, Status: status, UserIds: []uuid.UUID{}, Pulled: pulled && !reposted}
+	if event.Pulled {
+		return timelineService.publish(event)
+	}
+
 	allFollowers, err := timelineService.followerService.GetFollowers(ctx, userId)
 	if err != nil {
 		return err
 	}
+	if !reposted && len(allFollowers) > timelineService.timelineConfig.FanOutThreshold {
+		event.Pulled = true
+		err = timelineService.repo.AddPulledAuthor(userId)
+	} else {
+		event.UserIds, err = timelineService.fanOut(allFollowers, userId, status)
+	}
+	if err != nil {
+		return err
+	}
+
+	return timelineService.publish(event)
+}
+
+// fanOut pushes a status to the timelines of the followers who can see it, FanOutConcurrency timelines at a time.
+// It returns the followers it was pushed to.
+func (timelineService *Service) fanOut(allFollowers []users.User, userId uuid.UUID, status statuses.Status) ([]uuid.UUID, error) {
+	pushedTo := make([]uuid.UUID, 0, len(allFollowers))
+	group := errgroup.Group{}
+	group.SetLimit(timelineService.timelineConfig.FanOutConcurrency)
 	for _, follower := range allFollowers {
-		timeline, err := timelineService.GetTimeline(context.Background(), follower.Id)
+		if !status.VisibleTo(follower.Id, userId == status.UserId) {
+			continue
+		}
+
+		followerId := follower.Id
+		pushedTo = append(pushedTo, followerId)
+		group.Go(func() error {
+			return timelineService.push(followerId, status)
+		})
+	}
+	return pushedTo, group.Wait()
+}
+
+func (timelineService *Service) push(followerId uuid.UUID, status statuses.Status) error {
+	timeline, err := timelineService.repo.Get(followerId)
+	if err != nil {
+		if !errors.Is(err, internal.NotFoundError(followerId)) {
+			return err
+		}
+		timeline = timelines.Timeline{
+			UserId:   followerId,
+			Statuses: []statuses.Status{status},
+		}
+	} else {
+		timeline.Statuses = append(timeline.Statuses, status)
+		timelineService.sortAndTrim(&timeline)
+	}
+
+	_, err = timelineService.repo.Save(timeline)
+	return err
+}
+
+// BackfillFollowee adds the latest statuses of a followed user the follower can see to the timeline of the follower.
+// Pulled authors are merged into timelines when they are read, so they aren't backfilled.
+func (timelineService *Service) BackfillFollowee(ctx context.Context, event followers.FollowEvent) error {
+	pulledAuthors, err := timelineService.repo.GetPulledAuthors()
+	if err != nil {
+		return err
+	}
+	if pulledAuthors.Has(event.FolloweeId) {
+		return nil
+	}
+
+	latest, err := timelineService.latestStatuses(event.FolloweeId, event.FollowerId)
+	if err != nil {
+		return err
+	}
+
+	timeline, err := timelineService.repo.Get(event.FollowerId)
+	if err != nil {
+		if !errors.Is(err, internal.NotFoundError(event.FollowerId)) {
+			return err
+		}
+		timeline = timelines.Timeline{UserId: event.FollowerId, Statuses: []statuses.Status{}}
+	}
+
+	length := len(timeline.Statuses)
+	timeline.Statuses = withoutDuplicates(append(timeline.Statuses, latest...))
+	if len(timeline.Statuses) == length {
+		return nil
+	}
+
+	timelineService.sortAndTrim(&timeline)
+	_, err = timelineService.repo.Save(timeline)
+	return err
+}
+
+// latestStatuses pages through the statuses of userId until it has as many as are backfilled
+func (timelineService *Service) latestStatuses(userId uuid.UUID, callerId uuid.UUID) ([]statuses.Status, error) {
+	latest := make([]statuses.Status, 0, timelineService.timelineConfig.BackfillLength)
+	query := statuses.PageQuery{}
+	for len(latest) < timelineService.timelineConfig.BackfillLength {
+		query.Limit = timelineService.timelineConfig.BackfillLength - len(latest)
+		if query.Limit > statuses.MaxPageLimit {
+			query.Limit = statuses.MaxPageLimit
+		}
+
+		page, err := timelineService.statusService.GetStatuses(userId, callerId, query)
 		if err != nil {
-			if errors.Is(err, internal.NotFoundError(follower.Id)) {
-				timeline = timelines.Timeline{
-					UserId:   follower.Id,
-					Statuses: []statuses.Status{status},
-				}
+			return nil, err
+		}
+		latest = append(latest, page.Statuses...)
+		if page.Next == nil {
+			break
+		}
+		query.Cursor = page.Next
+	}
+	return latest, nil
+}
+
+// RemoveFollowee removes the statuses and reposts of an unfollowed user from the timeline of the former follower.
+// Statuses of the unfollowed user reposted by someone still followed are kept.
+func (timelineService *Service) RemoveFollowee(ctx context.Context, event followers.FollowEvent) error {
+	timeline, err := timelineService.repo.Get(event.FollowerId)
+	if err != nil {
+		if errors.Is(err, internal.NotFoundError(event.FollowerId)) {
+			return nil
+		}
+		return err
+	}
+
+	remaining := make([]statuses.Status, 0, len(timeline.Statuses))
+	for _, status := range timeline.Statuses {
+		postedBy := status.UserId
+		if status.Repost != nil {
+			postedBy = status.Repost.UserId
+		}
+		if postedBy != event.FolloweeId {
+			remaining = append(remaining, status)
+		}
+	}
+	if len(remaining) == len(timeline.Statuses) {
+		return nil
+	}
+
+	timeline.Statuses = remaining
+	_, err = timelineService.repo.Save(timeline)
+	return err
+}
+
+// sortAndTrim sorts a timeline newest first. The timeline is stored as a single document, dropping the oldest
+// statuses keeps it from growing forever.
+func (timelineService *Service) sortAndTrim(timeline *timelines.Timeline) {
+	statuses.SortNewestFirst(timeline.Statuses)
+	if len(timeline.Statuses) > timelineService.timelineConfig.MaxLength {
+		timeline.Statuses = timeline.Statuses[:timelineService.timelineConfig.MaxLength]
+	}
+}
+
+// ReplaceStatus replaces the copy of an edited status in the public timeline and the timelines of the followers of its
+// author. An edit of a reposted status replaces the reposts of status.Repost.UserId in the timelines of their followers.
+func (timelineService *Service) ReplaceStatus(ctx context.Context, status statuses.Status) error {
+	postedBy := status.UserId
+	if status.Repost != nil {
+		postedBy = status.Repost.UserId
+	} else {
+		err := timelineService.replaceInPublic(status)
+		if err != nil {
+			return err
+		}
+	}
+
+	allFollowers, err := timelineService.followerService.GetFollowers(ctx, postedBy)
+	if err != nil {
+		return err
+	}
+	changed, err := timelineService.changeTimelines(allFollowers, func(timeline *timelines.Timeline) bool {
+		replaced := false
+		for i, timelineStatus := range timeline.Statuses {
+			if timelineStatus.Id != status.Id {
+				continue
+			}
+			if status.Repost != nil && (timelineStatus.Repost == nil || timelineStatus.Repost.UserId != postedBy) {
+				continue
 			}
-		} else {
-			timeline.Statuses = append(timeline.Statuses, status)
+			timeline.Statuses[i] = status
+			timeline.Statuses[i].Repost = timelineStatus.Repost
+			replaced = true
 		}
+		return replaced
+	})
+	if err != nil {
+		return err
+	}
+
+	return timelineService.publishChange(timelines.Event{Type: timelines.StatusUpdateEvent, Status: status, UserIds: changed})
+}
 
-		_, err = timelineService.repo.Save(timeline)
+// publishChange publishes an edit or removal if it changed a timeline or might be in the timelines of followers of a
+// pulled author. Reposts are always pushed, the timelines they changed are all there is to tell.
+func (timelineService *Service) publishChange(event timelines.Event) error {
+	pulled := false
+	if event.Status.Repost == nil {
+		var err error
+		pulled, err = timelineService.isPulled(event.PostedBy())
 		if err != nil {
 			return err
 		}
 	}
+	if len(event.UserIds) == 0 && !pulled {
+		return nil
+	}
+
+	event.Pulled = pulled
+	return timelineService.publish(event)
+}
 
-	err = timelineService.client.PublishEvent(context.Background(), timelineService.config.Name, "timeline", "")
+// RemoveStatus removes a deleted status from the public timeline and the timelines of the followers of its author,
+// including reposts of it
+func (timelineService *Service) RemoveStatus(ctx context.Context, status statuses.Status) error {
+	err := timelineService.removeFromPublic(status)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	allFollowers, err := timelineService.followerService.GetFollowers(ctx, status.UserId)
+	if err != nil {
+		return err
+	}
+	changed, err := timelineService.changeTimelines(allFollowers, func(timeline *timelines.Timeline) bool {
+		remaining := make([]statuses.Status, 0, len(timeline.Statuses))
+		for _, timelineStatus := range timeline.Statuses {
+			if timelineStatus.Id != status.Id {
+				remaining = append(remaining, timelineStatus)
+			}
+		}
+		removed := len(remaining) != len(timeline.Statuses)
+		timeline.Statuses = remaining
+		return removed
+	})
+	if err != nil {
+		return err
+	}
+
+	return timelineService.publishChange(timelines.Event{Type: timelines.DeleteEvent, Status: status, UserIds: changed})
+}
+
+// RemoveRepost removes a status reposted by status.Repost.UserId from the timelines of the followers of the reposter
+func (timelineService *Service) RemoveRepost(ctx context.Context, status statuses.Status) error {
+	reposterId := status.Repost.UserId
+	allFollowers, err := timelineService.followerService.GetFollowers(ctx, reposterId)
+	if err != nil {
+		return err
+	}
+	changed, err := timelineService.changeTimelines(allFollowers, func(timeline *timelines.Timeline) bool {
+		remaining := make([]statuses.Status, 0, len(timeline.Statuses))
+		for _, timelineStatus := range timeline.Statuses {
+			if timelineStatus.Id == status.Id && timelineStatus.Repost != nil && timelineStatus.Repost.UserId == reposterId {
+				continue
+			}
+			remaining = append(remaining, timelineStatus)
+		}
+		removed := len(remaining) != len(timeline.Statuses)
+		timeline.Statuses = remaining
+		return removed
+	})
+	if err != nil {
+		return err
+	}
+
+	return timelineService.publishChange(timelines.Event{Type: timelines.DeleteEvent, Status: status, UserIds: changed})
+}
+
+// changeTimelines applies change to the timelines of the followers, FanOutConcurrency timelines at a time. Timelines
+// change reports as changed are saved, it returns the followers they belong to.
+func (timelineService *Service) changeTimelines(allFollowers []users.User, change func(timeline *timelines.Timeline) bool) ([]uuid.UUID, error) {
+	changed := make([]uuid.UUID, 0)
+	mutex := sync.Mutex{}
+	group := errgroup.Group{}
+	group.SetLimit(timelineService.timelineConfig.FanOutConcurrency)
+	for _, follower := range allFollowers {
+		followerId := follower.Id
+		group.Go(func() error {
+			timeline, err := timelineService.repo.Get(followerId)
+			if err != nil {
+				if errors.Is(err, internal.NotFoundError(followerId)) {
+					return nil
+				}
+				return err
+			}
+			if !change(&timeline) {
+				return nil
+			}
+
+			_, err = timelineService.repo.Save(timeline)
+			if err != nil {
+				return err
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			changed = append(changed, followerId)
+			return nil
+		})
+	}
+	return changed, group.Wait()
 }
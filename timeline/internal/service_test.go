@@ -0,0 +1,613 @@
+package timelines
+
+import (
+	"context"
+	dapr "github.com/dapr/go-sdk/client"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+	"yatc/internal"
+	statuses "yatc/status/pkg"
+	timelines "yatc/timeline/pkg"
+	"yatc/user/pkg/followers"
+	"yatc/user/pkg/users"
+)
+
+var testTimelineConfig = internal.TimelineConfig{MaxLength: 800, BackfillLength: 20, FanOutThreshold: 10000, FanOutConcurrency: 16}
+
+// MockFollowerService knows who follows whom, followers maps a user to the users following them
+type MockFollowerService struct {
+	followers.Service
+	followers          map[uuid.UUID][]uuid.UUID
+	getFollowersCalled int
+}
+
+func NewMockFollowerService() *MockFollowerService {
+	return &MockFollowerService{followers: map[uuid.UUID][]uuid.UUID{}}
+}
+
+func (service *MockFollowerService) follow(followeeId uuid.UUID, followerIds ...uuid.UUID) {
+	service.followers[followeeId] = append(service.followers[followeeId], followerIds...)
+}
+
+func (service *MockFollowerService) GetFollowers(ctx context.Context, userId uuid.UUID) ([]users.User, error) {
+	service.getFollowersCalled++
+	all := make([]users.User, 0)
+	for _, followerId := range service.followers[userId] {
+		all = append(all, users.User{Id: followerId})
+	}
+	return all, nil
+}
+
+func (service *MockFollowerService) GetFollowees(ctx context.Context, userId uuid.UUID) ([]users.User, error) {
+	followees := make([]users.User, 0)
+	for followeeId, followerIds := range service.followers {
+		for _, followerId := range followerIds {
+			if followerId == userId {
+				followees = append(followees, users.User{Id: followeeId})
+			}
+		}
+	}
+	return followees, nil
+}
+
+// MockUserService knows no preferences, so content warnings stay collapsed
+type MockUserService struct {
+	users.Service
+}
+
+func (service MockUserService) GetPreferences(userId uuid.UUID) (users.Preferences, error) {
+	return users.Preferences{}, internal.NotFoundError(userId)
+}
+
+// MockStatusService pages through the statuses of every author newest first
+type MockStatusService struct {
+	statuses.Service
+	statuses map[uuid.UUID][]statuses.Status
+}
+
+func NewMockStatusService() *MockStatusService {
+	return &MockStatusService{statuses: map[uuid.UUID][]statuses.Status{}}
+}
+
+func (service *MockStatusService) post(all ...statuses.Status) {
+	for _, status := range all {
+		service.statuses[status.UserId] = append(service.statuses[status.UserId], status)
+		statuses.SortNewestFirst(service.statuses[status.UserId])
+	}
+}
+
+func (service *MockStatusService) GetStatuses(userId uuid.UUID, callerId uuid.UUID, query statuses.PageQuery) (statuses.StatusPage, error) {
+	page := statuses.StatusPage{Statuses: []statuses.Status{}}
+	for _, status := range service.statuses[userId] {
+		if query.Cursor != nil && !query.Cursor.Before(status.CreatedAt, status.Id) {
+			continue
+		}
+		if !status.VisibleTo(callerId, true) {
+			continue
+		}
+		if len(page.Statuses) == query.Limit {
+			next := statuses.CursorOf(page.Statuses[len(page.Statuses)-1])
+			page.Next = &next
+			break
+		}
+		page.Statuses = append(page.Statuses, status)
+	}
+	return page, nil
+}
+
+// MockPubSub records the timeline events the service publishes
+type MockPubSub struct {
+	dapr.Client
+	mutex  sync.Mutex
+	events []timelines.Event
+}
+
+func (pubSub *MockPubSub) PublishEvent(ctx context.Context, pubsubName, topicName string, data interface{}, opts ...dapr.PublishEventOption) error {
+	pubSub.mutex.Lock()
+	defer pubSub.mutex.Unlock()
+	pubSub.events = append(pubSub.events, data.(timelines.Event))
+	return nil
+}
+
+func newTestService(repo Repository, followerService followers.Service, statusService statuses.Service, pubSub *MockPubSub, config internal.TimelineConfig) *Service {
+	return NewTimelineService(repo, followerService, MockUserService{}, statusService, pubSub, internal.PubSubConfig{Name: "pubsub"}, config, NewHub())
+}
+
+func newTestStatus(userId uuid.UUID, createdAt time.Time) statuses.Status {
+	return statuses.Status{Id: uuid.New(), Content: "test status", UserId: userId, CreatedAt: createdAt, Visibility: statuses.Public}
+}
+
+func TestService_ReplaceStatus_Reposted(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	followerService := NewMockFollowerService()
+	pubSub := &MockPubSub{}
+	service := newTestService(repo, followerService, NewMockStatusService(), pubSub, testTimelineConfig)
+
+	authorId, reposterId, authorFollowerId, reposterFollowerId := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	followerService.follow(authorId, authorFollowerId)
+	followerService.follow(reposterId, reposterFollowerId)
+	now := time.Now().UTC()
+	status := newTestStatus(authorId, now)
+	repost := status
+	repost.Repost = &statuses.Repost{StatusId: status.Id, UserId: reposterId, CreatedAt: now.Add(time.Minute)}
+	assert.NoError(t, service.UpdateTimelines(context.Background(), authorId, status))
+	assert.NoError(t, service.UpdateTimelines(context.Background(), reposterId, repost))
+
+	edited := status
+	edited.Content = "edited status"
+	editedRepost := edited
+	editedRepost.Repost = &statuses.Repost{StatusId: status.Id, UserId: reposterId}
+
+	// WHEN
+	err := service.ReplaceStatus(context.Background(), edited)
+	assert.NoError(t, err)
+	err = service.ReplaceStatus(context.Background(), editedRepost)
+
+	// THEN
+	assert.NoError(t, err)
+	authorFollowerTimeline, err := repo.Get(authorFollowerId)
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Status{edited}, authorFollowerTimeline.Statuses)
+
+	reposterFollowerTimeline, err := repo.Get(reposterFollowerId)
+	assert.NoError(t, err)
+	assert.Len(t, reposterFollowerTimeline.Statuses, 1)
+	assert.Equal(t, "edited status", reposterFollowerTimeline.Statuses[0].Content)
+	assert.Equal(t, repost.Repost, reposterFollowerTimeline.Statuses[0].Repost)
+
+	assert.Equal(t, []uuid.UUID{authorFollowerId}, pubSub.events[2].UserIds)
+	assert.Equal(t, []uuid.UUID{reposterFollowerId}, pubSub.events[3].UserIds)
+}
+
+// concurrentSaves records the most timelines saved at once
+type concurrentSaves struct {
+	*InMemoryRepo
+	mutex  sync.Mutex
+	active int
+	most   int
+}
+
+func (repo *concurrentSaves) Save(timeline timelines.Timeline) (timelines.Timeline, error) {
+	repo.mutex.Lock()
+	repo.active++
+	if repo.active > repo.most {
+		repo.most = repo.active
+	}
+	repo.mutex.Unlock()
+
+	time.Sleep(time.Millisecond)
+	saved, err := repo.InMemoryRepo.Save(timeline)
+
+	repo.mutex.Lock()
+	repo.active--
+	repo.mutex.Unlock()
+	return saved, err
+}
+
+func TestService_UpdateTimelines_BoundedFanOut(t *testing.T) {
+	// GIVEN
+	repo := &concurrentSaves{InMemoryRepo: NewInMemoryRepo()}
+	followerService := NewMockFollowerService()
+	pubSub := &MockPubSub{}
+	config := testTimelineConfig
+	config.FanOutConcurrency = 4
+	service := newTestService(repo, followerService, NewMockStatusService(), pubSub, config)
+
+	authorId := uuid.New()
+	followerIds := make([]uuid.UUID, 50)
+	for i := range followerIds {
+		followerIds[i] = uuid.New()
+	}
+	followerService.follow(authorId, followerIds...)
+	status := newTestStatus(authorId, time.Now().UTC())
+
+	// WHEN
+	err := service.UpdateTimelines(context.Background(), authorId, status)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, repo.most, config.FanOutConcurrency)
+	for _, followerId := range followerIds {
+		timeline, err := repo.Get(followerId)
+		assert.NoError(t, err)
+		assert.Equal(t, []statuses.Status{status}, timeline.Statuses)
+	}
+	assert.ElementsMatch(t, followerIds, pubSub.events[0].UserIds)
+	assert.False(t, pubSub.events[0].Pulled)
+}
+
+func TestService_ChangeTimelines_BoundedFanOut(t *testing.T) {
+	tests := []struct {
+		name     string
+		reposted bool
+		change   func(service *Service, status statuses.Status) error
+	}{
+		{"replace", false, func(service *Service, status statuses.Status) error {
+			status.Content = "edited status"
+			return service.ReplaceStatus(context.Background(), status)
+		}},
+		{"remove", false, func(service *Service, status statuses.Status) error {
+			return service.RemoveStatus(context.Background(), status)
+		}},
+		{"remove repost", true, func(service *Service, status statuses.Status) error {
+			return service.RemoveRepost(context.Background(), status)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// GIVEN
+			repo := &concurrentSaves{InMemoryRepo: NewInMemoryRepo()}
+			followerService := NewMockFollowerService()
+			pubSub := &MockPubSub{}
+			config := testTimelineConfig
+			config.FanOutConcurrency = 4
+			service := newTestService(repo, followerService, NewMockStatusService(), pubSub, config)
+
+			reposterId := uuid.New()
+			followerIds := make([]uuid.UUID, 50)
+			for i := range followerIds {
+				followerIds[i] = uuid.New()
+			}
+			followerService.follow(reposterId, followerIds...)
+			status := newTestStatus(reposterId, time.Now().UTC())
+			if tt.reposted {
+				status = newTestStatus(uuid.New(), time.Now().UTC())
+				status.Repost = &statuses.Repost{StatusId: status.Id, UserId: reposterId, CreatedAt: time.Now().UTC()}
+			}
+			assert.NoError(t, service.UpdateTimelines(context.Background(), reposterId, status))
+			repo.most = 0
+
+			// WHEN
+			err := tt.change(service, status)
+
+			// THEN
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, repo.most, config.FanOutConcurrency)
+			assert.ElementsMatch(t, followerIds, pubSub.events[1].UserIds)
+		})
+	}
+}
+
+func TestService_UpdateTimelines_FanOutThreshold(t *testing.T) {
+	tests := []struct {
+		name      string
+		followers int
+		pulled    bool
+	}{
+		{"at threshold", 2, false},
+		{"above threshold", 3, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// GIVEN
+			repo := NewInMemoryRepo()
+			followerService := NewMockFollowerService()
+			pubSub := &MockPubSub{}
+			config := testTimelineConfig
+			config.FanOutThreshold = 2
+			service := newTestService(repo, followerService, NewMockStatusService(), pubSub, config)
+
+			authorId := uuid.New()
+			followerIds := make([]uuid.UUID, tt.followers)
+			for i := range followerIds {
+				followerIds[i] = uuid.New()
+			}
+			followerService.follow(authorId, followerIds...)
+
+			// WHEN
+			err := service.UpdateTimelines(context.Background(), authorId, newTestStatus(authorId, time.Now().UTC()))
+
+			// THEN
+			assert.NoError(t, err)
+			pulledAuthors, err := repo.GetPulledAuthors()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.pulled, pulledAuthors.Has(authorId))
+			assert.Equal(t, tt.pulled, pubSub.events[0].Pulled)
+			for _, followerId := range followerIds {
+				_, err := repo.Get(followerId)
+				if tt.pulled {
+					assert.ErrorIs(t, err, internal.NotFoundError(followerId))
+				} else {
+					assert.NoError(t, err)
+				}
+			}
+		})
+	}
+}
+
+func TestService_UpdateTimelines_PulledAuthor(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	followerService := NewMockFollowerService()
+	pubSub := &MockPubSub{}
+	service := newTestService(repo, followerService, NewMockStatusService(), pubSub, testTimelineConfig)
+
+	authorId, followerId := uuid.New(), uuid.New()
+	followerService.follow(authorId, followerId)
+	assert.NoError(t, repo.AddPulledAuthor(authorId))
+
+	// WHEN
+	err := service.UpdateTimelines(context.Background(), authorId, newTestStatus(authorId, time.Now().UTC()))
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, 0, followerService.getFollowersCalled)
+	_, err = repo.Get(followerId)
+	assert.ErrorIs(t, err, internal.NotFoundError(followerId))
+	assert.True(t, pubSub.events[0].Pulled)
+	assert.Empty(t, pubSub.events[0].UserIds)
+}
+
+func TestService_UpdateTimelines_PulledReposter(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	followerService := NewMockFollowerService()
+	statusService := NewMockStatusService()
+	pubSub := &MockPubSub{}
+	service := newTestService(repo, followerService, statusService, pubSub, testTimelineConfig)
+
+	reposterId, followerId := uuid.New(), uuid.New()
+	followerService.follow(reposterId, followerId)
+	assert.NoError(t, repo.AddPulledAuthor(reposterId))
+
+	now := time.Now().UTC()
+	own := newTestStatus(reposterId, now.Add(-time.Minute))
+	statusService.post(own)
+	repost := newTestStatus(uuid.New(), now.Add(-2*time.Minute))
+	repost.Repost = &statuses.Repost{StatusId: repost.Id, UserId: reposterId, CreatedAt: now}
+
+	// WHEN
+	err := service.UpdateTimelines(context.Background(), reposterId, repost)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.False(t, pubSub.events[0].Pulled)
+	assert.Equal(t, []uuid.UUID{followerId}, pubSub.events[0].UserIds)
+
+	timeline, err := service.GetTimeline(context.Background(), followerId, timelines.Query{Limit: statuses.DefaultPageLimit})
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Status{repost, own}, timeline.Statuses)
+}
+
+func TestService_GetTimeline_MergesPulledStatuses(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	followerService := NewMockFollowerService()
+	statusService := NewMockStatusService()
+	service := newTestService(repo, followerService, statusService, &MockPubSub{}, testTimelineConfig)
+
+	pushedId, pulledId, unfollowedId, userId := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	followerService.follow(pushedId, userId)
+	followerService.follow(pulledId, userId)
+	assert.NoError(t, repo.AddPulledAuthor(pulledId))
+	assert.NoError(t, repo.AddPulledAuthor(unfollowedId))
+
+	now := time.Now().UTC()
+	pushed := []statuses.Status{newTestStatus(pushedId, now.Add(-1*time.Minute)), newTestStatus(pushedId, now.Add(-4*time.Minute))}
+	pulled := []statuses.Status{newTestStatus(pulledId, now), newTestStatus(pulledId, now.Add(-2*time.Minute)), newTestStatus(pulledId, now.Add(-3*time.Minute))}
+	statusService.post(pulled...)
+	statusService.post(newTestStatus(unfollowedId, now))
+	for _, status := range pushed {
+		assert.NoError(t, service.push(userId, status))
+	}
+
+	// WHEN
+	first, err := service.GetTimeline(context.Background(), userId, timelines.Query{Limit: 3})
+	assert.NoError(t, err)
+	second, err := service.GetTimeline(context.Background(), userId, timelines.Query{Limit: 3, MaxId: first.Next})
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Status{pulled[0], pushed[0], pulled[1]}, first.Statuses)
+	assert.Equal(t, []statuses.Status{pulled[2], pushed[1]}, second.Statuses)
+	assert.Nil(t, second.Next)
+}
+
+func TestPageOf(t *testing.T) {
+	now := time.Now().UTC()
+	all := make([]statuses.Status, 5)
+	cursors := make([]*statuses.Cursor, len(all))
+	for i := range all {
+		all[i] = newTestStatus(uuid.New(), now.Add(-time.Duration(i)*time.Minute))
+		cursor := statuses.CursorOf(all[i])
+		cursors[i] = &cursor
+	}
+	timeline := timelines.Timeline{UserId: uuid.New(), Statuses: all}
+
+	tests := []struct {
+		name     string
+		query    timelines.Query
+		statuses []statuses.Status
+		next     *statuses.Cursor
+		prev     *statuses.Cursor
+	}{
+		{"first page", timelines.Query{Limit: 2}, all[:2], cursors[1], cursors[0]},
+		{"max_id", timelines.Query{Limit: 2, MaxId: cursors[1]}, all[2:4], cursors[3], cursors[2]},
+		{"last page", timelines.Query{Limit: 5, MaxId: cursors[2]}, all[3:], nil, cursors[3]},
+		{"since_id", timelines.Query{Limit: 5, SinceId: cursors[2]}, all[:2], nil, cursors[0]},
+		{"since_id and max_id", timelines.Query{Limit: 5, MaxId: cursors[0], SinceId: cursors[3]}, all[1:3], nil, cursors[1]},
+		{"nothing newer", timelines.Query{Limit: 5, SinceId: cursors[0]}, []statuses.Status{}, nil, cursors[0]},
+		{"nothing older", timelines.Query{Limit: 5, MaxId: cursors[4]}, []statuses.Status{}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// WHEN
+			page := pageOf(timeline, tt.query)
+
+			// THEN
+			assert.Equal(t, timeline.UserId, page.UserId)
+			assert.Equal(t, tt.statuses, page.Statuses)
+			assert.Equal(t, tt.next, page.Next)
+			assert.Equal(t, tt.prev, page.Prev)
+		})
+	}
+}
+
+func TestPageOf_Reposts(t *testing.T) {
+	// GIVEN
+	now := time.Now().UTC()
+	status := newTestStatus(uuid.New(), now.Add(-time.Hour))
+	repost := status
+	repost.Repost = &statuses.Repost{StatusId: status.Id, UserId: uuid.New(), CreatedAt: now}
+	newer := newTestStatus(uuid.New(), now.Add(-time.Minute))
+	timeline := timelines.Timeline{Statuses: []statuses.Status{repost, newer, status}}
+	maxId := statuses.CursorOf(repost)
+
+	// WHEN
+	page := pageOf(timeline, timelines.Query{Limit: 5, MaxId: &maxId})
+
+	// THEN
+	assert.Equal(t, []statuses.Status{newer, status}, page.Statuses)
+}
+
+func TestService_BackfillFollowee(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	statusService := NewMockStatusService()
+	config := testTimelineConfig
+	config.BackfillLength = 3
+	service := newTestService(repo, NewMockFollowerService(), statusService, &MockPubSub{}, config)
+
+	followeeId, followerId := uuid.New(), uuid.New()
+	now := time.Now().UTC()
+	hidden := newTestStatus(followeeId, now)
+	hidden.Visibility = statuses.Mentioned
+	followersOnly := newTestStatus(followeeId, now.Add(-1*time.Minute))
+	followersOnly.Visibility = statuses.Followers
+	latest := []statuses.Status{followersOnly, newTestStatus(followeeId, now.Add(-2*time.Minute)), newTestStatus(followeeId, now.Add(-3*time.Minute))}
+	statusService.post(append(latest, hidden, newTestStatus(followeeId, now.Add(-4*time.Minute)))...)
+
+	other := newTestStatus(uuid.New(), now.Add(-time.Hour))
+	_, err := repo.Save(timelines.Timeline{UserId: followerId, Statuses: []statuses.Status{latest[1], other}})
+	assert.NoError(t, err)
+
+	// WHEN
+	err = service.BackfillFollowee(context.Background(), followers.FollowEvent{FollowerId: followerId, FolloweeId: followeeId})
+
+	// THEN
+	assert.NoError(t, err)
+	timeline, err := repo.Get(followerId)
+	assert.NoError(t, err)
+	assert.Equal(t, append(latest, other), timeline.Statuses)
+}
+
+func TestService_BackfillFollowee_PulledAuthor(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	statusService := NewMockStatusService()
+	service := newTestService(repo, NewMockFollowerService(), statusService, &MockPubSub{}, testTimelineConfig)
+
+	followeeId, followerId := uuid.New(), uuid.New()
+	statusService.post(newTestStatus(followeeId, time.Now().UTC()))
+	assert.NoError(t, repo.AddPulledAuthor(followeeId))
+
+	// WHEN
+	err := service.BackfillFollowee(context.Background(), followers.FollowEvent{FollowerId: followerId, FolloweeId: followeeId})
+
+	// THEN
+	assert.NoError(t, err)
+	_, err = repo.Get(followerId)
+	assert.ErrorIs(t, err, internal.NotFoundError(followerId))
+}
+
+func TestService_RemoveFollowee(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	service := newTestService(repo, NewMockFollowerService(), NewMockStatusService(), &MockPubSub{}, testTimelineConfig)
+
+	followeeId, otherId, followerId := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now().UTC()
+	followeeStatus := newTestStatus(followeeId, now)
+	otherStatus := newTestStatus(otherId, now.Add(-time.Minute))
+	repostedByFollowee := otherStatus
+	repostedByFollowee.Repost = &statuses.Repost{StatusId: otherStatus.Id, UserId: followeeId, CreatedAt: now.Add(time.Minute)}
+	repostedByOther := followeeStatus
+	repostedByOther.Repost = &statuses.Repost{StatusId: followeeStatus.Id, UserId: otherId, CreatedAt: now.Add(2 * time.Minute)}
+	_, err := repo.Save(timelines.Timeline{UserId: followerId, Statuses: []statuses.Status{repostedByOther, repostedByFollowee, followeeStatus, otherStatus}})
+	assert.NoError(t, err)
+
+	// WHEN
+	err = service.RemoveFollowee(context.Background(), followers.FollowEvent{FollowerId: followerId, FolloweeId: followeeId})
+
+	// THEN
+	assert.NoError(t, err)
+	timeline, err := repo.Get(followerId)
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Status{repostedByOther, otherStatus}, timeline.Statuses)
+}
+
+func TestService_RemoveFollowee_NoTimeline(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	service := newTestService(repo, NewMockFollowerService(), NewMockStatusService(), &MockPubSub{}, testTimelineConfig)
+	followerId := uuid.New()
+
+	// WHEN
+	err := service.RemoveFollowee(context.Background(), followers.FollowEvent{FollowerId: followerId, FolloweeId: uuid.New()})
+
+	// THEN
+	assert.NoError(t, err)
+	_, err = repo.Get(followerId)
+	assert.ErrorIs(t, err, internal.NotFoundError(followerId))
+}
+
+func TestService_AddToPublic(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	config := testTimelineConfig
+	config.MaxLength = 3
+	service := newTestService(repo, NewMockFollowerService(), NewMockStatusService(), &MockPubSub{}, config)
+
+	now := time.Now().UTC()
+	public := make([]statuses.Status, 4)
+	for i := range public {
+		public[i] = newTestStatus(uuid.New(), now.Add(-time.Duration(i)*time.Minute))
+	}
+	followersOnly := newTestStatus(uuid.New(), now.Add(time.Minute))
+	followersOnly.Visibility = statuses.Followers
+
+	// WHEN
+	for _, status := range []statuses.Status{public[3], public[1], public[0], followersOnly, public[2], public[0]} {
+		assert.NoError(t, service.AddToPublic(context.Background(), status))
+	}
+
+	// THEN
+	timeline, err := repo.GetPublic()
+	assert.NoError(t, err)
+	assert.Equal(t, public[:3], timeline.Statuses)
+
+	page, err := service.GetPublicTimeline(context.Background(), timelines.Query{Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, public[:2], page.Statuses)
+	assert.Equal(t, uuid.Nil, page.UserId)
+}
+
+func TestService_ReplaceStatus_Public(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	service := newTestService(repo, NewMockFollowerService(), NewMockStatusService(), &MockPubSub{}, testTimelineConfig)
+	now := time.Now().UTC()
+	kept := newTestStatus(uuid.New(), now)
+	status := newTestStatus(uuid.New(), now.Add(-time.Minute))
+	assert.NoError(t, service.AddToPublic(context.Background(), kept))
+	assert.NoError(t, service.AddToPublic(context.Background(), status))
+
+	edited := status
+	edited.Content = "edited status"
+
+	// WHEN
+	err := service.ReplaceStatus(context.Background(), edited)
+
+	// THEN
+	assert.NoError(t, err)
+	timeline, err := repo.GetPublic()
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Status{kept, edited}, timeline.Statuses)
+}
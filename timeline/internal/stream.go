@@ -0,0 +1,154 @@
+package timelines
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"sync"
+	"yatc/internal"
+	timelines "yatc/timeline/pkg"
+)
+
+// streamBuffer is how many events a stream holds for a slow client before it is closed, the client resumes with
+// Last-Event-ID
+const streamBuffer = 64
+
+// Stream receives the events of the timeline of one user until it is closed
+type Stream struct {
+	userId uuid.UUID
+	// pulledFollowees are the pulled authors the user follows, their events don't name the user
+	pulledFollowees internal.Set[uuid.UUID]
+	events          chan timelines.Event
+}
+
+// Events is closed when the stream is closed
+func (stream *Stream) Events() <-chan timelines.Event {
+	return stream.events
+}
+
+// Hub hands the timeline events received by this replica to the streams open on it
+type Hub struct {
+	mutex   sync.Mutex
+	streams map[uuid.UUID]map[*Stream]struct{}
+	closed  bool
+}
+
+func NewHub() *Hub {
+	return &Hub{streams: map[uuid.UUID]map[*Stream]struct{}{}}
+}
+
+func (hub *Hub) Open(userId uuid.UUID, pulledFollowees internal.Set[uuid.UUID]) *Stream {
+	stream := &Stream{userId, pulledFollowees, make(chan timelines.Event, streamBuffer)}
+
+	hub.mutex.Lock()
+	defer hub.mutex.Unlock()
+	if hub.closed {
+		close(stream.events)
+		return stream
+	}
+	if hub.streams[userId] == nil {
+		hub.streams[userId] = map[*Stream]struct{}{}
+	}
+	hub.streams[userId][stream] = struct{}{}
+	return stream
+}
+
+func (hub *Hub) Close(stream *Stream) {
+	hub.mutex.Lock()
+	defer hub.mutex.Unlock()
+	hub.remove(stream)
+}
+
+// Shutdown closes all streams and the ones opened afterwards
+func (hub *Hub) Shutdown() {
+	hub.mutex.Lock()
+	defer hub.mutex.Unlock()
+	hub.closed = true
+	for _, streams := range hub.streams {
+		for stream := range streams {
+			hub.remove(stream)
+		}
+	}
+}
+
+// BroadcastReceiver passes the data of every event published to a topic to handle on every replica,
+// statuses.DaprStatusSubscriber is one
+type BroadcastReceiver interface {
+	ReceiveEach(topic string, consumerId string, handle func(ctx context.Context, data json.RawMessage) error)
+}
+
+// Subscribe has the hub receive all timeline events, consumerId has to be different for every replica
+func (hub *Hub) Subscribe(receiver BroadcastReceiver, consumerId string) {
+	receiver.ReceiveEach(timelines.Topic, consumerId, hub.receive)
+}
+
+func (hub *Hub) receive(ctx context.Context, data json.RawMessage) error {
+	var event timelines.Event
+	err := json.Unmarshal(data, &event)
+	if err != nil {
+		return err
+	}
+	hub.Dispatch(event)
+	return nil
+}
+
+// Dispatch hands an event to the streams of the users whose timeline it changes. A stream that is full is closed
+// rather than blocking the others.
+func (hub *Hub) Dispatch(event timelines.Event) {
+	hub.mutex.Lock()
+	defer hub.mutex.Unlock()
+
+	for _, stream := range hub.receiversOf(event) {
+		select {
+		case stream.events <- event:
+		default:
+			hub.remove(stream)
+		}
+	}
+}
+
+func (hub *Hub) receiversOf(event timelines.Event) []*Stream {
+	receivers := make([]*Stream, 0)
+	for _, userId := range event.UserIds {
+		for stream := range hub.streams[userId] {
+			receivers = append(receivers, stream)
+		}
+	}
+
+	if event.Pulled {
+		postedBy := event.PostedBy()
+		for _, streams := range hub.streams {
+			for stream := range streams {
+				if stream.pulledFollowees.Has(postedBy) && visibleTo(event, stream.userId, postedBy == event.Status.UserId) {
+					receivers = append(receivers, stream)
+				}
+			}
+		}
+	}
+	return receivers
+}
+
+// visibleTo reports whether a user may receive an event of a pulled author, which unlike the events of pushed statuses
+// isn't limited to the users who can see the status. Deleted statuses are checked as they were before.
+func visibleTo(event timelines.Event, userId uuid.UUID, following bool) bool {
+	status := event.Status
+	status.DeletedAt = nil
+	return status.VisibleTo(userId, following)
+}
+
+// remove closes a stream unless it was removed already, the mutex has to be held
+func (hub *Hub) remove(stream *Stream) {
+	streams, ok := hub.streams[stream.userId]
+	if !ok {
+		return
+	}
+	if _, ok := streams[stream]; !ok {
+		return
+	}
+
+	delete(streams, stream)
+	if len(streams) == 0 {
+		delete(hub.streams, stream.userId)
+	}
+	close(stream.events)
+}
@@ -0,0 +1,210 @@
+package timelines
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"yatc/internal"
+	statuses "yatc/status/pkg"
+	timelines "yatc/timeline/pkg"
+)
+
+// received drains the events a stream holds
+func received(stream *Stream) []timelines.Event {
+	events := make([]timelines.Event, 0)
+	for {
+		select {
+		case event := <-stream.Events():
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestHub_Dispatch(t *testing.T) {
+	// GIVEN
+	hub := NewHub()
+	pushedTo, other := uuid.New(), uuid.New()
+	pushedStream := hub.Open(pushedTo, internal.NewSet[uuid.UUID]())
+	otherStream := hub.Open(other, internal.NewSet[uuid.UUID]())
+	event := timelines.Event{Type: timelines.UpdateEvent, Status: newTestStatus(uuid.New(), time.Now().UTC()), UserIds: []uuid.UUID{pushedTo}}
+
+	// WHEN
+	hub.Dispatch(event)
+
+	// THEN
+	assert.Equal(t, []timelines.Event{event}, received(pushedStream))
+	assert.Empty(t, received(otherStream))
+}
+
+func TestHub_Dispatch_PulledMentioned(t *testing.T) {
+	// GIVEN
+	hub := NewHub()
+	authorId, mentionedId, followerId := uuid.New(), uuid.New(), uuid.New()
+	mentionedStream := hub.Open(mentionedId, *internal.SetOf(authorId))
+	followerStream := hub.Open(followerId, *internal.SetOf(authorId))
+
+	status := newTestStatus(authorId, time.Now().UTC())
+	status.Visibility = statuses.Mentioned
+	status.Mentions = []statuses.Mention{{UserId: mentionedId}}
+	event := timelines.Event{Type: timelines.UpdateEvent, Status: status, UserIds: []uuid.UUID{}, Pulled: true}
+
+	// WHEN
+	hub.Dispatch(event)
+
+	// THEN
+	assert.Equal(t, []timelines.Event{event}, received(mentionedStream))
+	assert.Empty(t, received(followerStream))
+}
+
+func TestHub_Dispatch_PulledRepostOfFollowersOnly(t *testing.T) {
+	// GIVEN
+	hub := NewHub()
+	authorId, reposterId, followerId := uuid.New(), uuid.New(), uuid.New()
+	followerStream := hub.Open(followerId, *internal.SetOf(reposterId))
+
+	status := newTestStatus(authorId, time.Now().UTC())
+	status.Visibility = statuses.Followers
+	status.Repost = &statuses.Repost{StatusId: status.Id, UserId: reposterId, CreatedAt: time.Now().UTC()}
+
+	// WHEN
+	hub.Dispatch(timelines.Event{Type: timelines.UpdateEvent, Status: status, UserIds: []uuid.UUID{}, Pulled: true})
+
+	// THEN
+	assert.Empty(t, received(followerStream))
+}
+
+func TestHub_Dispatch_PulledDeleted(t *testing.T) {
+	// GIVEN
+	hub := NewHub()
+	authorId, followerId := uuid.New(), uuid.New()
+	followerStream := hub.Open(followerId, *internal.SetOf(authorId))
+
+	status := newTestStatus(authorId, time.Now().UTC())
+	deletedAt := time.Now().UTC()
+	status.DeletedAt = &deletedAt
+	event := timelines.Event{Type: timelines.DeleteEvent, Status: status, UserIds: []uuid.UUID{}, Pulled: true}
+
+	// WHEN
+	hub.Dispatch(event)
+
+	// THEN
+	assert.Equal(t, []timelines.Event{event}, received(followerStream))
+}
+
+func TestHub_Dispatch_FullStream(t *testing.T) {
+	// GIVEN
+	hub := NewHub()
+	userId := uuid.New()
+	stream := hub.Open(userId, internal.NewSet[uuid.UUID]())
+	event := timelines.Event{Type: timelines.UpdateEvent, Status: newTestStatus(uuid.New(), time.Now().UTC()), UserIds: []uuid.UUID{userId}}
+
+	// WHEN
+	for i := 0; i <= streamBuffer; i++ {
+		hub.Dispatch(event)
+	}
+
+	// THEN
+	count := 0
+	for range stream.Events() {
+		count++
+	}
+	assert.Equal(t, streamBuffer, count)
+}
+
+func TestRelay(t *testing.T) {
+	// GIVEN
+	hub := NewHub()
+	userId := uuid.New()
+	stream := hub.Open(userId, internal.NewSet[uuid.UUID]())
+	now := time.Now().UTC()
+	missed := []statuses.Status{newTestStatus(uuid.New(), now.Add(-2*time.Minute)), newTestStatus(uuid.New(), now.Add(-time.Minute))}
+	newer := newTestStatus(uuid.New(), now)
+
+	// The update of missed[1] was dispatched after the stream was opened, but before the missed statuses were read
+	for _, event := range []timelines.Event{
+		{Type: timelines.UpdateEvent, Status: missed[1], UserIds: []uuid.UUID{userId}},
+		{Type: timelines.UpdateEvent, Status: newer, UserIds: []uuid.UUID{userId}},
+		{Type: timelines.DeleteEvent, Status: missed[0], UserIds: []uuid.UUID{userId}},
+	} {
+		hub.Dispatch(event)
+	}
+	hub.Close(stream)
+
+	sent := make([]streamMessage, 0)
+	send := func(message streamMessage) error {
+		sent = append(sent, message)
+		return nil
+	}
+
+	// WHEN
+	relay(make(chan struct{}), stream, missed, send, func() error { return nil })
+
+	// THEN
+	assert.Equal(t, []streamMessage{
+		streamMessageOf(timelines.UpdateEvent, missed[0]),
+		streamMessageOf(timelines.UpdateEvent, missed[1]),
+		streamMessageOf(timelines.UpdateEvent, newer),
+		streamMessageOf(timelines.DeleteEvent, missed[0]),
+	}, sent)
+	assert.Equal(t, statuses.CursorOf(newer).String(), sent[2].Id)
+	assert.Empty(t, sent[3].Id)
+}
+
+func TestRelay_SendFails(t *testing.T) {
+	// GIVEN
+	hub := NewHub()
+	userId := uuid.New()
+	stream := hub.Open(userId, internal.NewSet[uuid.UUID]())
+	hub.Dispatch(timelines.Event{Type: timelines.UpdateEvent, Status: newTestStatus(uuid.New(), time.Now().UTC()), UserIds: []uuid.UUID{userId}})
+
+	attempts := 0
+	send := func(message streamMessage) error {
+		attempts++
+		return errors.New("client gone")
+	}
+
+	// WHEN
+	relay(make(chan struct{}), stream, []statuses.Status{newTestStatus(uuid.New(), time.Now().UTC())}, send, func() error { return nil })
+
+	// THEN
+	assert.Equal(t, 1, attempts)
+}
+
+func TestService_OpenStream_Resume(t *testing.T) {
+	// GIVEN
+	repo := NewInMemoryRepo()
+	followerService := NewMockFollowerService()
+	statusService := NewMockStatusService()
+	service := newTestService(repo, followerService, statusService, &MockPubSub{}, testTimelineConfig)
+
+	pushedId, pulledId, userId := uuid.New(), uuid.New(), uuid.New()
+	followerService.follow(pushedId, userId)
+	followerService.follow(pulledId, userId)
+	assert.NoError(t, repo.AddPulledAuthor(pulledId))
+
+	now := time.Now().UTC()
+	seen := newTestStatus(pushedId, now.Add(-3*time.Minute))
+	pushed := newTestStatus(pushedId, now.Add(-time.Minute))
+	pulled := []statuses.Status{newTestStatus(pulledId, now), newTestStatus(pulledId, now.Add(-2*time.Minute)), newTestStatus(pulledId, now.Add(-4*time.Minute))}
+	statusService.post(pulled...)
+	_, err := repo.Save(timelines.Timeline{UserId: userId, Statuses: []statuses.Status{pushed, seen}})
+	assert.NoError(t, err)
+	lastEventId := statuses.CursorOf(seen)
+
+	// WHEN
+	stream, missed, err := service.OpenStream(context.Background(), userId, &lastEventId)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, []statuses.Status{pulled[1], pushed, pulled[0]}, missed)
+	assert.True(t, stream.pulledFollowees.Has(pulledId))
+
+	service.CloseStream(stream)
+	_, open := <-stream.Events()
+	assert.False(t, open)
+}
@@ -21,20 +21,98 @@ import (
 	"github.com/go-chi/chi/v5"
 )
 
+// PublicTimelineResponse defines model for PublicTimelineResponse.
+type PublicTimelineResponse struct {
+	// Next cursor for older statuses to pass as max_id, absent on the last page
+	Next *string `json:"next,omitempty"`
+
+	// Prev cursor for newer statuses to pass as since_id, absent if the page is empty and no since_id was passed
+	Prev     *string                       `json:"prev,omitempty"`
+	Statuses []externalRef0.StatusResponse `json:"statuses"`
+}
+
 // TimelineResponse defines model for TimelineResponse.
 type TimelineResponse struct {
-	Id       openapi_types.UUID            `json:"id"`
+	// ExpandContentWarnings preference of the user whether statuses with a spoilerText or marked sensitive are shown expanded
+	ExpandContentWarnings bool               `json:"expandContentWarnings"`
+	Id                    openapi_types.UUID `json:"id"`
+
+	// Next cursor for older statuses to pass as max_id, absent on the last page
+	Next *string `json:"next,omitempty"`
+
+	// Prev cursor for newer statuses to pass as since_id, absent if the page is empty and no since_id was passed
+	Prev     *string                       `json:"prev,omitempty"`
 	Statuses []externalRef0.StatusResponse `json:"statuses"`
 }
 
+// GetPublicTimelineParams defines parameters for GetPublicTimeline.
+type GetPublicTimelineParams struct {
+	// Limit maximum number of statuses to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// MaxId opaque cursor from the next field of a previous response, only return statuses older than it
+	MaxId *string `form:"max_id,omitempty" json:"max_id,omitempty"`
+
+	// SinceId opaque cursor from the prev field of a previous response, only return statuses newer than it
+	SinceId *string `form:"since_id,omitempty" json:"since_id,omitempty"`
+}
+
+// GetTimelineParams defines parameters for GetTimeline.
+type GetTimelineParams struct {
+	// Limit maximum number of statuses to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// MaxId opaque cursor from the next field of a previous response, only return statuses older than it
+	MaxId *string `form:"max_id,omitempty" json:"max_id,omitempty"`
+
+	// SinceId opaque cursor from the prev field of a previous response, only return statuses newer than it
+	SinceId *string `form:"since_id,omitempty" json:"since_id,omitempty"`
+
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be the user
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// StreamTimelineParams defines parameters for StreamTimeline.
+type StreamTimelineParams struct {
+	// LastEventId id of the last update event received, for clients that can't set the Last-Event-ID header
+	LastEventId *string `form:"last_event_id,omitempty" json:"last_event_id,omitempty"`
+
+	// LastEventID id of the last update event received, set by browsers when reconnecting an EventSource
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be the user
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// GetTimelineV1Params defines parameters for GetTimelineV1.
+type GetTimelineV1Params struct {
+	// Limit maximum number of statuses to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// MaxId opaque cursor from the next field of a previous response, only return statuses older than it
+	MaxId *string `form:"max_id,omitempty" json:"max_id,omitempty"`
+
+	// SinceId opaque cursor from the prev field of a previous response, only return statuses newer than it
+	SinceId *string `form:"since_id,omitempty" json:"since_id,omitempty"`
+
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be the user
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// get the timeline of all public statuses, newest first
+	// (GET /timelines/public)
+	GetPublicTimeline(w http.ResponseWriter, r *http.Request, params GetPublicTimelineParams)
 	// get a timeline by userId
 	// (GET /timelines/{userId})
-	GetTimeline(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID)
+	GetTimeline(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params GetTimelineParams)
+	// stream new, edited and deleted statuses of a timeline as server-sent events or over a websocket
+	// (GET /timelines/{userId}/stream)
+	StreamTimeline(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params StreamTimelineParams)
 	// get a timeline by userId
 	// (GET /v1/timelines/{userId})
-	GetTimelineV1(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID)
+	GetTimelineV1(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params GetTimelineV1Params)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -46,6 +124,50 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// GetPublicTimeline operation middleware
+func (siw *ServerInterfaceWrapper) GetPublicTimeline(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPublicTimelineParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_id", r.URL.Query(), &params.MaxId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since_id", r.URL.Query(), &params.SinceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since_id", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPublicTimeline(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetTimeline operation middleware
 func (siw *ServerInterfaceWrapper) GetTimeline(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -61,8 +183,141 @@ func (siw *ServerInterfaceWrapper) GetTimeline(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTimelineParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_id", r.URL.Query(), &params.MaxId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since_id", r.URL.Query(), &params.SinceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since_id", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTimeline(w, r, userId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// StreamTimeline operation middleware
+func (siw *ServerInterfaceWrapper) StreamTimeline(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, chi.URLParam(r, "userId"), &userId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamTimelineParams
+
+	// ------------- Optional query parameter "last_event_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "last_event_id", r.URL.Query(), &params.LastEventId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "last_event_id", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, valueList[0], &LastEventID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTimeline(w, r, userId)
+		siw.Handler.StreamTimeline(w, r, userId, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -87,8 +342,60 @@ func (siw *ServerInterfaceWrapper) GetTimelineV1(w http.ResponseWriter, r *http.
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTimelineV1Params
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_id", r.URL.Query(), &params.MaxId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since_id", r.URL.Query(), &params.SinceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since_id", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTimelineV1(w, r, userId)
+		siw.Handler.GetTimelineV1(w, r, userId, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -211,9 +518,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/timelines/public", wrapper.GetPublicTimeline)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/timelines/{userId}", wrapper.GetTimeline)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/timelines/{userId}/stream", wrapper.StreamTimeline)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/timelines/{userId}", wrapper.GetTimelineV1)
 	})
@@ -224,15 +537,43 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+yTTY/TPBDHv4o1z3NMN02XRcE3Tqg3BIjLqgfHmWy8SmJjTwpVle+Oxnnptl1YhLQ3",
-	"bs7Y8/L/zT9H0LZ1tsOOAsgjBF1jq+LxMynqwycMznYBOeK8dejJYLzXtiPsiI/4Q7WuQZAQYpKY7xKg",
-	"gxvj3nQPMCRgyvOMzfptVRZZvsKi2Kze6EKt3uWIq0zpUlV5scnv7iCByvpWEUjoe1M+V7fF0qhtGUcz",
-	"hG08vJg1BZT36sDffUC/vZiQY+J7bXQttEdFGATVKEapL482JODxW288liDvIT458Zka7pY0WzyiJp7l",
-	"i2mxMR3+egWvxXKUhucs//dYgYT/0pNj0sku6YVXrsA+x2Bpcq2d35uusty2xKC9cWRsB3JhwlMbiqLn",
-	"kHj/cQsJ7NGH8W3Gc1iHnXIGJNzerG9uIQGnqI6CUpoyQ3oc1zBw+AGjp5mz4q5sB/iA9KS1U161SOgD",
-	"yPvLERmqsJXgksA6QMaekECn2tlQW0ZwYkK+x2T6+/7AuMOOk0faUctmvb74J5VzjdFRQfoYeLLjk/q/",
-	"W+aV7+I+zkWGXmsMoeobsYCKaw592yp/AMkchRIzY1EcxKKb1AOTg/kSdpya7rO/XMnX7N9SXmUpnIt+",
-	"PzPtfQMSaiIn07SxWjW1DSTzdZ4Bq59KHGemS6lhN/wMAAD//3Q43cRqBgAA",
+	"H4sIAAAAAAAC/+xZb4/ctvH+KgPmB/ze6P46Sa/7KkZatEZbNEhcp4B7CGbF2RVjipQ51O1tjfvuBYeS",
+	"VtrV3q2vMFAg98orHUXOn+d5Zjj+pEpfN96Ri6wWnxSXFdUoP/9GLhrvfiRuvGNKrzRxGUyTXquFQqjz",
+	"EtLQMgVAp2FTUSCIFfV/BMNgnLwpvYvkoipUE3xDIRqSk8jpw939asUU06dlhQHLSIEhmHUVAVeRwvgM",
+	"VSi6x7qxpBZXvytU3DakFsq4SGsK6qFQHDHEEw/xK9n7u/7HzCHfzp2RYvBGPFn5UGNUC9W2RqthLcdg",
+	"3Lpf6rCWoA6bqtdc/YViWbX14TcPhQr0sTWBtFq8788a7dT7WEg4b4cN/PJXKmM69Adv7d+b/ZxOUxFN",
+	"tHtWbYnnXLjzkXiycibwe1bn3ftvj9l43Dq6b/JW+3lMaWq8tRDxAzE4D7UPBHJOASYyRLTWEAMGgpVx",
+	"aHc+Lb23hC4dnw/g13GSRY2RzqKpaffNLg51a6Np7Aw/NhXFioJYERhKTCjznikbFyt04B2Bbzp4HdqT",
+	"/yS+m0i1/Pi/QCu1UF9d7Jh70dH2YibFD8O+GAJu+9QF/t63boYSrq2XFBL2E7QYNpUXD3QhHiwJLDFn",
+	"61PYua17oki400Mfk+SvKXNqeELS6yex0rs+ivA4P8UAhqk/s6Bql9aUb01N1jg6Di9H9zMBKdvAPsDK",
+	"B/BWUwCOGFsmhuihQWZAhhrvfzG6AFwyuQg+x8YiR2hwPQucJtDdo6c52hw5jY0raXyeyQlIR4FhoLqJ",
+	"W5Fj54fFsEGWHWhWkvpzTsbaT/LBcZzt5XPYfy5D/V6N5/hIwYkxmGWbnhLKsItNwmIErvyGoW1gSSW2",
+	"TGCieBxkU/F5mu0yEEbSr2dSnrjegzp/r4oT5WBXBKZbpvfCpd6eTB5xQBVPVYxj6r/z4bGoHsN7X48n",
+	"ct/FdFerD1x8JHDIbNaONCy32T8Kd+I3uZG/CaLdJifHVZOlx7I12j1lvVtegHd2C02gMS1DF5FEKVlo",
+	"3BrM6TkmbU4AjpA/Ld1n6chMRyk8eb+Tjzd6mrHry29Xenl1c0bL5fXZ1+USz35/Q3R2haXG1c3y+uab",
+	"b56GWKGM+5Eau33rZ/HbGg1+4kCsDPe/AzVSXqMfvE0SNoiX9CYpzJiQdUeBsSt6T5plzQcaatVjzUah",
+	"atIG3+iphj15wH557Po9PgzCd3231feEQ7PY0SVL0ZKsd+uELpQiqorTFHW/456xrTHOHWuAsI2VD5CX",
+	"TBLl05MJ0AS/MpZmW41Uo09pL8bWNamwcnUKKdcUGbr1B7zcVMaKYCdAlRXp1n4GHzqFPrFcTUrM8PUh",
+	"wF7NXiPIsYnmjo5kIEZM5oMAMZWk1urUMlVGa3LQumhsJ0GoKQDyBxaimDibFG68sRTezjcmHeY2GJzJ",
+	"eEtFEIzjSKj3oXlwON036DRDUqjcMWSBCtJDOO9o3LL1tmR7Y9W10bMZibieYY9L2bTm36ShQq7Soj0b",
+	"C9iYWPk2yktLqJNfX43NeK80NolRa2/RrdXtiFtPMrtt9DMql2j0CMplhW5N/IyWYBfMrh0wZdVVQv6s",
+	"fqBQd4bN0lgTt08B/91u5X4fIVvvKv1MWzGO2ZQrY2HuMj6SzomBUxyPWTQI2lz78nTDnhH8fXbg50yD",
+	"GeA1gVYUyJVDbe7Cn+9nQ5FK4AOEkbHgA9QYPpCGwWi5QiaiuY5BpGe5+6XK9Mst5QveUiTkwyHFEYTN",
+	"ofXdhJD7kwAvl2emcU0sYOWt9RsKeSwhmjq86ZCai/o5vB1+S8SmkzcGtBvcyowhGe3aOjkj5bYUTHW7",
+	"7ig64dxwzZAucOUPPei5qIpuiLN7Ba9/eJP4ToHz2qs8uSCHjVEL9er88vxVYjrGStJ1Ebsv+aKzcPFJ",
+	"rUlAndgtnWESTPUnitO7u2wTsKaYnFm83zezxntTtzXshhhjYAaKbUgBMmntx5ZC0qY8h1PW1FKEM3By",
+	"BFbY2qgW15dFv7NaXF2mJ+O6p7k5xr5VvsGPLUFPmuBrSW0iMqwMWZ1vs4lqxkszndHadUnZ7J0nmd8y",
+	"gDHxiDeZ6RN3Dq6UJ5qZzHqOmVkYHjezZ/2jht4Wqj9K8HN9ebl3e8WmsaYU3Fz8yt7tJtlPdrTzoyFh",
+	"wjQ63JYlMa9aCwNIRT64rWsMW7VIGJaI9fiWeFmb295yiEwhoeGU+8BD8ZTxaP5O3aZ9RzT5lCvzw2NE",
+	"OZUi/UWuv5ikd4mau6QMbcBOGGNoaZyiJ8cVL8T8DRDzwFBuExFJZxuxMbDGSBuUm0WqXuRi4ulomMwU",
+	"oUbXorVbsL5M/57Dn1FwsaShW+vtrOTusjP0n2fdX58P1i+pL89XlkJ9fflqpvNCaynkC1rcRedQiHAn",
+	"Q8stDJw+WWsuOAbCeiQ5U0P+eJcclcYlpUJDvigUHc7O86M0K3kYV3RDCI0Rk/39+PYc/pGXUt6xxBDy",
+	"HawDfO6ETN9kAjJIUwmlNeQiBCq9c1TKfEl6eBPTor8ixzMx8+zNH7LYprVk7ia3LeK0vjbMpM/hZ1qy",
+	"Lz8kVBIzrrv/Msp9XndFEEMLMFq8a3BrPerMRD7/l1PFnjr/JJH83xbo3XRPrgPtKCV90HQhDX+Oejd1",
+	"L9H9fxQSp0+nER+oOqvqyPEX2f6zVeY0U5NNyy0sg990/49FbgoVdCC2/uTbUNIxgZn49FuUw6vLq0P2",
+	"88ZEmXTJkHPTkybJ1qF8RrqPF5Kfs52oHI/joUDKR9I0yFTmTG6TsiFnobw8tNC4O7RGZ4zIWjD6v5PV",
+	"zg5Hm6Kb2Y/kTY/6gNVYfZFnzIaka2muNA7eUXW+u3pmM/ju6qUdfGkHX9rBl3bwM9rB9K0IVtaLNtgU",
+	"6BibxcWFpKTyHBc3lzdX6uF22OJTn4Jhq4fbh/8MAEvgBvVfJgAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -36,17 +36,16 @@ func NewTimelineClient(config internal.DaprConfig) *TimelineClient {
 func StatusResponsesToStatuses(responses []statuses.StatusResponse) []statuses.Status {
 	allStatuses := make([]statuses.Status, 0)
 	for _, statusResponse := range responses {
-		allStatuses = append(allStatuses, statuses.Status{
-			Id:      statusResponse.Id,
-			Content: statusResponse.Content,
-			UserId:  statusResponse.UserId,
-		})
+		allStatuses = append(allStatuses, statuses.StatusFromStatusResponse(statusResponse))
 	}
 	return allStatuses
 }
 
-func (client *TimelineClient) GetTimeline(ctx context.Context, userId uuid.UUID) (Timeline, error) {
-	response, err := client.httpClient.GetTimeline(ctx, userId)
+// GetTimeline asks for the timeline on behalf of the user it belongs to
+func (client *TimelineClient) GetTimeline(ctx context.Context, userId uuid.UUID, query Query) (Timeline, error) {
+	limit, maxId, sinceId := paramsOf(query)
+	params := &GetTimelineParams{Limit: limit, MaxId: maxId, SinceId: sinceId, XUser: userId}
+	response, err := client.httpClient.GetTimeline(ctx, userId, params)
 	clientError := internal.ToClientError(response, err)
 	if clientError != nil {
 		return Timeline{}, clientError
@@ -58,12 +57,62 @@ func (client *TimelineClient) GetTimeline(ctx context.Context, userId uuid.UUID)
 		return Timeline{}, err
 	}
 
-	return Timeline{
+	timeline := Timeline{
 		UserId:   timelineResponse.Id,
 		Statuses: StatusResponsesToStatuses(timelineResponse.Statuses),
-	}, nil
+
+		ExpandContentWarnings: timelineResponse.ExpandContentWarnings,
+	}
+	return withCursors(timeline, timelineResponse.Next, timelineResponse.Prev)
+}
+
+func (client *TimelineClient) GetPublicTimeline(ctx context.Context, query Query) (Timeline, error) {
+	limit, maxId, sinceId := paramsOf(query)
+	response, err := client.httpClient.GetPublicTimeline(ctx, &GetPublicTimelineParams{Limit: limit, MaxId: maxId, SinceId: sinceId})
+	clientError := internal.ToClientError(response, err)
+	if clientError != nil {
+		return Timeline{}, clientError
+	}
+
+	var timelineResponse PublicTimelineResponse
+	err = render.DecodeJSON(response.Body, &timelineResponse)
+	if err != nil {
+		return Timeline{}, err
+	}
+
+	timeline := Timeline{Statuses: StatusResponsesToStatuses(timelineResponse.Statuses)}
+	return withCursors(timeline, timelineResponse.Next, timelineResponse.Prev)
+}
+
+func paramsOf(query Query) (*int, *string, *string) {
+	var maxId, sinceId *string
+	if query.MaxId != nil {
+		maxId = internal.Ptr(query.MaxId.String())
+	}
+	if query.SinceId != nil {
+		sinceId = internal.Ptr(query.SinceId.String())
+	}
+	return &query.Limit, maxId, sinceId
+}
+
+func withCursors(timeline Timeline, next *string, prev *string) (Timeline, error) {
+	if next != nil {
+		cursor, err := statuses.ParseCursor(*next)
+		if err != nil {
+			return Timeline{}, err
+		}
+		timeline.Next = &cursor
+	}
+	if prev != nil {
+		cursor, err := statuses.ParseCursor(*prev)
+		if err != nil {
+			return Timeline{}, err
+		}
+		timeline.Prev = &cursor
+	}
+	return timeline, nil
 }
 
 func (client *TimelineClient) UpdateTimelines(ctx context.Context, userId uuid.UUID, status statuses.Status) error {
-	panic("implement me")
+	return internal.NotImplementedError
 }
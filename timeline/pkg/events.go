@@ -0,0 +1,39 @@
+package timelines
+
+import (
+	"github.com/google/uuid"
+	statuses "yatc/status/pkg"
+)
+
+// Topic timeline events are published to
+const Topic = "timeline"
+
+type EventType string
+
+// Event types, named like the events of a timeline stream
+const (
+	// UpdateEvent adds a status to timelines
+	UpdateEvent EventType = "update"
+	// DeleteEvent removes a status and its reposts from timelines, a status with Repost set removes only that repost
+	DeleteEvent EventType = "delete"
+	// StatusUpdateEvent replaces an edited status in timelines
+	StatusUpdateEvent EventType = "status.update"
+)
+
+// Event is published whenever statuses are added to, edited in or removed from timelines. UserIds are the users whose
+// timelines changed. Statuses of pulled authors aren't stored in timelines, they are Pulled and meant for the
+// followers who can see them.
+type Event struct {
+	Type    EventType
+	Status  statuses.Status
+	UserIds []uuid.UUID
+	Pulled  bool
+}
+
+// PostedBy returns the user who put the status of the event into timelines, the reposter for reposts
+func (event Event) PostedBy() uuid.UUID {
+	if event.Status.Repost != nil {
+		return event.Status.Repost.UserId
+	}
+	return event.Status.UserId
+}
@@ -9,9 +9,23 @@ import (
 type Timeline struct {
 	UserId   uuid.UUID
 	Statuses []statuses.Status
+	// ExpandContentWarnings is the preference of the user, it is looked up whenever the timeline is read and not stored
+	ExpandContentWarnings bool `json:"-"`
+	// Next and Prev point at the ends of a page of the timeline, they are not stored either
+	Next *statuses.Cursor `json:"-"`
+	Prev *statuses.Cursor `json:"-"`
+}
+
+// Query selects a page of a timeline, newest first. MaxId and SinceId exclude the statuses they point at.
+type Query struct {
+	Limit   int
+	MaxId   *statuses.Cursor
+	SinceId *statuses.Cursor
 }
 
 type Service interface {
-	GetTimeline(ctx context.Context, userId uuid.UUID) (Timeline, error)
+	GetTimeline(ctx context.Context, userId uuid.UUID, query Query) (Timeline, error)
+	// GetPublicTimeline returns a page of the timeline of all public statuses, its UserId is uuid.Nil
+	GetPublicTimeline(ctx context.Context, query Query) (Timeline, error)
 	UpdateTimelines(ctx context.Context, userId uuid.UUID, status statuses.Status) error
 }
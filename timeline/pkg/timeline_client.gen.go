@@ -18,12 +18,84 @@ import (
 	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 )
 
+// PublicTimelineResponse defines model for PublicTimelineResponse.
+type PublicTimelineResponse struct {
+	// Next cursor for older statuses to pass as max_id, absent on the last page
+	Next *string `json:"next,omitempty"`
+
+	// Prev cursor for newer statuses to pass as since_id, absent if the page is empty and no since_id was passed
+	Prev     *string                       `json:"prev,omitempty"`
+	Statuses []externalRef0.StatusResponse `json:"statuses"`
+}
+
 // TimelineResponse defines model for TimelineResponse.
 type TimelineResponse struct {
-	Id       openapi_types.UUID            `json:"id"`
+	// ExpandContentWarnings preference of the user whether statuses with a spoilerText or marked sensitive are shown expanded
+	ExpandContentWarnings bool               `json:"expandContentWarnings"`
+	Id                    openapi_types.UUID `json:"id"`
+
+	// Next cursor for older statuses to pass as max_id, absent on the last page
+	Next *string `json:"next,omitempty"`
+
+	// Prev cursor for newer statuses to pass as since_id, absent if the page is empty and no since_id was passed
+	Prev     *string                       `json:"prev,omitempty"`
 	Statuses []externalRef0.StatusResponse `json:"statuses"`
 }
 
+// GetPublicTimelineParams defines parameters for GetPublicTimeline.
+type GetPublicTimelineParams struct {
+	// Limit maximum number of statuses to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// MaxId opaque cursor from the next field of a previous response, only return statuses older than it
+	MaxId *string `form:"max_id,omitempty" json:"max_id,omitempty"`
+
+	// SinceId opaque cursor from the prev field of a previous response, only return statuses newer than it
+	SinceId *string `form:"since_id,omitempty" json:"since_id,omitempty"`
+}
+
+// GetTimelineParams defines parameters for GetTimeline.
+type GetTimelineParams struct {
+	// Limit maximum number of statuses to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// MaxId opaque cursor from the next field of a previous response, only return statuses older than it
+	MaxId *string `form:"max_id,omitempty" json:"max_id,omitempty"`
+
+	// SinceId opaque cursor from the prev field of a previous response, only return statuses newer than it
+	SinceId *string `form:"since_id,omitempty" json:"since_id,omitempty"`
+
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be the user
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// StreamTimelineParams defines parameters for StreamTimeline.
+type StreamTimelineParams struct {
+	// LastEventId id of the last update event received, for clients that can't set the Last-Event-ID header
+	LastEventId *string `form:"last_event_id,omitempty" json:"last_event_id,omitempty"`
+
+	// LastEventID id of the last update event received, set by browsers when reconnecting an EventSource
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be the user
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// GetTimelineV1Params defines parameters for GetTimelineV1.
+type GetTimelineV1Params struct {
+	// Limit maximum number of statuses to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// MaxId opaque cursor from the next field of a previous response, only return statuses older than it
+	MaxId *string `form:"max_id,omitempty" json:"max_id,omitempty"`
+
+	// SinceId opaque cursor from the prev field of a previous response, only return statuses newer than it
+	SinceId *string `form:"since_id,omitempty" json:"since_id,omitempty"`
+
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be the user
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -97,15 +169,33 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetPublicTimeline request
+	GetPublicTimeline(ctx context.Context, params *GetPublicTimelineParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetTimeline request
-	GetTimeline(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetTimeline(ctx context.Context, userId openapi_types.UUID, params *GetTimelineParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamTimeline request
+	StreamTimeline(ctx context.Context, userId openapi_types.UUID, params *StreamTimelineParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetTimelineV1 request
-	GetTimelineV1(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetTimelineV1(ctx context.Context, userId openapi_types.UUID, params *GetTimelineV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetPublicTimeline(ctx context.Context, params *GetPublicTimelineParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPublicTimelineRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) GetTimeline(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTimelineRequest(c.Server, userId)
+func (c *Client) GetTimeline(ctx context.Context, userId openapi_types.UUID, params *GetTimelineParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTimelineRequest(c.Server, userId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -116,8 +206,8 @@ func (c *Client) GetTimeline(ctx context.Context, userId openapi_types.UUID, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTimelineV1(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTimelineV1Request(c.Server, userId)
+func (c *Client) StreamTimeline(ctx context.Context, userId openapi_types.UUID, params *StreamTimelineParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamTimelineRequest(c.Server, userId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -128,8 +218,99 @@ func (c *Client) GetTimelineV1(ctx context.Context, userId openapi_types.UUID, r
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetTimelineV1(ctx context.Context, userId openapi_types.UUID, params *GetTimelineV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTimelineV1Request(c.Server, userId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetPublicTimelineRequest generates requests for GetPublicTimeline
+func NewGetPublicTimelineRequest(server string, params *GetPublicTimelineParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/timelines/public")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.MaxId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_id", runtime.ParamLocationQuery, *params.MaxId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.SinceId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since_id", runtime.ParamLocationQuery, *params.SinceId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetTimelineRequest generates requests for GetTimeline
-func NewGetTimelineRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
+func NewGetTimelineRequest(server string, userId openapi_types.UUID, params *GetTimelineParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -154,16 +335,151 @@ func NewGetTimelineRequest(server string, userId openapi_types.UUID) (*http.Requ
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.MaxId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_id", runtime.ParamLocationQuery, *params.MaxId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.SinceId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since_id", runtime.ParamLocationQuery, *params.SinceId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam0)
+
+	return req, nil
+}
+
+// NewStreamTimelineRequest generates requests for StreamTimeline
+func NewStreamTimelineRequest(server string, userId openapi_types.UUID, params *StreamTimelineParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/timelines/%s/stream", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.LastEventId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "last_event_id", runtime.ParamLocationQuery, *params.LastEventId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.LastEventID != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Last-Event-ID", headerParam0)
+	}
+
+	var headerParam1 string
+
+	headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam1)
+
 	return req, nil
 }
 
 // NewGetTimelineV1Request generates requests for GetTimelineV1
-func NewGetTimelineV1Request(server string, userId openapi_types.UUID) (*http.Request, error) {
+func NewGetTimelineV1Request(server string, userId openapi_types.UUID, params *GetTimelineV1Params) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -188,11 +504,72 @@ func NewGetTimelineV1Request(server string, userId openapi_types.UUID) (*http.Re
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.MaxId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_id", runtime.ParamLocationQuery, *params.MaxId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.SinceId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since_id", runtime.ParamLocationQuery, *params.SinceId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam0)
+
 	return req, nil
 }
 
@@ -239,11 +616,39 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// GetPublicTimeline request
+	GetPublicTimelineWithResponse(ctx context.Context, params *GetPublicTimelineParams, reqEditors ...RequestEditorFn) (*GetPublicTimelineResponse, error)
+
 	// GetTimeline request
-	GetTimelineWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTimelineResponse, error)
+	GetTimelineWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetTimelineParams, reqEditors ...RequestEditorFn) (*GetTimelineResponse, error)
+
+	// StreamTimeline request
+	StreamTimelineWithResponse(ctx context.Context, userId openapi_types.UUID, params *StreamTimelineParams, reqEditors ...RequestEditorFn) (*StreamTimelineResponse, error)
 
 	// GetTimelineV1 request
-	GetTimelineV1WithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTimelineV1Response, error)
+	GetTimelineV1WithResponse(ctx context.Context, userId openapi_types.UUID, params *GetTimelineV1Params, reqEditors ...RequestEditorFn) (*GetTimelineV1Response, error)
+}
+
+type GetPublicTimelineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PublicTimelineResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPublicTimelineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPublicTimelineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
 type GetTimelineResponse struct {
@@ -268,6 +673,27 @@ func (r GetTimelineResponse) StatusCode() int {
 	return 0
 }
 
+type StreamTimelineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamTimelineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamTimelineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetTimelineV1Response struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -290,24 +716,68 @@ func (r GetTimelineV1Response) StatusCode() int {
 	return 0
 }
 
+// GetPublicTimelineWithResponse request returning *GetPublicTimelineResponse
+func (c *ClientWithResponses) GetPublicTimelineWithResponse(ctx context.Context, params *GetPublicTimelineParams, reqEditors ...RequestEditorFn) (*GetPublicTimelineResponse, error) {
+	rsp, err := c.GetPublicTimeline(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPublicTimelineResponse(rsp)
+}
+
 // GetTimelineWithResponse request returning *GetTimelineResponse
-func (c *ClientWithResponses) GetTimelineWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTimelineResponse, error) {
-	rsp, err := c.GetTimeline(ctx, userId, reqEditors...)
+func (c *ClientWithResponses) GetTimelineWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetTimelineParams, reqEditors ...RequestEditorFn) (*GetTimelineResponse, error) {
+	rsp, err := c.GetTimeline(ctx, userId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseGetTimelineResponse(rsp)
 }
 
+// StreamTimelineWithResponse request returning *StreamTimelineResponse
+func (c *ClientWithResponses) StreamTimelineWithResponse(ctx context.Context, userId openapi_types.UUID, params *StreamTimelineParams, reqEditors ...RequestEditorFn) (*StreamTimelineResponse, error) {
+	rsp, err := c.StreamTimeline(ctx, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamTimelineResponse(rsp)
+}
+
 // GetTimelineV1WithResponse request returning *GetTimelineV1Response
-func (c *ClientWithResponses) GetTimelineV1WithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTimelineV1Response, error) {
-	rsp, err := c.GetTimelineV1(ctx, userId, reqEditors...)
+func (c *ClientWithResponses) GetTimelineV1WithResponse(ctx context.Context, userId openapi_types.UUID, params *GetTimelineV1Params, reqEditors ...RequestEditorFn) (*GetTimelineV1Response, error) {
+	rsp, err := c.GetTimelineV1(ctx, userId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseGetTimelineV1Response(rsp)
 }
 
+// ParseGetPublicTimelineResponse parses an HTTP response from a GetPublicTimelineWithResponse call
+func ParseGetPublicTimelineResponse(rsp *http.Response) (*GetPublicTimelineResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPublicTimelineResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PublicTimelineResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetTimelineResponse parses an HTTP response from a GetTimelineWithResponse call
 func ParseGetTimelineResponse(rsp *http.Response) (*GetTimelineResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -334,6 +804,22 @@ func ParseGetTimelineResponse(rsp *http.Response) (*GetTimelineResponse, error)
 	return response, nil
 }
 
+// ParseStreamTimelineResponse parses an HTTP response from a StreamTimelineWithResponse call
+func ParseStreamTimelineResponse(rsp *http.Response) (*StreamTimelineResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamTimelineResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
 // ParseGetTimelineV1Response parses an HTTP response from a GetTimelineV1WithResponse call
 func ParseGetTimelineV1Response(rsp *http.Response) (*GetTimelineV1Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -48,7 +48,7 @@ func main() {
 	}
 
 	userService := iusers.NewUserService(userRepo)
-	followerService := followers.NewFollowerService(userRepo)
+	followerService := followers.NewFollowerService(userRepo, followers.NewDaprFollowPublisher(client, config.Dapr.PubSub))
 	userApi := api.NewUserApi(userService, followerService)
 
 	port, err := strconv.Atoi(config.Port)
@@ -56,7 +56,8 @@ func main() {
 		logger.Fatal("port not a int", zap.String("port", config.Port))
 	}
 
-	server := internal.NewServer(logger, port)
+	rateLimiter := internal.NewRateLimiter(internal.NewDaprRateLimitStore(client, config.Dapr.StateStore), config.RateLimits)
+	server := internal.NewServer(logger, port, rateLimiter.Middleware())
 	server.Router.Route("/", userApi.ConfigureRouter)
 
 	server.StartAndWait()
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"yatc/internal"
 	ifollowers "yatc/user/internal/followers"
+	iusers "yatc/user/internal/users"
 	"yatc/user/pkg/followers"
 	"yatc/user/pkg/users"
 )
@@ -26,6 +27,12 @@ func UserResponseFromUser(user users.User) UserResponse {
 	}
 }
 
+func PreferencesResponseFromPreferences(preferences users.Preferences) PreferencesResponse {
+	return PreferencesResponse{
+		ExpandContentWarnings: preferences.ExpandContentWarnings,
+	}
+}
+
 func UserFromCreateUserRequest(request CreateUserRequest) users.User {
 	return users.User{
 		Id:   uuid.New(),
@@ -129,7 +136,11 @@ func (api *UserApi) CreateUser(w http.ResponseWriter, r *http.Request) {
 	user := UserFromCreateUserRequest(createUserRequest)
 	user, err = api.userService.CreateUser(user)
 	if err != nil {
-		internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		if errors.Is(err, iusers.UsernameTakenError) {
+			internal.ReplyWithError(w, r, err, http.StatusConflict)
+		} else {
+			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		}
 		return
 	}
 
@@ -146,6 +157,67 @@ func (api *UserApi) GetUser(w http.ResponseWriter, r *http.Request, userId uuid.
 	internal.ReplyWithStatusOkWithJSON(w, r, UserResponseFromUser(user))
 }
 
+func (api *UserApi) GetPreferences(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params GetPreferencesParams) {
+	if !checkUserId(userId, params.XUser) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	preferences, err := api.userService.GetPreferences(userId)
+	if err != nil {
+		if errors.Is(err, internal.NotFoundError(userId)) {
+			internal.ReplyWithError(w, r, err, http.StatusNotFound)
+		} else {
+			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, PreferencesResponseFromPreferences(preferences))
+}
+
+func (api *UserApi) UpdatePreferences(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params UpdatePreferencesParams) {
+	if !checkUserId(userId, params.XUser) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var updatePreferencesRequest UpdatePreferencesRequest
+	err := render.Decode(r, &updatePreferencesRequest)
+	if err != nil {
+		internal.ReplyWithError(w, r, err, http.StatusBadRequest)
+		return
+	}
+
+	preferences := users.Preferences{ExpandContentWarnings: updatePreferencesRequest.ExpandContentWarnings}
+	preferences, err = api.userService.UpdatePreferences(userId, preferences)
+	if err != nil {
+		if errors.Is(err, internal.NotFoundError(userId)) {
+			internal.ReplyWithError(w, r, err, http.StatusNotFound)
+		} else {
+			internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, PreferencesResponseFromPreferences(preferences))
+}
+
+func (api *UserApi) GetUsersByName(w http.ResponseWriter, r *http.Request, params GetUsersByNameParams) {
+	allUsers, err := api.userService.GetUsersByName(params.Username)
+	if err != nil {
+		internal.ReplyWithError(w, r, err, http.StatusInternalServerError)
+		return
+	}
+
+	userResponses := make([]UserResponse, len(allUsers))
+	for i, user := range allUsers {
+		userResponses[i] = UserResponseFromUser(user)
+	}
+
+	internal.ReplyWithStatusOkWithJSON(w, r, UsersResponse{userResponses})
+}
+
 func (api *UserApi) GetFollowees(w http.ResponseWriter, r *http.Request, userId uuid.UUID) {
 	allUsers, err := api.followerService.GetFollowees(context.Background(), userId)
 	if err != nil {
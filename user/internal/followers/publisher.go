@@ -0,0 +1,30 @@
+package followers
+
+import (
+	"context"
+	"github.com/dapr/go-sdk/client"
+	"yatc/internal"
+	"yatc/user/pkg/followers"
+)
+
+type Publisher interface {
+	PublishFollowed(event followers.FollowEvent) error
+	PublishUnfollowed(event followers.FollowEvent) error
+}
+
+type DaprFollowPublisher struct {
+	client client.Client
+	config internal.PubSubConfig
+}
+
+func NewDaprFollowPublisher(client client.Client, config internal.PubSubConfig) *DaprFollowPublisher {
+	return &DaprFollowPublisher{client, config}
+}
+
+func (pub *DaprFollowPublisher) PublishFollowed(event followers.FollowEvent) error {
+	return pub.client.PublishEvent(context.Background(), pub.config.Name, followers.FollowedTopic, event)
+}
+
+func (pub *DaprFollowPublisher) PublishUnfollowed(event followers.FollowEvent) error {
+	return pub.client.PublishEvent(context.Background(), pub.config.Name, followers.UnfollowedTopic, event)
+}
@@ -7,17 +7,19 @@ import (
 	"github.com/google/uuid"
 	"yatc/internal"
 	iusers "yatc/user/internal/users"
+	"yatc/user/pkg/followers"
 	"yatc/user/pkg/users"
 )
 
 var SelfFollowError = errors.New("cant follow one self")
 
 type Service struct {
-	repo iusers.Repository
+	repo      iusers.Repository
+	publisher Publisher
 }
 
-func NewFollowerService(repo iusers.Repository) *Service {
-	return &Service{repo: repo}
+func NewFollowerService(repo iusers.Repository, publisher Publisher) *Service {
+	return &Service{repo: repo, publisher: publisher}
 }
 
 func (service *Service) GetFollowers(ctx context.Context, userId uuid.UUID) ([]users.User, error) {
@@ -91,6 +93,11 @@ func (service *Service) FollowUser(ctx context.Context, userToFollowId uuid.UUID
 		return users.User{}, err
 	}
 
+	err = service.publisher.PublishFollowed(followers.FollowEvent{FollowerId: userWhichFollowsId, FolloweeId: userToFollowId})
+	if err != nil {
+		return users.User{}, err
+	}
+
 	return userToFollow, nil
 }
 
@@ -128,5 +135,5 @@ func (service *Service) UnfollowUser(ctx context.Context, userToUnfollowId uuid.
 		return err
 	}
 
-	return nil
+	return service.publisher.PublishUnfollowed(followers.FollowEvent{FollowerId: userWhichUnfollowsId, FolloweeId: userToUnfollowId})
 }
@@ -7,6 +7,7 @@ import (
 	"yatc/internal"
 
 	"github.com/google/uuid"
+	"yatc/user/pkg/followers"
 	"yatc/user/pkg/users"
 )
 
@@ -33,14 +34,37 @@ func (repo *mockRepo) Save(user users.User) (users.User, error) {
 	return user, nil
 }
 
+func (repo *mockRepo) Create(user users.User) (users.User, error) {
+	return repo.Save(user)
+}
+
 func (repo *mockRepo) List() ([]users.User, error) {
 	panic("implement me")
 }
 
+func (repo *mockRepo) GetByName(name string) (users.User, error) {
+	panic("implement me")
+}
+
 func (repo *mockRepo) Delete(userId uuid.UUID) (users.User, error) {
 	panic("implement me")
 }
 
+type mockPublisher struct {
+	followed   []followers.FollowEvent
+	unfollowed []followers.FollowEvent
+}
+
+func (pub *mockPublisher) PublishFollowed(event followers.FollowEvent) error {
+	pub.followed = append(pub.followed, event)
+	return nil
+}
+
+func (pub *mockPublisher) PublishUnfollowed(event followers.FollowEvent) error {
+	pub.unfollowed = append(pub.unfollowed, event)
+	return nil
+}
+
 func contains(s []users.User, e string) bool {
 	for _, a := range s {
 		if a.Name == e {
@@ -82,7 +106,7 @@ func TestService_GetFollowers(t *testing.T) {
 		}
 	}
 
-	service := NewFollowerService(mockRepo)
+	service := NewFollowerService(mockRepo, &mockPublisher{})
 
 	followers, err := service.GetFollowers(ctx, mockUsers[0].Id)
 	assert.NoError(t, err)
@@ -124,7 +148,7 @@ func TestService_GetFollowees(t *testing.T) {
 		}
 	}
 
-	service := NewFollowerService(mockRepo)
+	service := NewFollowerService(mockRepo, &mockPublisher{})
 
 	followers, err := service.GetFollowees(ctx, mockUsers[0].Id)
 	assert.NoError(t, err)
@@ -133,3 +157,35 @@ func TestService_GetFollowees(t *testing.T) {
 	assert.True(t, contains(followers, "User 2"), "expected followees to contain User 2. %v", followers)
 	assert.True(t, contains(followers, "User 2"), "expected followees to contain User 3. %v", followers)
 }
+
+func TestService_FollowUser_PublishesEvents(t *testing.T) {
+	follower := users.User{
+		Id:        uuid.New(),
+		Name:      "User 1",
+		Followers: internal.Ptr(internal.NewSet[uuid.UUID]()),
+		Followees: internal.Ptr(internal.NewSet[uuid.UUID]()),
+	}
+	followee := users.User{
+		Id:        uuid.New(),
+		Name:      "User 2",
+		Followers: internal.Ptr(internal.NewSet[uuid.UUID]()),
+		Followees: internal.Ptr(internal.NewSet[uuid.UUID]()),
+	}
+
+	mockRepo := newMockRepo()
+	for _, user := range []users.User{follower, followee} {
+		_, err := mockRepo.Save(user)
+		assert.NoError(t, err)
+	}
+	publisher := &mockPublisher{}
+	service := NewFollowerService(mockRepo, publisher)
+
+	_, err := service.FollowUser(ctx, followee.Id, follower.Id)
+	assert.NoError(t, err)
+	err = service.UnfollowUser(ctx, followee.Id, follower.Id)
+	assert.NoError(t, err)
+
+	event := followers.FollowEvent{FollowerId: follower.Id, FolloweeId: followee.Id}
+	assert.Equal(t, []followers.FollowEvent{event}, publisher.followed)
+	assert.Equal(t, []followers.FollowEvent{event}, publisher.unfollowed)
+}
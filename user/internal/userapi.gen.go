@@ -29,6 +29,18 @@ type CreateUserRequest struct {
 	Username string `json:"username"`
 }
 
+// PreferencesResponse defines model for PreferencesResponse.
+type PreferencesResponse struct {
+	// ExpandContentWarnings timelines show statuses with a content warning or sensitive media expanded
+	ExpandContentWarnings bool `json:"expandContentWarnings"`
+}
+
+// UpdatePreferencesRequest defines model for UpdatePreferencesRequest.
+type UpdatePreferencesRequest struct {
+	// ExpandContentWarnings timelines show statuses with a content warning or sensitive media expanded
+	ExpandContentWarnings bool `json:"expandContentWarnings"`
+}
+
 // UserResponse defines model for UserResponse.
 type UserResponse struct {
 	Id       openapi_types.UUID `json:"id"`
@@ -40,6 +52,12 @@ type UsersResponse struct {
 	Users []UserResponse `json:"users"`
 }
 
+// GetUsersByNameParams defines parameters for GetUsersByName.
+type GetUsersByNameParams struct {
+	// Username username to look up, can be repeated
+	Username []string `form:"username" json:"username"`
+}
+
 // DeleteUserParams defines parameters for DeleteUser.
 type DeleteUserParams struct {
 	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be equal to userId in path
@@ -58,14 +76,32 @@ type UnfollowUserParams struct {
 	XUser openapi_types.UUID `json:"X-user"`
 }
 
+// GetPreferencesParams defines parameters for GetPreferences.
+type GetPreferencesParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be equal to userId in path
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// UpdatePreferencesParams defines parameters for UpdatePreferences.
+type UpdatePreferencesParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be equal to userId in path
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody = CreateUserRequest
 
 // FollowUserJSONRequestBody defines body for FollowUser for application/json ContentType.
 type FollowUserJSONRequestBody = CreateFollowerRequest
 
+// UpdatePreferencesJSONRequestBody defines body for UpdatePreferences for application/json ContentType.
+type UpdatePreferencesJSONRequestBody = UpdatePreferencesRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// get users by their usernames
+	// (GET /users)
+	GetUsersByName(w http.ResponseWriter, r *http.Request, params GetUsersByNameParams)
 	// create a user
 	// (POST /users)
 	CreateUser(w http.ResponseWriter, r *http.Request)
@@ -87,6 +123,12 @@ type ServerInterface interface {
 	// unfollow a user
 	// (DELETE /users/{userId}/followers/{followerUserId})
 	UnfollowUser(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, followerUserId openapi_types.UUID, params UnfollowUserParams)
+	// get the preferences of a user
+	// (GET /users/{userId}/preferences)
+	GetPreferences(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params GetPreferencesParams)
+	// replace the preferences of a user
+	// (PUT /users/{userId}/preferences)
+	UpdatePreferences(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params UpdatePreferencesParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -98,6 +140,41 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// GetUsersByName operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersByName(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersByNameParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersByName(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // CreateUser operation middleware
 func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -362,6 +439,114 @@ func (siw *ServerInterfaceWrapper) UnfollowUser(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, chi.URLParam(r, "userId"), &userId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPreferencesParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPreferences(w, r, userId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdatePreferences operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, chi.URLParam(r, "userId"), &userId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdatePreferencesParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-user" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-user")]; found {
+		var XUser openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-user", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, valueList[0], &XUser)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-user", Err: err})
+			return
+		}
+
+		params.XUser = XUser
+
+	} else {
+		err := fmt.Errorf("Header parameter X-user is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-user", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePreferences(w, r, userId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -475,6 +660,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users", wrapper.GetUsersByName)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/users", wrapper.CreateUser)
 	})
@@ -496,6 +684,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/users/{userId}/followers/{followerUserId}", wrapper.UnfollowUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{userId}/preferences", wrapper.GetPreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/users/{userId}/preferences", wrapper.UpdatePreferences)
+	})
 
 	return r
 }
@@ -503,20 +697,25 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xXSW/bOBT+K8SbOcqW7UwGHt2mLdIGvRUIUCDwgZKeLAYSyXBJahj67wVJWba8xEbS",
-	"LAhyiRSK5Pu2J9FLyEQtBUduNCRL0FmJNfW3nxVSgxeiqsQ9qh94a1Eb90AqIVEZhn4ay91f/EVrWSEk",
-	"MBn9W+TpeDrANJ0M/slSOvhvijgY0yynxTSdTM/PIYJCqJoaSMBalkMEZiHdam0U43NomggU3lqmMIfk",
-	"2hWZdXNEeoOZgSZqIV7pB+BZjYrTGvsg/9fldzRZaeujpbsN9gEIpbUUXONLSRM9nZPf9igxfZiZWxso",
-	"Gqz9zd8KC0jgr3idp7gNU9xTqemqUaXoYq/eGmaNG2e8EG7zHHWmmDRMcEgCNseRGU989f8dKh1mjF0V",
-	"IZFTySCBs+FoeAYRSGpKDzbu8EsRQuPIUbf/ZQ7JRq4ggENtPol84WZmghvkfhGVsmKZXxbfaFd51UDH",
-	"FNkNbtPXwSiLfiCo5rFORuM/BqBviavd11jbLEOtC1tVC5J5tLm3Stu6pmoBCYRRQokNOhk61z0Do1bn",
-	"eOkul3kTrKzQ4K7kX/x4K7mkitZovEfXyx1ojjXmpFCiJlQyMqcG7+mCsIJQa0rkxomCeUQyykmKRKMh",
-	"NeWWOjaVyNx1SL5RTYxwz/HW0srdB6CEceKyAi6BkECJNPe4QtPBz4FdR2PtV7Sh/dHX2zYrN4+IYiWm",
-	"r9tiaKsGbE+qOttJ1Gi3vdbWk86iLeuDia31JF2QUG0rABHMcU9zfUVzis2vKcgLt9hBnedojom822Vx",
-	"ET7ZgdEhCy66Se/Bh5M/Q3rTkUdbUlVeDk3uS5aVZM7ukAebgvZ6w6jWjSNmqVPMUh9mPc6sTmSnzc4H",
-	"a9Oh6MCJIDjwZt5b0bv8KM6e87C1/UPmpAPX6HUOXG0i8yFRaKzimpgSu9EQrX7Sw7OHs/3Q2yderm6v",
-	"jp/VrnjxxtthVXVFan/lPuWPhny286LlJ+XTrUF1twqSVZWjZYxM4tjrUQptkuloeg4OWrvHcttODc6O",
-	"jXRpaGbN7wAAAP//1PzGCeoQAAA=",
+	"H4sIAAAAAAAC/+xYW2/bOBP9KwN+36McO2mzcPW27aK7wQKLRYFgFyj8QIsji41EKuQwjhHovy9IyrdI",
+	"vjRN0rTIS6xIFOdyzpkZ8Y5luqq1QkWWpXfMZgVWPFx+MMgJP+qy1HM0n/DaoSX/oDa6RkMSwzIp/F+8",
+	"5VVdIkvZ2eiXXExPxwOcTs8Gb7MpH7wbIw5OeSZ4Pp6ejc/PWcJybSpOLGXOScESRovav23JSDVjTZMw",
+	"g9dOGhQs/eyNTFZr9PQLZsSapHXx0u5xz1k0ile47eSvtvgTKStcddD0aoM+B/42mKNBlaH9hLbWymLX",
+	"BbytuRIftCJU9A83SqpZeCDQZkbWJLViKSNZYSkVWrCFnoMlTs6ihbmkAjhk8X2Yxw1AG7CorCR5g1Ch",
+	"kByiIdxI51TrErnqBNXvUl+El7XghFtx7sj0jx1m4NAuBJ+G48m3kzNsu5ehPrA93PTvxhAJq3Dxf4M5",
+	"S9n/huvCMGyrwnArS83KGjeGL3qFY9mk8felynWXCsE3H6OkEjf+v0Fj44pTb0XXqHgtWcrenIxO3rCE",
+	"1ZyK4Oxw5f8MqWtgmRkL3CBUnLICBWTc4kCqFa3KRQJOXSk9V7D9Rok5gXYEOgcqEMwy9uCU4d7MhWAp",
+	"+x0pOP9+8ZcHwntoeIUUnPu8yy0gDaXWV+DqBDKuYOpN1MgpkBtv61ILZCkZhz6HLGXXDs2CJSyyZg39",
+	"Zurj8ojZFrQd/t3Db5KwZYRh/dlo5H9aSfpLXtelzELcwy9Wq3XLOIY6ax4GUmwnxbosQ2tzV8Iqt4FT",
+	"1lUVNwuWeowDQBamC4+HNGu8WMKIz+wm8RJW61iotsFad402bWjpvRaLR4u125aaprmPUNNJ9umjJvu4",
+	"XJcLyEwkXJOwt6N3u0UE0gIvDXKxAOJXeB+duA/wgEkPHE3SynV4538uRBNtlUjYBem3cL8Faa+arPN5",
+	"QgG50RXwWsKME875AmQO3FGBinwaUaxEZpGg4spxH3+pM/97An9w6xU5RcBrx0t/HR0FqcCXHNaKsEAu",
+	"gl+tCv8duDWZ+jV4cNzpZN1J4ctOu3Ow2/qwof0L8U1Wdwj+q4UZQWyh9+KUoocAybJI99bOg0XzOybk",
+	"mUW5twAeSHJXZcM8jvC42Sc7EHxcLfoZcDh6mtlqSQ+GpCzbvjQvZFbATN5gHCUg5n6zO8U7h8Ayx4Bl",
+	"XsF6GFirJPvcdBrWJkK7ZoiIwIupW8lP2RQnTzme3T/YOGpEG32fEa1lpDgBg+SMsuFrZHk3Umub6fHZ",
+	"fm7vqz7Du+Xl5eFZ7VLlL1wOS6vLoPotb4f8KsgnmxedeiA/6/U51L7+uHFc9Vqen5sNj1Ie+w5Wj+75",
+	"/kP2tEvMGLL/jFWaQgWNhdMvf9v/3RtW5top0TNJ+B02CNk7S2ycRbgernZOV1/p+qNNEzsPyJ95oHjp",
+	"ijFYlzzDr1JN2AHNzVILzpSeG0R1OhwGUhXaUjoejc9ZM1m9f3e/oVvWJKubcfNm0vw3AH3B34D8GgAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -3,16 +3,25 @@ package users
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	dapr "github.com/dapr/go-sdk/client"
 	"github.com/google/uuid"
+	"strings"
 	"yatc/internal"
 	"yatc/user/pkg/users"
 )
 
+var UsernameNotFoundError = errors.New("no user with this username")
+
 type Repository interface {
 	List() ([]users.User, error)
 	Get(userId uuid.UUID) (users.User, error)
+	// GetByName looks up a user by username, ignoring case
+	GetByName(name string) (users.User, error)
 	Delete(userId uuid.UUID) (users.User, error)
+	// Create saves a new user, it fails with UsernameTakenError if another user got the username first
+	Create(user users.User) (users.User, error)
 	Save(user users.User) (users.User, error)
 }
 
@@ -30,6 +39,9 @@ func (repo *DaprStateStoreRepo) List() ([]users.User, error) {
 	if err != nil {
 		return nil, err
 	}
+	if item.Value == nil {
+		return []users.User{}, nil
+	}
 
 	listOfKeys := make([]string, 0)
 	err = json.Unmarshal(item.Value, &listOfKeys)
@@ -73,6 +85,58 @@ func (repo *DaprStateStoreRepo) Get(userId uuid.UUID) (users.User, error) {
 	return user, nil
 }
 
+// usernameKey maps a username to the id of its user
+func usernameKey(name string) string {
+	return fmt.Sprintf("username-%s", strings.ToLower(name))
+}
+
+func (repo *DaprStateStoreRepo) GetByName(name string) (users.User, error) {
+	item, err := repo.dapr.GetState(context.Background(), repo.config.Name, usernameKey(name), nil)
+	if err != nil {
+		return users.User{}, err
+	}
+	if item.Value == nil {
+		return repo.backfillUsername(name)
+	}
+
+	var userId uuid.UUID
+	err = json.Unmarshal(item.Value, &userId)
+	if err != nil {
+		return users.User{}, err
+	}
+
+	user, err := repo.Get(userId)
+	if errors.Is(err, internal.NotFoundError(userId)) {
+		return users.User{}, UsernameNotFoundError
+	}
+	return user, err
+}
+
+// backfillUsername looks for a user saved before usernames were indexed and adds them to the index
+func (repo *DaprStateStoreRepo) backfillUsername(name string) (users.User, error) {
+	allUsers, err := repo.List()
+	if err != nil {
+		return users.User{}, err
+	}
+
+	for _, user := range allUsers {
+		if !strings.EqualFold(user.Name, name) {
+			continue
+		}
+
+		userIdJson, err := json.Marshal(user.Id)
+		if err != nil {
+			return users.User{}, err
+		}
+		err = repo.dapr.SaveState(context.Background(), repo.config.Name, usernameKey(user.Name), userIdJson, nil)
+		if err != nil {
+			return users.User{}, err
+		}
+		return user, nil
+	}
+	return users.User{}, UsernameNotFoundError
+}
+
 func (repo *DaprStateStoreRepo) Delete(userId uuid.UUID) (users.User, error) {
 	ctx := context.Background()
 	userItem, err := repo.dapr.GetState(ctx, repo.config.Name, userId.String(), nil)
@@ -84,13 +148,23 @@ func (repo *DaprStateStoreRepo) Delete(userId uuid.UUID) (users.User, error) {
 		return users.User{}, internal.NotFoundError(userId)
 	}
 
-	err = repo.dapr.DeleteState(ctx, repo.config.Name, userId.String(), nil)
+	var user users.User
+	err = json.Unmarshal(userItem.Value, &user)
 	if err != nil {
 		return users.User{}, err
 	}
 
-	var user users.User
-	err = json.Unmarshal(userItem.Value, &user)
+	deleteUserOp := dapr.StateOperation{
+		Type: dapr.StateOperationTypeDelete,
+		Item: &dapr.SetStateItem{Key: userId.String()},
+	}
+
+	deleteUsernameOp := dapr.StateOperation{
+		Type: dapr.StateOperationTypeDelete,
+		Item: &dapr.SetStateItem{Key: usernameKey(user.Name)},
+	}
+
+	err = repo.dapr.ExecuteStateTransaction(ctx, repo.config.Name, nil, []*dapr.StateOperation{&deleteUserOp, &deleteUsernameOp})
 	if err != nil {
 		return users.User{}, err
 	}
@@ -98,7 +172,34 @@ func (repo *DaprStateStoreRepo) Delete(userId uuid.UUID) (users.User, error) {
 	return user, nil
 }
 
+func (repo *DaprStateStoreRepo) Create(user users.User) (users.User, error) {
+	ctx := context.Background()
+	item, err := repo.dapr.GetState(ctx, repo.config.Name, usernameKey(user.Name), nil)
+	if err != nil {
+		return users.User{}, err
+	}
+	if item.Value != nil {
+		return users.User{}, UsernameTakenError
+	}
+
+	// Without an etag the username is only written if nobody else wrote it since
+	saved, err := repo.save(user, &dapr.StateOptions{Concurrency: dapr.StateConcurrencyFirstWrite})
+	if err != nil {
+		item, getErr := repo.dapr.GetState(ctx, repo.config.Name, usernameKey(user.Name), nil)
+		if getErr == nil && item.Value != nil {
+			return users.User{}, UsernameTakenError
+		}
+		return users.User{}, err
+	}
+	return saved, nil
+}
+
 func (repo *DaprStateStoreRepo) Save(user users.User) (users.User, error) {
+	return repo.save(user, nil)
+}
+
+// save writes a user together with its key and username, usernameOptions apply to the write of the username
+func (repo *DaprStateStoreRepo) save(user users.User, usernameOptions *dapr.StateOptions) (users.User, error) {
 	ctx := context.Background()
 	userJson, err := json.Marshal(user)
 	if err != nil {
@@ -148,7 +249,21 @@ func (repo *DaprStateStoreRepo) Save(user users.User) (users.User, error) {
 		},
 	}
 
-	err = repo.dapr.ExecuteStateTransaction(ctx, repo.config.Name, nil, []*dapr.StateOperation{&saveUserOp, &saveKeyOp})
+	userIdJson, err := json.Marshal(user.Id)
+	if err != nil {
+		return users.User{}, err
+	}
+
+	saveUsernameOp := dapr.StateOperation{
+		Type: dapr.StateOperationTypeUpsert,
+		Item: &dapr.SetStateItem{
+			Key:     usernameKey(user.Name),
+			Value:   userIdJson,
+			Options: usernameOptions,
+		},
+	}
+
+	err = repo.dapr.ExecuteStateTransaction(ctx, repo.config.Name, nil, []*dapr.StateOperation{&saveUserOp, &saveKeyOp, &saveUsernameOp})
 	if err != nil {
 		return users.User{}, err
 	}
@@ -182,6 +297,15 @@ func (repo InMemoryRepo) Get(userId uuid.UUID) (users.User, error) {
 	return user, nil
 }
 
+func (repo InMemoryRepo) GetByName(name string) (users.User, error) {
+	for _, user := range repo.Users {
+		if strings.EqualFold(user.Name, name) {
+			return user, nil
+		}
+	}
+	return users.User{}, UsernameNotFoundError
+}
+
 func (repo InMemoryRepo) Delete(userId uuid.UUID) (users.User, error) {
 	user, exists := repo.Users[userId]
 	if !exists {
@@ -191,6 +315,14 @@ func (repo InMemoryRepo) Delete(userId uuid.UUID) (users.User, error) {
 	return user, nil
 }
 
+func (repo InMemoryRepo) Create(user users.User) (users.User, error) {
+	_, err := repo.GetByName(user.Name)
+	if err == nil {
+		return users.User{}, UsernameTakenError
+	}
+	return repo.Save(user)
+}
+
 func (repo InMemoryRepo) Save(user users.User) (users.User, error) {
 	repo.Users[user.Id] = user
 	return user, nil
@@ -0,0 +1,144 @@
+package users
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	dapr "github.com/dapr/go-sdk/client"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"sync"
+	"testing"
+	"yatc/internal"
+	"yatc/user/pkg/users"
+)
+
+type fakeStateItem struct {
+	value   []byte
+	version int
+}
+
+// fakeStateStore keeps state in memory and checks etags like a Dapr state store with first-write concurrency,
+// a missing etag only matches a key that doesn't exist
+type fakeStateStore struct {
+	dapr.Client
+	mutex   sync.Mutex
+	items   map[string]fakeStateItem
+	version int
+}
+
+func newFakeStateStore() *fakeStateStore {
+	return &fakeStateStore{items: map[string]fakeStateItem{}}
+}
+
+func (store *fakeStateStore) GetState(ctx context.Context, storeName, key string, meta map[string]string) (*dapr.StateItem, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	item, ok := store.items[key]
+	if !ok {
+		return &dapr.StateItem{Key: key}, nil
+	}
+	return &dapr.StateItem{Key: key, Value: item.value, Etag: strconv.Itoa(item.version)}, nil
+}
+
+func (store *fakeStateStore) GetBulkState(ctx context.Context, storeName string, keys []string, meta map[string]string, parallelism int32) ([]*dapr.BulkStateItem, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	items := make([]*dapr.BulkStateItem, len(keys))
+	for i, key := range keys {
+		items[i] = &dapr.BulkStateItem{Key: key, Value: store.items[key].value}
+	}
+	return items, nil
+}
+
+func (store *fakeStateStore) SaveState(ctx context.Context, storeName, key string, data []byte, meta map[string]string, so ...dapr.StateOption) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.version++
+	store.items[key] = fakeStateItem{data, store.version}
+	return nil
+}
+
+func (store *fakeStateStore) ExecuteStateTransaction(ctx context.Context, storeName string, meta map[string]string, ops []*dapr.StateOperation) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	for _, op := range ops {
+		if op.Item.Options == nil || op.Item.Options.Concurrency != dapr.StateConcurrencyFirstWrite {
+			continue
+		}
+		item, exists := store.items[op.Item.Key]
+		if op.Item.Etag == nil && exists || op.Item.Etag != nil && (!exists || op.Item.Etag.Value != strconv.Itoa(item.version)) {
+			return errors.New("possible etag mismatch")
+		}
+	}
+
+	for _, op := range ops {
+		if op.Type == dapr.StateOperationTypeDelete {
+			delete(store.items, op.Item.Key)
+			continue
+		}
+		store.version++
+		store.items[op.Item.Key] = fakeStateItem{op.Item.Value, store.version}
+	}
+	return nil
+}
+
+func TestDaprStateStoreRepo_GetByName_NotIndexed(t *testing.T) {
+	// GIVEN
+	store := newFakeStateStore()
+	repo := NewDaprRepo(store, internal.StateStoreConfig{Name: "statestore"})
+	hans := users.User{Id: uuid.New(), Name: "Hans"}
+	userJson, err := json.Marshal(hans)
+	assert.NoError(t, err)
+	keysJson, err := json.Marshal(internal.SetOf(hans.Id))
+	assert.NoError(t, err)
+	store.items[hans.Id.String()] = fakeStateItem{value: userJson}
+	store.items["keys"] = fakeStateItem{value: keysJson}
+
+	// WHEN
+	found, err := repo.GetByName("HANS")
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, hans.Id, found.Id)
+	assert.Contains(t, store.items, usernameKey(hans.Name))
+
+	_, err = repo.Create(users.User{Id: uuid.New(), Name: "hans"})
+	assert.ErrorIs(t, err, UsernameTakenError)
+	_, err = repo.GetByName("peter")
+	assert.ErrorIs(t, err, UsernameNotFoundError)
+}
+
+func TestDaprStateStoreRepo_Create_Concurrent(t *testing.T) {
+	// GIVEN
+	repo := NewDaprRepo(newFakeStateStore(), internal.StateStoreConfig{Name: "statestore"})
+
+	// WHEN
+	attempts := 4
+	var wg sync.WaitGroup
+	errs := make([]error, attempts)
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = repo.Create(users.User{Id: uuid.New(), Name: "Hans"})
+		}(i)
+	}
+	wg.Wait()
+
+	// THEN
+	created := 0
+	for _, err := range errs {
+		if err == nil {
+			created++
+			continue
+		}
+		assert.ErrorIs(t, err, UsernameTakenError)
+	}
+	assert.Equal(t, 1, created)
+}
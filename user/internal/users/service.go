@@ -1,11 +1,14 @@
 package users
 
 import (
+	"errors"
 	"github.com/google/uuid"
 	"yatc/internal"
 	"yatc/user/pkg/users"
 )
 
+var UsernameTakenError = errors.New("username is already taken")
+
 type Service struct {
 	repo Repository
 }
@@ -22,12 +25,69 @@ func (userService *Service) GetUser(uuid uuid.UUID) (users.User, error) {
 	return userService.repo.Get(uuid)
 }
 
+// GetUsersByName returns the users with the given usernames, unknown usernames are skipped
+func (userService *Service) GetUsersByName(names []string) ([]users.User, error) {
+	found := make([]users.User, 0, len(names))
+	for _, name := range names {
+		user, err := userService.repo.GetByName(name)
+		if err != nil {
+			if errors.Is(err, UsernameNotFoundError) {
+				continue
+			}
+			return nil, err
+		}
+		if !containsUser(found, user.Id) {
+			found = append(found, user)
+		}
+	}
+	return found, nil
+}
+
+func containsUser(all []users.User, userId uuid.UUID) bool {
+	for _, user := range all {
+		if user.Id == userId {
+			return true
+		}
+	}
+	return false
+}
+
 func (userService *Service) CreateUser(user users.User) (users.User, error) {
+	_, err := userService.repo.GetByName(user.Name)
+	if err == nil {
+		return users.User{}, UsernameTakenError
+	}
+	if !errors.Is(err, UsernameNotFoundError) {
+		return users.User{}, err
+	}
+
 	user.Followers = internal.Ptr(internal.NewSet[uuid.UUID]())
 	user.Followees = internal.Ptr(internal.NewSet[uuid.UUID]())
-	return userService.repo.Save(user)
+	return userService.repo.Create(user)
 }
 
 func (userService *Service) DeleteUser(uuid uuid.UUID) (users.User, error) {
 	return userService.repo.Delete(uuid)
 }
+
+func (userService *Service) GetPreferences(userId uuid.UUID) (users.Preferences, error) {
+	user, err := userService.repo.Get(userId)
+	if err != nil {
+		return users.Preferences{}, err
+	}
+	return user.Preferences, nil
+}
+
+func (userService *Service) UpdatePreferences(userId uuid.UUID, preferences users.Preferences) (users.Preferences, error) {
+	user, err := userService.repo.Get(userId)
+	if err != nil {
+		return users.Preferences{}, err
+	}
+
+	user.Preferences = preferences
+	user, err = userService.repo.Save(user)
+	if err != nil {
+		return users.Preferences{}, err
+	}
+	return user.Preferences, nil
+}
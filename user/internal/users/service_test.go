@@ -0,0 +1,63 @@
+package users
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"yatc/internal"
+	"yatc/user/pkg/users"
+)
+
+func TestService_GetUsersByName(t *testing.T) {
+	repo := NewInMemoryRepo()
+	service := NewUserService(repo)
+
+	hans, err := service.CreateUser(users.User{Id: uuid.New(), Name: "Hans"})
+	assert.NoError(t, err)
+	peter, err := service.CreateUser(users.User{Id: uuid.New(), Name: "Peter"})
+	assert.NoError(t, err)
+
+	found, err := service.GetUsersByName([]string{"hans", "unknown", "PETER", "Hans"})
+	assert.NoError(t, err)
+	assert.Equal(t, []users.User{hans, peter}, found)
+}
+
+func TestService_CreateUser_UsernameTaken(t *testing.T) {
+	repo := NewInMemoryRepo()
+	service := NewUserService(repo)
+
+	_, err := service.CreateUser(users.User{Id: uuid.New(), Name: "Hans"})
+	assert.NoError(t, err)
+
+	_, err = service.CreateUser(users.User{Id: uuid.New(), Name: "hans"})
+	assert.ErrorIs(t, err, UsernameTakenError)
+	assert.Len(t, repo.Users, 1)
+}
+
+func TestService_UpdatePreferences(t *testing.T) {
+	repo := NewInMemoryRepo()
+	service := NewUserService(repo)
+
+	hans, err := service.CreateUser(users.User{Id: uuid.New(), Name: "Hans"})
+	assert.NoError(t, err)
+
+	preferences, err := service.GetPreferences(hans.Id)
+	assert.NoError(t, err)
+	assert.False(t, preferences.ExpandContentWarnings)
+
+	_, err = service.UpdatePreferences(hans.Id, users.Preferences{ExpandContentWarnings: true})
+	assert.NoError(t, err)
+
+	preferences, err = service.GetPreferences(hans.Id)
+	assert.NoError(t, err)
+	assert.True(t, preferences.ExpandContentWarnings)
+	assert.Equal(t, "Hans", repo.Users[hans.Id].Name)
+}
+
+func TestService_UpdatePreferences_UnknownUser(t *testing.T) {
+	service := NewUserService(NewInMemoryRepo())
+	userId := uuid.New()
+
+	_, err := service.UpdatePreferences(userId, users.Preferences{ExpandContentWarnings: true})
+	assert.ErrorIs(t, err, internal.NotFoundError(userId))
+}
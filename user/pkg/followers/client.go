@@ -70,11 +70,16 @@ func (client *FollowerClient) GetFollowees(ctx context.Context, userId uuid.UUID
 		return nil, clientError
 	}
 
-	var allUsers []users.User
-	err = render.DecodeJSON(response.Body, &allUsers)
+	var usersResponse api.UsersResponse
+	err = render.DecodeJSON(response.Body, &usersResponse)
 	if err != nil {
 		return nil, err
 	}
+
+	allUsers := make([]users.User, 0)
+	for _, user := range usersResponse.Users {
+		allUsers = append(allUsers, UserResponseToUser(user))
+	}
 	return allUsers, nil
 }
 
@@ -0,0 +1,58 @@
+package followers
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+)
+
+// Topics follow and unfollow events are published to
+const (
+	FollowedTopic   = "user.followed"
+	UnfollowedTopic = "user.unfollowed"
+)
+
+// FollowEvent is published when FollowerId follows or unfollows FolloweeId
+type FollowEvent struct {
+	FollowerId uuid.UUID
+	FolloweeId uuid.UUID
+}
+
+// Receiver passes the data of every event published to a topic to handle. statuses.DaprStatusSubscriber is one,
+// receiving follow events through it keeps all subscriptions of a service in one place.
+type Receiver interface {
+	Receive(topic string, handle func(ctx context.Context, data json.RawMessage) error)
+}
+
+type Subscriber interface {
+	SubscribeFollowed(handler func(ctx context.Context, event FollowEvent))
+	SubscribeUnfollowed(handler func(ctx context.Context, event FollowEvent))
+}
+
+type DaprFollowSubscriber struct {
+	receiver Receiver
+}
+
+func NewDaprFollowSubscriber(receiver Receiver) *DaprFollowSubscriber {
+	return &DaprFollowSubscriber{receiver}
+}
+
+func (sub *DaprFollowSubscriber) SubscribeFollowed(handler func(ctx context.Context, event FollowEvent)) {
+	sub.subscribe(FollowedTopic, handler)
+}
+
+func (sub *DaprFollowSubscriber) SubscribeUnfollowed(handler func(ctx context.Context, event FollowEvent)) {
+	sub.subscribe(UnfollowedTopic, handler)
+}
+
+func (sub *DaprFollowSubscriber) subscribe(topic string, handler func(ctx context.Context, event FollowEvent)) {
+	sub.receiver.Receive(topic, func(ctx context.Context, data json.RawMessage) error {
+		var event FollowEvent
+		err := json.Unmarshal(data, &event)
+		if err != nil {
+			return err
+		}
+		handler(ctx, event)
+		return nil
+	})
+}
@@ -27,6 +27,18 @@ type CreateUserRequest struct {
 	Username string `json:"username"`
 }
 
+// PreferencesResponse defines model for PreferencesResponse.
+type PreferencesResponse struct {
+	// ExpandContentWarnings timelines show statuses with a content warning or sensitive media expanded
+	ExpandContentWarnings bool `json:"expandContentWarnings"`
+}
+
+// UpdatePreferencesRequest defines model for UpdatePreferencesRequest.
+type UpdatePreferencesRequest struct {
+	// ExpandContentWarnings timelines show statuses with a content warning or sensitive media expanded
+	ExpandContentWarnings bool `json:"expandContentWarnings"`
+}
+
 // UserResponse defines model for UserResponse.
 type UserResponse struct {
 	Id       openapi_types.UUID `json:"id"`
@@ -38,6 +50,12 @@ type UsersResponse struct {
 	Users []UserResponse `json:"users"`
 }
 
+// GetUsersByNameParams defines parameters for GetUsersByName.
+type GetUsersByNameParams struct {
+	// Username username to look up, can be repeated
+	Username []string `form:"username" json:"username"`
+}
+
 // DeleteUserParams defines parameters for DeleteUser.
 type DeleteUserParams struct {
 	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be equal to userId in path
@@ -56,12 +74,27 @@ type UnfollowUserParams struct {
 	XUser openapi_types.UUID `json:"X-user"`
 }
 
+// GetPreferencesParams defines parameters for GetPreferences.
+type GetPreferencesParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be equal to userId in path
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
+// UpdatePreferencesParams defines parameters for UpdatePreferences.
+type UpdatePreferencesParams struct {
+	// XUser supplied from api gateway if authenticated, can be set manually locally. Has to be equal to userId in path
+	XUser openapi_types.UUID `json:"X-user"`
+}
+
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody = CreateUserRequest
 
 // FollowUserJSONRequestBody defines body for FollowUser for application/json ContentType.
 type FollowUserJSONRequestBody = CreateFollowerRequest
 
+// UpdatePreferencesJSONRequestBody defines body for UpdatePreferences for application/json ContentType.
+type UpdatePreferencesJSONRequestBody = UpdatePreferencesRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -135,6 +168,9 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetUsersByName request
+	GetUsersByName(ctx context.Context, params *GetUsersByNameParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateUser request with any body
 	CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -159,6 +195,26 @@ type ClientInterface interface {
 
 	// UnfollowUser request
 	UnfollowUser(ctx context.Context, userId openapi_types.UUID, followerUserId openapi_types.UUID, params *UnfollowUserParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPreferences request
+	GetPreferences(ctx context.Context, userId openapi_types.UUID, params *GetPreferencesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdatePreferences request with any body
+	UpdatePreferencesWithBody(ctx context.Context, userId openapi_types.UUID, params *UpdatePreferencesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdatePreferences(ctx context.Context, userId openapi_types.UUID, params *UpdatePreferencesParams, body UpdatePreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetUsersByName(ctx context.Context, params *GetUsersByNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersByNameRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -269,6 +325,85 @@ func (c *Client) UnfollowUser(ctx context.Context, userId openapi_types.UUID, fo
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetPreferences(ctx context.Context, userId openapi_types.UUID, params *GetPreferencesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPreferencesRequest(c.Server, userId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePreferencesWithBody(ctx context.Context, userId openapi_types.UUID, params *UpdatePreferencesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePreferencesRequestWithBody(c.Server, userId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePreferences(ctx context.Context, userId openapi_types.UUID, params *UpdatePreferencesParams, body UpdatePreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePreferencesRequest(c.Server, userId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetUsersByNameRequest generates requests for GetUsersByName
+func NewGetUsersByNameRequest(server string, params *GetUsersByNameParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewCreateUserRequest calls the generic CreateUser builder with application/json body
 func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -560,6 +695,105 @@ func NewUnfollowUserRequest(server string, userId openapi_types.UUID, followerUs
 	return req, nil
 }
 
+// NewGetPreferencesRequest generates requests for GetPreferences
+func NewGetPreferencesRequest(server string, userId openapi_types.UUID, params *GetPreferencesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/preferences", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam0)
+
+	return req, nil
+}
+
+// NewUpdatePreferencesRequest calls the generic UpdatePreferences builder with application/json body
+func NewUpdatePreferencesRequest(server string, userId openapi_types.UUID, params *UpdatePreferencesParams, body UpdatePreferencesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePreferencesRequestWithBody(server, userId, params, "application/json", bodyReader)
+}
+
+// NewUpdatePreferencesRequestWithBody generates requests for UpdatePreferences with any type of body
+func NewUpdatePreferencesRequestWithBody(server string, userId openapi_types.UUID, params *UpdatePreferencesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/preferences", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-user", runtime.ParamLocationHeader, params.XUser)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-user", headerParam0)
+
+	return req, nil
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -603,6 +837,9 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// GetUsersByName request
+	GetUsersByNameWithResponse(ctx context.Context, params *GetUsersByNameParams, reqEditors ...RequestEditorFn) (*GetUsersByNameResponse, error)
+
 	// CreateUser request with any body
 	CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
 
@@ -627,6 +864,36 @@ type ClientWithResponsesInterface interface {
 
 	// UnfollowUser request
 	UnfollowUserWithResponse(ctx context.Context, userId openapi_types.UUID, followerUserId openapi_types.UUID, params *UnfollowUserParams, reqEditors ...RequestEditorFn) (*UnfollowUserResponse, error)
+
+	// GetPreferences request
+	GetPreferencesWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetPreferencesParams, reqEditors ...RequestEditorFn) (*GetPreferencesResponse, error)
+
+	// UpdatePreferences request with any body
+	UpdatePreferencesWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, params *UpdatePreferencesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePreferencesResponse, error)
+
+	UpdatePreferencesWithResponse(ctx context.Context, userId openapi_types.UUID, params *UpdatePreferencesParams, body UpdatePreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePreferencesResponse, error)
+}
+
+type GetUsersByNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UsersResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersByNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersByNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
 type CreateUserResponse struct {
@@ -781,6 +1048,59 @@ func (r UnfollowUserResponse) StatusCode() int {
 	return 0
 }
 
+type GetPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PreferencesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PreferencesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetUsersByNameWithResponse request returning *GetUsersByNameResponse
+func (c *ClientWithResponses) GetUsersByNameWithResponse(ctx context.Context, params *GetUsersByNameParams, reqEditors ...RequestEditorFn) (*GetUsersByNameResponse, error) {
+	rsp, err := c.GetUsersByName(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersByNameResponse(rsp)
+}
+
 // CreateUserWithBodyWithResponse request with arbitrary body returning *CreateUserResponse
 func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
 	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
@@ -860,6 +1180,58 @@ func (c *ClientWithResponses) UnfollowUserWithResponse(ctx context.Context, user
 	return ParseUnfollowUserResponse(rsp)
 }
 
+// GetPreferencesWithResponse request returning *GetPreferencesResponse
+func (c *ClientWithResponses) GetPreferencesWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetPreferencesParams, reqEditors ...RequestEditorFn) (*GetPreferencesResponse, error) {
+	rsp, err := c.GetPreferences(ctx, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPreferencesResponse(rsp)
+}
+
+// UpdatePreferencesWithBodyWithResponse request with arbitrary body returning *UpdatePreferencesResponse
+func (c *ClientWithResponses) UpdatePreferencesWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, params *UpdatePreferencesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePreferencesResponse, error) {
+	rsp, err := c.UpdatePreferencesWithBody(ctx, userId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePreferencesResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePreferencesWithResponse(ctx context.Context, userId openapi_types.UUID, params *UpdatePreferencesParams, body UpdatePreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePreferencesResponse, error) {
+	rsp, err := c.UpdatePreferences(ctx, userId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePreferencesResponse(rsp)
+}
+
+// ParseGetUsersByNameResponse parses an HTTP response from a GetUsersByNameWithResponse call
+func ParseGetUsersByNameResponse(rsp *http.Response) (*GetUsersByNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersByNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UsersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseCreateUserResponse parses an HTTP response from a CreateUserWithResponse call
 func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1021,3 +1393,55 @@ func ParseUnfollowUserResponse(rsp *http.Response) (*UnfollowUserResponse, error
 
 	return response, nil
 }
+
+// ParseGetPreferencesResponse parses an HTTP response from a GetPreferencesWithResponse call
+func ParseGetPreferencesResponse(rsp *http.Response) (*GetPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PreferencesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdatePreferencesResponse parses an HTTP response from a UpdatePreferencesWithResponse call
+func ParseUpdatePreferencesResponse(rsp *http.Response) (*UpdatePreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdatePreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PreferencesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
@@ -34,11 +34,32 @@ func NewUserClient(config internal.DaprConfig) *UserClient {
 }
 
 func (client *UserClient) GetUsers() ([]User, error) {
-	panic("implement me")
+	return nil, internal.NotImplementedError
 }
 
 func (client *UserClient) GetUser(userId uuid.UUID) (User, error) {
-	panic("implement me")
+	return User{}, internal.NotImplementedError
+}
+
+func (client *UserClient) GetUsersByName(names []string) ([]User, error) {
+	params := api.GetUsersByNameParams{Username: names}
+	response, err := client.httpClient.GetUsersByName(context.Background(), &params)
+	clientError := internal.ToClientError(response, err)
+	if clientError != nil {
+		return nil, clientError
+	}
+
+	var usersResponse api.UsersResponse
+	err = render.DecodeJSON(response.Body, &usersResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	allUsers := make([]User, len(usersResponse.Users))
+	for i, userResponse := range usersResponse.Users {
+		allUsers[i] = User{Id: userResponse.Id, Name: userResponse.Username}
+	}
+	return allUsers, nil
 }
 
 func (client *UserClient) CreateUser(user User) (User, error) {
@@ -62,5 +83,27 @@ func (client *UserClient) CreateUser(user User) (User, error) {
 }
 
 func (client *UserClient) DeleteUser(userId uuid.UUID) (User, error) {
-	panic("implement me")
+	return User{}, internal.NotImplementedError
+}
+
+// GetPreferences asks for the preferences on behalf of the user they belong to
+func (client *UserClient) GetPreferences(userId uuid.UUID) (Preferences, error) {
+	params := api.GetPreferencesParams{XUser: userId}
+	response, err := client.httpClient.GetPreferences(context.Background(), userId, &params)
+	clientError := internal.ToClientError(response, err)
+	if clientError != nil {
+		return Preferences{}, clientError
+	}
+
+	var preferencesResponse api.PreferencesResponse
+	err = render.DecodeJSON(response.Body, &preferencesResponse)
+	if err != nil {
+		return Preferences{}, err
+	}
+
+	return Preferences{ExpandContentWarnings: preferencesResponse.ExpandContentWarnings}, nil
+}
+
+func (client *UserClient) UpdatePreferences(userId uuid.UUID, preferences Preferences) (Preferences, error) {
+	return Preferences{}, internal.NotImplementedError
 }
@@ -6,15 +6,25 @@ import (
 )
 
 type User struct {
-	Id        uuid.UUID
-	Name      string
-	Followers *internal.Set[uuid.UUID]
-	Followees *internal.Set[uuid.UUID]
+	Id          uuid.UUID
+	Name        string
+	Followers   *internal.Set[uuid.UUID]
+	Followees   *internal.Set[uuid.UUID]
+	Preferences Preferences
+}
+
+// Preferences are only visible to the user they belong to
+type Preferences struct {
+	// ExpandContentWarnings shows statuses with a spoiler text or sensitive media expanded in timelines
+	ExpandContentWarnings bool
 }
 
 type Service interface {
 	GetUsers() ([]User, error)
 	GetUser(userId uuid.UUID) (User, error)
+	GetUsersByName(names []string) ([]User, error)
 	CreateUser(user User) (User, error)
 	DeleteUser(userId uuid.UUID) (User, error)
+	GetPreferences(userId uuid.UUID) (Preferences, error)
+	UpdatePreferences(userId uuid.UUID, preferences Preferences) (Preferences, error)
 }